@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+
+	coreserv "github.com/kimbellG/tournament/core/service"
+)
+
+// Reconciliation prints its report as JSON and exits with status 1, if discrepancies are left uncorrected.
+func main() {
+	correct := flag.Bool("correct", false, "correct discrepancies that don't need a decision of operator")
+	flag.Parse()
+
+	report, err := coreserv.Reconcile(context.Background(), *correct)
+	if err != nil {
+		log.Fatalf("Failed to reconcile balances: %v", err)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "\t")
+	if err := encoder.Encode(report); err != nil {
+		log.Fatalf("Failed to write report: %v", err)
+	}
+
+	if !report.Consistent() {
+		os.Exit(1)
+	}
+}
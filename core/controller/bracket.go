@@ -0,0 +1,135 @@
+package controller
+
+import (
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/tournament/core/models"
+)
+
+func bracketSize(players int) int {
+	size := 1
+	for size < players {
+		size <<= 1
+	}
+
+	return size
+}
+
+func bracketRounds(players int) int {
+	rounds := 0
+	for size := bracketSize(players); size > 1; size >>= 1 {
+		rounds++
+	}
+
+	return rounds
+}
+
+func shufflePlayers(players []uuid.UUID) []uuid.UUID {
+	shuffled := make([]uuid.UUID, len(players))
+	copy(shuffled, players)
+
+	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
+	rnd.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	return shuffled
+}
+
+// buildBracket creates all matches of a single-elimination bracket for seeded players.
+// Players without an opponent get a bye and are advanced to the next round immediately.
+func buildBracket(tournamentID uuid.UUID, seeded []uuid.UUID) []*models.Match {
+	size := bracketSize(len(seeded))
+	rounds := bracketRounds(len(seeded))
+	byes := size - len(seeded)
+
+	bracket := make([][]*models.Match, rounds)
+	for round := 1; round <= rounds; round++ {
+		for position := 0; position < size>>round; position++ {
+			bracket[round-1] = append(bracket[round-1], &models.Match{
+				TournamentID: tournamentID,
+				Round:        round,
+				Position:     position,
+			})
+		}
+	}
+
+	next := 0
+	for _, match := range bracket[0] {
+		match.FirstUser = seeded[next]
+		next++
+
+		if byes > 0 {
+			byes--
+			match.Winner = match.FirstUser
+			advanceWinner(bracket, match)
+
+			continue
+		}
+
+		match.SecondUser = seeded[next]
+		next++
+	}
+
+	var matches []*models.Match
+	for _, round := range bracket {
+		matches = append(matches, round...)
+	}
+
+	return matches
+}
+
+func advanceWinner(bracket [][]*models.Match, match *models.Match) {
+	if match.Round == len(bracket) {
+		return
+	}
+
+	nextRound, nextPosition := nextMatchOf(match)
+	placeInMatch(bracket[nextRound-1][nextPosition], match)
+}
+
+func nextMatchOf(match *models.Match) (round, position int) {
+	return match.Round + 1, match.Position / 2
+}
+
+func placeInMatch(next *models.Match, previous *models.Match) {
+	if previous.Position%2 == 0 {
+		next.FirstUser = previous.Winner
+	} else {
+		next.SecondUser = previous.Winner
+	}
+}
+
+// eliminationRanking ranks players of a finished bracket: the champion first, then the losers
+// from the latest round to the earliest. Losers of the same round follow the order of their matches.
+func eliminationRanking(matches []models.Match) []uuid.UUID {
+	sorted := make([]models.Match, len(matches))
+	copy(sorted, matches)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Round != sorted[j].Round {
+			return sorted[i].Round > sorted[j].Round
+		}
+
+		return sorted[i].Position < sorted[j].Position
+	})
+
+	var ranking []uuid.UUID
+	for _, match := range sorted {
+		if match.Winner == uuid.Nil {
+			continue
+		}
+
+		if len(ranking) == 0 {
+			ranking = append(ranking, match.Winner)
+		}
+
+		if !match.IsBye() {
+			ranking = append(ranking, match.Opponent(match.Winner))
+		}
+	}
+
+	return ranking
+}
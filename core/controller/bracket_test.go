@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBracketSize(t *testing.T) {
+	tt := []struct {
+		players int
+		size    int
+		rounds  int
+	}{
+		{players: 2, size: 2, rounds: 1},
+		{players: 3, size: 4, rounds: 2},
+		{players: 4, size: 4, rounds: 2},
+		{players: 5, size: 8, rounds: 3},
+		{players: 16, size: 16, rounds: 4},
+	}
+
+	for _, tc := range tt {
+		assert.Equalf(t, tc.size, bracketSize(tc.players), "size of bracket for %d players", tc.players)
+		assert.Equalf(t, tc.rounds, bracketRounds(tc.players), "rounds of bracket for %d players", tc.players)
+	}
+}
+
+func TestBuildBracket(t *testing.T) {
+	tournamentID := uuid.New()
+
+	var players []uuid.UUID
+	for i := 0; i < 5; i++ {
+		players = append(players, uuid.New())
+	}
+
+	matches := buildBracket(tournamentID, players)
+	if !assert.Equal(t, 7, len(matches), "bracket for 8 slots should have 7 matches") {
+		return
+	}
+
+	byRound := map[int][]*models.Match{}
+	for _, match := range matches {
+		assert.Equal(t, tournamentID, match.TournamentID, "match should belong to tournament")
+		byRound[match.Round] = append(byRound[match.Round], match)
+	}
+
+	assert.Equal(t, 4, len(byRound[1]), "first round should have 4 matches")
+	assert.Equal(t, 2, len(byRound[2]), "second round should have 2 matches")
+	assert.Equal(t, 1, len(byRound[3]), "final should be single match")
+
+	seen := map[uuid.UUID]bool{}
+	byes := 0
+	for _, match := range byRound[1] {
+		assert.NotEqual(t, uuid.Nil, match.FirstUser, "every first round match should have a player")
+		seen[match.FirstUser] = true
+
+		if match.SecondUser == uuid.Nil {
+			byes++
+			assert.Equal(t, match.FirstUser, match.Winner, "player with bye should win the match")
+			continue
+		}
+
+		seen[match.SecondUser] = true
+		assert.Equal(t, uuid.Nil, match.Winner, "played match shouldn't have a winner")
+	}
+
+	assert.Equal(t, 3, byes, "5 players in 8 slots should get 3 byes")
+	assert.Equal(t, len(players), len(seen), "every player should be seeded once")
+	assert.True(t, byRound[2][0].IsReady(), "winners of byes should meet in second round")
+	assert.Equal(t, byRound[1][2].Winner, byRound[2][1].FirstUser, "bye winner should be advanced to the next match")
+}
+
+func TestEliminationRanking(t *testing.T) {
+	var players []uuid.UUID
+	for i := 0; i < 3; i++ {
+		players = append(players, uuid.New())
+	}
+
+	matches := []models.Match{
+		{Round: 1, Position: 0, FirstUser: players[0], Winner: players[0]},
+		{Round: 1, Position: 1, FirstUser: players[1], SecondUser: players[2], Winner: players[2]},
+		{Round: 2, Position: 0, FirstUser: players[0], SecondUser: players[2], Winner: players[2]},
+	}
+
+	assert.Equal(t, []uuid.UUID{players[2], players[0], players[1]}, eliminationRanking(matches),
+		"champion should be followed by losers of later rounds")
+}
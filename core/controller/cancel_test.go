@@ -0,0 +1,159 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+	"github.com/stretchr/testify/assert"
+)
+
+// cancelledTournament keeps the prize pool of tournament and the movements of money made by its cancellation.
+type cancelledTournament struct {
+	TournamentRepository
+	LedgerRepository
+	tournament   *models.Tournament
+	participants []models.Participant
+	transactions []*models.LedgerTransaction
+}
+
+func (ct *cancelledTournament) SelectParticipants(_ context.Context, _ tx.DBTX, _ uuid.UUID) ([]models.Participant, error) {
+	return ct.participants, nil
+}
+
+func (ct *cancelledTournament) AddRake(_ context.Context, _ tx.DBTX, _ uuid.UUID, rake models.Money) error {
+	ct.tournament.RakeCollected = ct.tournament.RakeCollected.Add(rake)
+	return nil
+}
+
+func (ct *cancelledTournament) AddToPrize(_ context.Context, _ tx.DBTX, _ uuid.UUID, amount models.Money) error {
+	ct.tournament.Prize = ct.tournament.Prize.Add(amount)
+	return nil
+}
+
+func (ct *cancelledTournament) UpdateStatus(_ context.Context, _ tx.DBTX, _ uuid.UUID, _, next models.TournamentStatus) error {
+	ct.tournament.Status = next
+	return nil
+}
+
+func (ct *cancelledTournament) InsertTransaction(_ context.Context, _ tx.DBTX, transaction *models.LedgerTransaction) error {
+	ct.transactions = append(ct.transactions, transaction)
+	return nil
+}
+
+// balanceOf sums the amounts moved to account by cancellation.
+func (ct *cancelledTournament) balanceOf(account models.LedgerAccountType, id uuid.UUID) models.Money {
+	var balance models.Money
+	for _, transaction := range ct.transactions {
+		for _, entry := range transaction.Entries {
+			if entry.Account.Type == account && entry.Account.ID == id {
+				balance = balance.Add(entry.Amount)
+			}
+		}
+	}
+
+	return balance
+}
+
+type tournamentHolds []models.Hold
+
+func (th tournamentHolds) Insert(context.Context, tx.DBTX, *models.Hold) error {
+	return nil
+}
+
+func (th tournamentHolds) SelectByTournament(context.Context, tx.DBTX, uuid.UUID) ([]models.Hold, error) {
+	return th, nil
+}
+
+func (th tournamentHolds) Delete(context.Context, tx.DBTX, uuid.UUID, uuid.UUID) error {
+	return nil
+}
+
+type ignoredBalances struct {
+	UserRepository
+}
+
+func (ignoredBalances) UpdateBalanceBySum(context.Context, tx.DBTX, uuid.UUID, models.Currency, models.Money) error {
+	return nil
+}
+
+type ignoredHouse struct {
+	HouseRepository
+}
+
+func (ignoredHouse) UpdateBalanceBySum(context.Context, tx.DBTX, models.Currency, models.Money) error {
+	return nil
+}
+
+func TestCancelEmptiesPrizePool(t *testing.T) {
+	charged, held := uuid.New(), uuid.New()
+
+	// Charged participant paid 100 with 10 of rake, withdrawn user left a penalty of 5 in the pool.
+	tournament := &models.Tournament{
+		ID:            uuid.New(),
+		Status:        models.RegistrationOpen,
+		Currency:      models.DefaultCurrency,
+		Prize:         money(95),
+		RakeType:      models.PercentageRake,
+		Rake:          percent(10),
+		RakeCollected: money(10),
+	}
+	repo := &cancelledTournament{
+		tournament: tournament,
+		participants: []models.Participant{
+			{UserID: charged, Stake: money(100)},
+			{UserID: held, Stake: money(100)},
+		},
+	}
+	holds := tournamentHolds{{TournamentID: tournament.ID, UserID: held, Currency: models.DefaultCurrency, Amount: money(100)}}
+	tu := &TournamentInteractor{repo: repo, ledger: NewLedger(repo, ignoredBalances{}, ignoredHouse{}, holds)}
+
+	if err := tu.cancel(context.Background(), nil, tournament); err != nil {
+		t.Fatalf("Failed to cancel tournament: %v", err)
+	}
+
+	assert.Equal(t, models.Cancelled, tournament.Status)
+	assert.True(t, money(95).Add(repo.balanceOf(models.TournamentAccount, tournament.ID)).IsZero(), "tournament account should be empty")
+	assert.True(t, tournament.Prize.IsZero(), "prize pool should be empty")
+	assert.True(t, tournament.RakeCollected.IsZero(), "rake should be returned")
+	assert.Equal(t, money(100), repo.balanceOf(models.UserAccount, charged), "charged stake should be refunded")
+	assert.True(t, repo.balanceOf(models.UserAccount, held).IsZero(), "held stake should only be released")
+	assert.Equal(t, money(-5), repo.balanceOf(models.HouseAccount, uuid.Nil), "house should return rake and keep penalty")
+}
+
+func TestCancelRefundsCapturedStakes(t *testing.T) {
+	first, second := uuid.New(), uuid.New()
+
+	// Both stakes of 100 were captured on start with 10 of rake each.
+	tournament := &models.Tournament{
+		ID:            uuid.New(),
+		Status:        models.InProgress,
+		Currency:      models.DefaultCurrency,
+		Prize:         money(180),
+		RakeType:      models.PercentageRake,
+		Rake:          percent(10),
+		RakeCollected: money(20),
+	}
+	repo := &cancelledTournament{
+		tournament: tournament,
+		participants: []models.Participant{
+			{UserID: first, Stake: money(100)},
+			{UserID: second, Stake: money(100)},
+		},
+	}
+	tu := &TournamentInteractor{repo: repo, ledger: NewLedger(repo, ignoredBalances{}, ignoredHouse{}, tournamentHolds{})}
+
+	if err := tu.cancel(context.Background(), nil, tournament); err != nil {
+		t.Fatalf("Failed to cancel tournament: %v", err)
+	}
+
+	assert.Equal(t, models.Cancelled, tournament.Status)
+	assert.True(t, money(180).Add(repo.balanceOf(models.TournamentAccount, tournament.ID)).IsZero(), "tournament account should be empty")
+	assert.True(t, tournament.Prize.IsZero(), "prize pool should be empty")
+	assert.True(t, tournament.RakeCollected.IsZero(), "rake should be returned")
+	assert.Equal(t, money(100), repo.balanceOf(models.UserAccount, first), "captured stake should be refunded")
+	assert.Equal(t, money(100), repo.balanceOf(models.UserAccount, second), "captured stake should be refunded")
+	assert.Equal(t, money(-20), repo.balanceOf(models.HouseAccount, uuid.Nil), "house should return rake")
+}
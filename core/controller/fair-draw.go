@@ -0,0 +1,126 @@
+package controller
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+)
+
+const (
+	serverSeedSize    = 32
+	maxClientSeedSize = 64
+)
+
+func generateServerSeed() (string, error) {
+	seed := make([]byte, serverSeedSize)
+	if _, err := rand.Read(seed); err != nil {
+		return "", kerror.Newf(kerror.InternalServerError, "read random seed: %v", err)
+	}
+
+	return hex.EncodeToString(seed), nil
+}
+
+func hashServerSeed(seed string) string {
+	hash := sha256.Sum256([]byte(seed))
+	return hex.EncodeToString(hash[:])
+}
+
+// drawIndex derives the winner index from the revealed server seed and the client seeds in join order.
+func drawIndex(serverSeed string, tournamentID uuid.UUID, clientSeeds []string) int {
+	mac := hmac.New(sha256.New, []byte(serverSeed))
+	mac.Write([]byte(strings.Join(append([]string{tournamentID.String()}, clientSeeds...), ":")))
+
+	digest := new(big.Int).SetBytes(mac.Sum(nil))
+	return int(digest.Mod(digest, big.NewInt(int64(len(clientSeeds)))).Int64())
+}
+
+func clientSeedsOf(entries []models.DrawEntry) []string {
+	seeds := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		seeds = append(seeds, entry.ClientSeed)
+	}
+
+	return seeds
+}
+
+func drawEntriesOf(participants []models.Participant) []models.DrawEntry {
+	entries := make([]models.DrawEntry, 0, len(participants))
+	for _, participant := range participants {
+		entries = append(entries, models.DrawEntry{
+			UserID:     participant.UserID,
+			ClientSeed: participant.ClientSeed,
+		})
+	}
+
+	return entries
+}
+
+type fairSelector struct {
+	repo TournamentRepository
+}
+
+func (fs *fairSelector) RankPlayers(ctx context.Context, store tx.DBTX, tournament *models.Tournament, _ *FinishInput) ([]uuid.UUID, error) {
+	if tournament.ServerSeed == "" {
+		return nil, kerror.Newf(kerror.BadRequest, "tournament doesn't have committed server seed")
+	}
+
+	participants, err := fs.repo.SelectParticipants(ctx, store, tournament.ID)
+	if err != nil {
+		return nil, kerror.Errorf(err, "get participants")
+	}
+
+	return rankBy(participants, tournament.Places(), func(remaining []models.Participant) (uuid.UUID, error) {
+		entries := drawEntriesOf(remaining)
+		return entries[drawIndex(tournament.ServerSeed, tournament.ID, clientSeedsOf(entries))].UserID, nil
+	})
+}
+
+func (tu *TournamentInteractor) GetDrawProof(ctx context.Context, id uuid.UUID) (*models.DrawProof, error) {
+	var proof *models.DrawProof
+
+	err := tu.store.WithTransaction(func(store tx.DBTX) error {
+		tournament, err := tu.repo.SelectByID(ctx, store, id)
+		if err != nil {
+			return kerror.Errorf(err, "get tournament")
+		}
+
+		if tournament.WinnerStrategy != models.FairWinner {
+			return kerror.Newf(kerror.BadRequest, "tournament doesn't use provably fair draw")
+		}
+
+		participants, err := tu.repo.SelectParticipants(ctx, store, id)
+		if err != nil {
+			return kerror.Errorf(err, "get participants")
+		}
+
+		proof = &models.DrawProof{
+			TournamentID:   tournament.ID,
+			Algorithm:      models.DrawAlgorithm,
+			ServerSeedHash: tournament.ServerSeedHash,
+			Entries:        drawEntriesOf(participants),
+			WinnerIndex:    -1,
+		}
+
+		if tournament.SeedRevealed && len(proof.Entries) > 0 {
+			proof.ServerSeed = tournament.ServerSeed
+			proof.WinnerIndex = drawIndex(tournament.ServerSeed, tournament.ID, clientSeedsOf(proof.Entries))
+			proof.Winner = tournament.Winner
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, kerror.Errorf(err, "execution transaction")
+	}
+
+	return proof, nil
+}
@@ -0,0 +1,36 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDrawIndex(t *testing.T) {
+	tournamentID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	seeds := []string{"alice", "", "carol", "dave"}
+
+	index := drawIndex("server-seed", tournamentID, seeds)
+	assert.True(t, index >= 0 && index < len(seeds), "index should point to a participant")
+	assert.Equal(t, index, drawIndex("server-seed", tournamentID, seeds), "draw should be deterministic")
+
+	changed := 0
+	for _, seed := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+		if drawIndex(seed, tournamentID, seeds) != index {
+			changed++
+		}
+	}
+	assert.NotZero(t, changed, "server seed should affect the draw")
+}
+
+func TestServerSeedCommitment(t *testing.T) {
+	seed, err := generateServerSeed()
+	if !assert.NoError(t, err, "seed should be generated") {
+		return
+	}
+
+	assert.Equal(t, serverSeedSize*2, len(seed), "seed should be hex encoded")
+	assert.Equal(t, hashServerSeed(seed), hashServerSeed(seed), "hash should be stable")
+	assert.NotEqual(t, seed, hashServerSeed(seed), "hash shouldn't reveal seed")
+}
@@ -1,6 +1,8 @@
 package controller
 
 import (
+	"crypto/sha256"
+	"fmt"
 	"math/rand"
 	"strings"
 	"time"
@@ -49,11 +51,22 @@ func generatePassword() string {
 	return password
 }
 
+// hashPassword hashes plain password the way it's checked on log in,
+// where the gateway sends SHA-256 digest of password instead of password itself.
 func hashPassword(password string) (string, error) {
-	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	hash, err := bcrypt.GenerateFromPassword([]byte(prehashPassword(password)), bcrypt.DefaultCost)
 	if err != nil {
 		return "", err
 	}
 
 	return string(hash), nil
 }
+
+// checkPassword compares plain password with the hash of it.
+func checkPassword(hash, password string) error {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(prehashPassword(password)))
+}
+
+func prehashPassword(password string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(password)))
+}
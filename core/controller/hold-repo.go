@@ -0,0 +1,16 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+)
+
+// HoldRepository keeps holds on balances of users. Insert of hold fails when user can't afford it.
+type HoldRepository interface {
+	Insert(ctx context.Context, store tx.DBTX, hold *models.Hold) error
+	SelectByTournament(ctx context.Context, store tx.DBTX, tournamentID uuid.UUID) ([]models.Hold, error)
+	Delete(ctx context.Context, store tx.DBTX, tournamentID, userID uuid.UUID) error
+}
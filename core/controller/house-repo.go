@@ -0,0 +1,13 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+)
+
+// HouseRepository keeps balances of the operator, which collects rake of tournaments.
+type HouseRepository interface {
+	UpdateBalanceBySum(ctx context.Context, store tx.DBTX, currency models.Currency, d models.Money) error
+}
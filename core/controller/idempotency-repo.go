@@ -0,0 +1,17 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+)
+
+// IdempotencyRepository keeps idempotency keys of requests with their outcomes.
+// Insert reports false when the key is already taken.
+type IdempotencyRepository interface {
+	Insert(ctx context.Context, store tx.DBTX, request *models.IdempotentRequest) (bool, error)
+	Select(ctx context.Context, store tx.DBTX, userID uuid.UUID, operation models.IdempotentOperation, key string) (*models.IdempotentRequest, error)
+	UpdateResponse(ctx context.Context, store tx.DBTX, request *models.IdempotentRequest) error
+}
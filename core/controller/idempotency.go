@@ -0,0 +1,97 @@
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+)
+
+const maxIdempotencyKeySize = 255
+
+// Idempotency lets clients retry requests safely: a request repeated with the same key
+// returns the outcome of the first one instead of being executed again.
+type Idempotency struct {
+	repo IdempotencyRepository
+}
+
+func NewIdempotency(repo IdempotencyRepository) *Idempotency {
+	return &Idempotency{
+		repo: repo,
+	}
+}
+
+// Do executes request and saves response as its outcome within the same database transaction.
+// Replay of the key decodes the saved outcome into response without executing request.
+// Failed requests leave no outcome, so their retry is executed again. Request without key is always executed.
+// Response is nil for requests without outcome.
+func (i *Idempotency) Do(ctx context.Context, store tx.DBTX, request *models.IdempotentRequest, response interface{}, execute func() error) error {
+	if request.Key == "" {
+		return execute()
+	}
+
+	if len(request.Key) > maxIdempotencyKeySize {
+		return kerror.Newf(kerror.BadRequest, "idempotency key should be at most %d characters", maxIdempotencyKeySize)
+	}
+
+	// The key is claimed before execution, so concurrent replay waits for the first request to finish.
+	claimed, err := i.repo.Insert(ctx, store, request)
+	if err != nil {
+		return kerror.Errorf(err, "claim idempotency key")
+	}
+
+	if !claimed {
+		return i.replay(ctx, store, request, response)
+	}
+
+	if err := execute(); err != nil {
+		return kerror.Errorf(err, "execute request")
+	}
+
+	request.Response, err = json.Marshal(response)
+	if err != nil {
+		return kerror.Newf(kerror.InternalServerError, "encode outcome of request: %v", err)
+	}
+
+	if err := i.repo.UpdateResponse(ctx, store, request); err != nil {
+		return kerror.Errorf(err, "save outcome of request")
+	}
+
+	return nil
+}
+
+func (i *Idempotency) replay(ctx context.Context, store tx.DBTX, request *models.IdempotentRequest, response interface{}) error {
+	stored, err := i.repo.Select(ctx, store, request.UserID, request.Operation, request.Key)
+	if err != nil {
+		return kerror.Errorf(err, "get idempotency key")
+	}
+
+	if stored.Hash != request.Hash {
+		return kerror.Newf(kerror.BadRequest, "idempotency key %q was used for another %v request", request.Key, request.Operation)
+	}
+
+	if response == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(stored.Response, response); err != nil {
+		return kerror.Newf(kerror.InternalServerError, "decode outcome of request: %v", err)
+	}
+
+	return nil
+}
+
+// hashRequest fingerprints the payload of request.
+func hashRequest(payload ...interface{}) string {
+	parts := make([]string, 0, len(payload))
+	for _, part := range payload {
+		parts = append(parts, fmt.Sprint(part))
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(strings.Join(parts, "\x00"))))
+}
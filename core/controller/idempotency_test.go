@@ -0,0 +1,85 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+	"github.com/stretchr/testify/assert"
+)
+
+type idempotencyKeys map[string]*models.IdempotentRequest
+
+func (k idempotencyKeys) Insert(_ context.Context, _ tx.DBTX, request *models.IdempotentRequest) (bool, error) {
+	if _, ok := k[request.Key]; ok {
+		return false, nil
+	}
+
+	stored := *request
+	k[request.Key] = &stored
+	return true, nil
+}
+
+func (k idempotencyKeys) Select(_ context.Context, _ tx.DBTX, _ uuid.UUID, _ models.IdempotentOperation, key string) (*models.IdempotentRequest, error) {
+	return k[key], nil
+}
+
+func (k idempotencyKeys) UpdateResponse(_ context.Context, _ tx.DBTX, request *models.IdempotentRequest) error {
+	k[request.Key].Response = request.Response
+	return nil
+}
+
+func TestIdempotencyDo(t *testing.T) {
+	keys := idempotencyKeys{}
+	idempotency := NewIdempotency(keys)
+
+	executions := 0
+	join := func(key, hash string) (*JoinResult, error) {
+		result := &JoinResult{}
+		err := idempotency.Do(context.Background(), nil, &models.IdempotentRequest{
+			Key:       key,
+			Operation: models.JoinOperation,
+			Hash:      hash,
+		}, result, func() error {
+			executions++
+			result.Waitlisted, result.Position = true, executions
+			return nil
+		})
+		return result, err
+	}
+
+	first, err := join("retry", hashRequest(100))
+	assert.NoError(t, err)
+
+	replayed, err := join("retry", hashRequest(100))
+	assert.NoError(t, err)
+	assert.Equal(t, first, replayed, "replay should return the first result")
+	assert.Equal(t, 1, executions, "replay shouldn't execute request")
+
+	_, err = join("retry", hashRequest(200))
+	assert.Error(t, err, "key shouldn't be reused for another payload")
+
+	_, err = join(strings.Repeat("k", maxIdempotencyKeySize+1), hashRequest(100))
+	assert.Error(t, err, "too long key should be rejected")
+
+	for i := 0; i < 2; i++ {
+		_, err := join("", hashRequest(100))
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 3, executions, "request without key should be executed every time")
+
+	err = idempotency.Do(context.Background(), nil, &models.IdempotentRequest{Key: "failed"}, nil, func() error {
+		return errors.New("failed")
+	})
+	assert.Error(t, err, "error of request should be returned")
+}
+
+func TestHashRequest(t *testing.T) {
+	assert.Equal(t, hashRequest("USD", money(10)), hashRequest("USD", money(10)), "same payload should have same hash")
+	assert.NotEqual(t, hashRequest("USD", money(10)), hashRequest("USD", money(-10)), "different payload should have different hash")
+	assert.NotEqual(t, hashRequest("ab", "c"), hashRequest("a", "bc"), "parts of payload shouldn't be mixed")
+}
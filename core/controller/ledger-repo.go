@@ -0,0 +1,15 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+)
+
+// LedgerRepository keeps the immutable record of every movement of money.
+type LedgerRepository interface {
+	InsertTransaction(ctx context.Context, store tx.DBTX, transaction *models.LedgerTransaction) error
+	SelectUserTransactions(ctx context.Context, store tx.DBTX, userID uuid.UUID, filter *models.TransactionFilter) ([]models.UserTransaction, error)
+}
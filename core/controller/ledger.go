@@ -0,0 +1,217 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+)
+
+const (
+	defaultTransactionsLimit = 50
+	maxTransactionsLimit     = 100
+)
+
+// Ledger records every movement of money as a balanced transaction
+// and keeps balances of users and the house as projections of it.
+// Holds reserve money on balances without moving it, so they aren't recorded.
+type Ledger struct {
+	repo      LedgerRepository
+	userRepo  UserRepository
+	houseRepo HouseRepository
+	holdRepo  HoldRepository
+}
+
+func NewLedger(repo LedgerRepository, userRepo UserRepository, houseRepo HouseRepository, holdRepo HoldRepository) *Ledger {
+	return &Ledger{
+		repo:      repo,
+		userRepo:  userRepo,
+		houseRepo: houseRepo,
+		holdRepo:  holdRepo,
+	}
+}
+
+// Post records transaction and applies its entries to balances within the same database transaction.
+// Transaction without entries moves nothing and isn't recorded.
+func (l *Ledger) Post(ctx context.Context, store tx.DBTX, transaction *models.LedgerTransaction) error {
+	if len(transaction.Entries) == 0 {
+		return nil
+	}
+
+	if err := validateTransaction(transaction); err != nil {
+		return kerror.Errorf(err, "validate ledger transaction")
+	}
+
+	if err := l.repo.InsertTransaction(ctx, store, transaction); err != nil {
+		return kerror.Errorf(err, "save ledger transaction")
+	}
+
+	for _, entry := range transaction.Entries {
+		switch entry.Account.Type {
+		case models.UserAccount:
+			if err := l.userRepo.UpdateBalanceBySum(ctx, store, entry.Account.ID, transaction.Currency, entry.Amount); err != nil {
+				return kerror.Errorf(err, "update balance of user(%v)", entry.Account.ID)
+			}
+		case models.HouseAccount:
+			if err := l.houseRepo.UpdateBalanceBySum(ctx, store, transaction.Currency, entry.Amount); err != nil {
+				return kerror.Errorf(err, "update house balance")
+			}
+		}
+	}
+
+	return nil
+}
+
+// Hold reserves money on balance of user. It fails when user hasn't enough available money.
+// Hold without amount reserves nothing and isn't saved.
+func (l *Ledger) Hold(ctx context.Context, store tx.DBTX, hold *models.Hold) error {
+	if hold.Amount.IsZero() {
+		return nil
+	}
+
+	if hold.Amount.IsNegative() {
+		return kerror.Newf(kerror.InternalServerError, "hold of user(%v) shouldn't be negative: %v", hold.UserID, hold.Amount)
+	}
+
+	if err := l.holdRepo.Insert(ctx, store, hold); err != nil {
+		return kerror.Errorf(err, "save hold")
+	}
+
+	return nil
+}
+
+// Holds returns the holds placed for tournament.
+func (l *Ledger) Holds(ctx context.Context, store tx.DBTX, tournamentID uuid.UUID) ([]models.Hold, error) {
+	holds, err := l.holdRepo.SelectByTournament(ctx, store, tournamentID)
+	if err != nil {
+		return nil, kerror.Errorf(err, "get holds")
+	}
+
+	return holds, nil
+}
+
+// Release gives the held money back to user.
+func (l *Ledger) Release(ctx context.Context, store tx.DBTX, hold *models.Hold) error {
+	if err := l.holdRepo.Delete(ctx, store, hold.TournamentID, hold.UserID); err != nil {
+		return kerror.Errorf(err, "delete hold")
+	}
+
+	return nil
+}
+
+// Capture releases hold and posts transaction, which spends the money that was held.
+func (l *Ledger) Capture(ctx context.Context, store tx.DBTX, hold *models.Hold, transaction *models.LedgerTransaction) error {
+	if err := l.Release(ctx, store, hold); err != nil {
+		return kerror.Errorf(err, "release hold")
+	}
+
+	if err := l.Post(ctx, store, transaction); err != nil {
+		return kerror.Errorf(err, "post captured hold")
+	}
+
+	return nil
+}
+
+// UserTransactions returns the page of movements on balance of user, the newest first.
+// The next offset is zero when there are no more pages.
+func (l *Ledger) UserTransactions(ctx context.Context, store tx.DBTX, userID uuid.UUID, filter *models.TransactionFilter) ([]models.UserTransaction, int, error) {
+	if err := prepareTransactionFilter(filter); err != nil {
+		return nil, 0, kerror.Errorf(err, "filter of transactions")
+	}
+
+	page := *filter
+	page.Limit++
+
+	transactions, err := l.repo.SelectUserTransactions(ctx, store, userID, &page)
+	if err != nil {
+		return nil, 0, kerror.Errorf(err, "get transactions of user")
+	}
+
+	if len(transactions) <= filter.Limit {
+		return transactions, 0, nil
+	}
+
+	return transactions[:filter.Limit], filter.Offset + filter.Limit, nil
+}
+
+func prepareTransactionFilter(filter *models.TransactionFilter) error {
+	for _, transactionType := range filter.Types {
+		if !transactionType.Valid() {
+			return kerror.Newf(kerror.BadRequest, "unknown type of transaction: %v", transactionType)
+		}
+	}
+
+	if filter.Currency != "" && !filter.Currency.Valid() {
+		return kerror.Newf(kerror.BadRequest, "invalid currency: %q", filter.Currency)
+	}
+
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return kerror.Newf(kerror.BadRequest, "start of range(%v) should be before its end(%v)", filter.From, filter.To)
+	}
+
+	if filter.Limit == 0 {
+		filter.Limit = defaultTransactionsLimit
+	}
+
+	if filter.Limit < 0 || filter.Limit > maxTransactionsLimit {
+		return kerror.Newf(kerror.BadRequest, "limit should be between 1 and %v", maxTransactionsLimit)
+	}
+
+	if filter.Offset < 0 {
+		return kerror.Newf(kerror.BadRequest, "offset shouldn't be negative")
+	}
+
+	return nil
+}
+
+// newTransaction builds ledger transaction, leaving out entries that move nothing.
+func newTransaction(transactionType models.TransactionType, currency models.Currency, tournamentID uuid.UUID, entries ...models.LedgerEntry) *models.LedgerTransaction {
+	transaction := &models.LedgerTransaction{
+		Type:         transactionType,
+		Currency:     currency,
+		TournamentID: tournamentID,
+	}
+
+	for _, entry := range entries {
+		if !entry.Amount.IsZero() {
+			transaction.Entries = append(transaction.Entries, entry)
+		}
+	}
+
+	return transaction
+}
+
+func validateTransaction(transaction *models.LedgerTransaction) error {
+	if !transaction.Currency.Valid() {
+		return kerror.Newf(kerror.InternalServerError, "%v transaction has invalid currency: %q", transaction.Type, transaction.Currency)
+	}
+
+	var sum models.Money
+	for _, entry := range transaction.Entries {
+		sum = sum.Add(entry.Amount)
+	}
+
+	if !sum.IsZero() {
+		return kerror.Newf(kerror.InternalServerError, "%v transaction isn't balanced: entries sum to %v", transaction.Type, sum)
+	}
+
+	return nil
+}
+
+func userEntry(userID uuid.UUID, amount models.Money) models.LedgerEntry {
+	return models.LedgerEntry{Account: models.LedgerAccount{Type: models.UserAccount, ID: userID}, Amount: amount}
+}
+
+func tournamentEntry(tournamentID uuid.UUID, amount models.Money) models.LedgerEntry {
+	return models.LedgerEntry{Account: models.LedgerAccount{Type: models.TournamentAccount, ID: tournamentID}, Amount: amount}
+}
+
+func houseEntry(amount models.Money) models.LedgerEntry {
+	return models.LedgerEntry{Account: models.LedgerAccount{Type: models.HouseAccount}, Amount: amount}
+}
+
+func externalEntry(amount models.Money) models.LedgerEntry {
+	return models.LedgerEntry{Account: models.LedgerAccount{Type: models.ExternalAccount}, Amount: amount}
+}
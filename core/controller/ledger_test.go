@@ -0,0 +1,114 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func money(amount float64) models.Money {
+	return models.MoneyFromFloat(amount)
+}
+
+func percent(percentage float64) models.Percent {
+	return models.PercentFromFloat(percentage)
+}
+
+func TestNewTransaction(t *testing.T) {
+	userID, tournamentID := uuid.New(), uuid.New()
+
+	transaction := newTransaction(models.JoinTransaction, models.DefaultCurrency, tournamentID,
+		userEntry(userID, money(-100)),
+		tournamentEntry(tournamentID, money(100)),
+		houseEntry(money(0)),
+	)
+
+	assert.Equal(t, models.JoinTransaction, transaction.Type, "type should be kept")
+	assert.Equal(t, models.DefaultCurrency, transaction.Currency, "currency should be kept")
+	assert.Equal(t, tournamentID, transaction.TournamentID, "tournament should be kept")
+	assert.Equal(t, []models.LedgerEntry{userEntry(userID, money(-100)), tournamentEntry(tournamentID, money(100))}, transaction.Entries,
+		"entries without amount should be left out")
+}
+
+func TestValidateTransaction(t *testing.T) {
+	userID, tournamentID := uuid.New(), uuid.New()
+
+	tt := []struct {
+		name    string
+		entries []models.LedgerEntry
+		valid   bool
+	}{
+		{
+			name:    "join with rake",
+			entries: []models.LedgerEntry{userEntry(userID, money(-100)), tournamentEntry(tournamentID, money(90)), houseEntry(money(10))},
+			valid:   true,
+		},
+		{
+			name:    "fractions of cents",
+			entries: []models.LedgerEntry{userEntry(userID, money(0.1)), userEntry(userID, money(0.2)), externalEntry(money(-0.3))},
+			valid:   true,
+		},
+		{
+			name:    "money from nowhere",
+			entries: []models.LedgerEntry{userEntry(userID, money(100))},
+			valid:   false,
+		},
+		{
+			name:    "lost rake",
+			entries: []models.LedgerEntry{userEntry(userID, money(-100)), tournamentEntry(tournamentID, money(90))},
+			valid:   false,
+		},
+	}
+
+	for _, tc := range tt {
+		err := validateTransaction(&models.LedgerTransaction{Type: models.JoinTransaction, Currency: models.DefaultCurrency, Entries: tc.entries})
+		if tc.valid {
+			assert.NoError(t, err, tc.name)
+		} else {
+			assert.Error(t, err, tc.name)
+		}
+	}
+
+	err := validateTransaction(&models.LedgerTransaction{
+		Type:    models.FundTransaction,
+		Entries: []models.LedgerEntry{userEntry(userID, money(100)), externalEntry(money(-100))},
+	})
+	assert.Error(t, err, "transaction without currency shouldn't be valid")
+}
+
+func TestPrepareTransactionFilter(t *testing.T) {
+	now := time.Now()
+
+	tt := []struct {
+		name   string
+		filter *models.TransactionFilter
+		valid  bool
+	}{
+		{name: "empty filter", filter: &models.TransactionFilter{}, valid: true},
+		{name: "known types", filter: &models.TransactionFilter{Types: []models.TransactionType{models.JoinTransaction, models.PrizeTransaction}}, valid: true},
+		{name: "currency", filter: &models.TransactionFilter{Currency: "CHIPS"}, valid: true},
+		{name: "invalid currency", filter: &models.TransactionFilter{Currency: "usd"}, valid: false},
+		{name: "unknown type", filter: &models.TransactionFilter{Types: []models.TransactionType{"Bonus"}}, valid: false},
+		{name: "date range", filter: &models.TransactionFilter{From: now.Add(-time.Hour), To: now}, valid: true},
+		{name: "reversed date range", filter: &models.TransactionFilter{From: now, To: now.Add(-time.Hour)}, valid: false},
+		{name: "too big page", filter: &models.TransactionFilter{Limit: maxTransactionsLimit + 1}, valid: false},
+		{name: "negative offset", filter: &models.TransactionFilter{Offset: -1}, valid: false},
+	}
+
+	for _, tc := range tt {
+		err := prepareTransactionFilter(tc.filter)
+		if tc.valid {
+			assert.NoError(t, err, tc.name)
+		} else {
+			assert.Error(t, err, tc.name)
+		}
+	}
+
+	filter := &models.TransactionFilter{}
+	if assert.NoError(t, prepareTransactionFilter(filter)) {
+		assert.Equal(t, defaultTransactionsLimit, filter.Limit, "page should have default size")
+	}
+}
@@ -0,0 +1,20 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+)
+
+// LimitRepository keeps self-limits and self-exclusion of users and counts the money limits apply to.
+type LimitRepository interface {
+	SelectByUser(ctx context.Context, store tx.DBTX, userID uuid.UUID) ([]models.Limit, error)
+	Upsert(ctx context.Context, store tx.DBTX, limit *models.Limit) error
+	SelectExclusion(ctx context.Context, store tx.DBTX, userID uuid.UUID) (time.Time, error)
+	UpdateExclusion(ctx context.Context, store tx.DBTX, userID uuid.UUID, until time.Time) error
+	SumDeposits(ctx context.Context, store tx.DBTX, userID uuid.UUID, currency models.Currency, since time.Time) (models.Money, error)
+	SumEntries(ctx context.Context, store tx.DBTX, userID uuid.UUID, currency models.Currency, since time.Time) (models.Money, error)
+}
@@ -0,0 +1,191 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+)
+
+// Limits enforces self-limits of users on deposits and entries of tournaments and their self-exclusion.
+// Stricter limit takes effect immediately, looser one only after the cooling-off period,
+// so limit can't be lifted on impulse.
+type Limits struct {
+	repo       LimitRepository
+	coolingOff time.Duration
+}
+
+func NewLimits(repo LimitRepository, coolingOff time.Duration) *Limits {
+	return &Limits{
+		repo:       repo,
+		coolingOff: coolingOff,
+	}
+}
+
+// Set changes limit of user and returns it the way it's saved.
+func (l *Limits) Set(ctx context.Context, store tx.DBTX, limit *models.Limit) (*models.Limit, error) {
+	if !limit.Kind.Valid() {
+		return nil, kerror.Newf(kerror.BadRequest, "unknown kind of limit: %q", limit.Kind)
+	}
+
+	if !limit.Period.Valid() {
+		return nil, kerror.Newf(kerror.BadRequest, "unknown period of limit: %q", limit.Period)
+	}
+
+	if !limit.Currency.Valid() {
+		return nil, kerror.Newf(kerror.BadRequest, "invalid currency of limit: %q", limit.Currency)
+	}
+
+	if limit.Amount.IsNegative() {
+		return nil, kerror.Newf(kerror.BadRequest, "limit shouldn't be negative")
+	}
+
+	limits, err := l.repo.SelectByUser(ctx, store, limit.UserID)
+	if err != nil {
+		return nil, kerror.Errorf(err, "get limits of user")
+	}
+
+	current := &models.Limit{
+		UserID:   limit.UserID,
+		Kind:     limit.Kind,
+		Period:   limit.Period,
+		Currency: limit.Currency,
+	}
+	for i := range limits {
+		if limits[i].Kind == limit.Kind && limits[i].Period == limit.Period && limits[i].Currency == limit.Currency {
+			current = &limits[i]
+		}
+	}
+
+	changed := changeLimit(current, limit.Amount, time.Now(), l.coolingOff)
+	if err := l.repo.Upsert(ctx, store, changed); err != nil {
+		return nil, kerror.Errorf(err, "save limit")
+	}
+
+	return changed, nil
+}
+
+// changeLimit sets stricter amount at once and postpones looser one until the cooling-off period ends.
+// Setting the amount in force drops the postponed change.
+func changeLimit(current *models.Limit, amount models.Money, now time.Time, coolingOff time.Duration) *models.Limit {
+	changed := *current
+	changed.Amount, changed.PendingAmount, changed.PendingFrom = current.At(now), models.Money{}, time.Time{}
+
+	if amount == changed.Amount {
+		return &changed
+	}
+
+	if isStricter(amount, changed.Amount) {
+		changed.Amount = amount
+		return &changed
+	}
+
+	changed.PendingAmount, changed.PendingFrom = amount, now.Add(coolingOff)
+	return &changed
+}
+
+// isStricter reports whether limit a allows less than limit b. Zero limit allows everything.
+func isStricter(a, b models.Money) bool {
+	if a.IsZero() {
+		return false
+	}
+
+	return b.IsZero() || a.Less(b)
+}
+
+// Get returns limits and self-exclusion of user.
+func (l *Limits) Get(ctx context.Context, store tx.DBTX, userID uuid.UUID) (*models.SelfLimits, error) {
+	excludedUntil, err := l.repo.SelectExclusion(ctx, store, userID)
+	if err != nil {
+		return nil, kerror.Errorf(err, "get self-exclusion")
+	}
+
+	limits, err := l.repo.SelectByUser(ctx, store, userID)
+	if err != nil {
+		return nil, kerror.Errorf(err, "get limits")
+	}
+
+	return &models.SelfLimits{
+		Limits:        limits,
+		ExcludedUntil: excludedUntil,
+	}, nil
+}
+
+// Exclude blocks entries of user into tournaments until the moment. Exclusion can be extended, but not shortened.
+func (l *Limits) Exclude(ctx context.Context, store tx.DBTX, userID uuid.UUID, until time.Time) error {
+	if !until.After(time.Now()) {
+		return kerror.Newf(kerror.BadRequest, "end of self-exclusion should be in the future")
+	}
+
+	current, err := l.repo.SelectExclusion(ctx, store, userID)
+	if err != nil {
+		return kerror.Errorf(err, "get self-exclusion")
+	}
+
+	if until.Before(current) {
+		return kerror.Newf(kerror.BadRequest, "self-exclusion until %v can't be shortened", current)
+	}
+
+	if err := l.repo.UpdateExclusion(ctx, store, userID, until); err != nil {
+		return kerror.Errorf(err, "save self-exclusion")
+	}
+
+	return nil
+}
+
+// CheckDeposit fails when deposit of amount breaks one of the limits of user on deposits.
+func (l *Limits) CheckDeposit(ctx context.Context, store tx.DBTX, userID uuid.UUID, currency models.Currency, amount models.Money) error {
+	return l.check(ctx, store, userID, models.DepositLimit, currency, amount, l.repo.SumDeposits)
+}
+
+// CheckEntry fails when user is excluded or entry with stake breaks one of the limits of user on entries.
+func (l *Limits) CheckEntry(ctx context.Context, store tx.DBTX, userID uuid.UUID, currency models.Currency, stake models.Money) error {
+	excludedUntil, err := l.repo.SelectExclusion(ctx, store, userID)
+	if err != nil {
+		return kerror.Errorf(err, "get self-exclusion")
+	}
+
+	if time.Now().Before(excludedUntil) {
+		return kerror.Newf(kerror.LimitExceeded, "user(%v) is self-excluded until %v", userID, excludedUntil)
+	}
+
+	return l.check(ctx, store, userID, models.EntryLimit, currency, stake, l.repo.SumEntries)
+}
+
+type sumFunc func(ctx context.Context, store tx.DBTX, userID uuid.UUID, currency models.Currency, since time.Time) (models.Money, error)
+
+// check locks limits of user, so concurrent requests are checked one after another.
+func (l *Limits) check(ctx context.Context, store tx.DBTX, userID uuid.UUID, kind models.LimitKind, currency models.Currency, amount models.Money, sum sumFunc) error {
+	limits, err := l.repo.SelectByUser(ctx, store, userID)
+	if err != nil {
+		return kerror.Errorf(err, "get limits of user")
+	}
+
+	now := time.Now()
+	for i := range limits {
+		limit := &limits[i]
+		if limit.Kind != kind || limit.Currency != currency {
+			continue
+		}
+
+		max := limit.At(now)
+		if max.IsZero() {
+			continue
+		}
+
+		used, err := sum(ctx, store, userID, currency, limit.Period.Since(now))
+		if err != nil {
+			return kerror.Errorf(err, "count money under %v limit", kind)
+		}
+
+		if max.Less(used.Add(amount)) {
+			return kerror.Newf(kerror.LimitExceeded, "%v limit of %v %v per %v would be exceeded: %v is already used",
+				kind, max, currency, limit.Period, used)
+		}
+	}
+
+	return nil
+}
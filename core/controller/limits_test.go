@@ -0,0 +1,78 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangeLimit(t *testing.T) {
+	now := time.Now()
+	coolingOff := 24 * time.Hour
+
+	tt := []struct {
+		name    string
+		current models.Limit
+		amount  models.Money
+		want    models.Limit
+	}{
+		{
+			name:    "new limit applies immediately",
+			current: models.Limit{},
+			amount:  money(100),
+			want:    models.Limit{Amount: money(100)},
+		},
+		{
+			name:    "lowered limit applies immediately",
+			current: models.Limit{Amount: money(100)},
+			amount:  money(50),
+			want:    models.Limit{Amount: money(50)},
+		},
+		{
+			name:    "raised limit waits for cooling-off",
+			current: models.Limit{Amount: money(100)},
+			amount:  money(200),
+			want:    models.Limit{Amount: money(100), PendingAmount: money(200), PendingFrom: now.Add(coolingOff)},
+		},
+		{
+			name:    "removed limit waits for cooling-off",
+			current: models.Limit{Amount: money(100)},
+			amount:  models.Money{},
+			want:    models.Limit{Amount: money(100), PendingFrom: now.Add(coolingOff)},
+		},
+		{
+			name:    "lowered limit drops pending raise",
+			current: models.Limit{Amount: money(100), PendingAmount: money(200), PendingFrom: now.Add(time.Hour)},
+			amount:  money(80),
+			want:    models.Limit{Amount: money(80)},
+		},
+		{
+			name:    "limit in force drops pending raise",
+			current: models.Limit{Amount: money(100), PendingAmount: money(200), PendingFrom: now.Add(time.Hour)},
+			amount:  money(100),
+			want:    models.Limit{Amount: money(100)},
+		},
+		{
+			name:    "due raise is in force",
+			current: models.Limit{Amount: money(100), PendingAmount: money(200), PendingFrom: now.Add(-time.Hour)},
+			amount:  money(150),
+			want:    models.Limit{Amount: money(150)},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, &tc.want, changeLimit(&tc.current, tc.amount, now, coolingOff))
+		})
+	}
+}
+
+func TestLimitAt(t *testing.T) {
+	now := time.Now()
+	limit := &models.Limit{Amount: money(100), PendingAmount: money(200), PendingFrom: now}
+
+	assert.Equal(t, money(100), limit.At(now.Add(-time.Second)), "raise shouldn't apply before cooling-off ends")
+	assert.Equal(t, money(200), limit.At(now), "raise should apply when cooling-off ends")
+}
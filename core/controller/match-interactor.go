@@ -0,0 +1,306 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+)
+
+const minPlayersToStart = 2
+
+func (tu *TournamentInteractor) Start(ctx context.Context, id uuid.UUID) error {
+	err := tu.store.WithTransaction(func(store tx.DBTX) error {
+		tournament, err := tu.lockTournament(ctx, store, id)
+		if err != nil {
+			return kerror.Errorf(err, "lock tournament")
+		}
+
+		return tu.start(ctx, store, tournament)
+	})
+	if err != nil {
+		return kerror.Errorf(err, "execution transaction")
+	}
+
+	return nil
+}
+
+// start charges the held stakes, schedules the first matches of tournament, if its format has them, and moves it to InProgress.
+func (tu *TournamentInteractor) start(ctx context.Context, store tx.DBTX, tournament *models.Tournament) error {
+	if !tournament.Status.CanBecome(models.InProgress) {
+		return kerror.Newf(kerror.BadRequest, "%v tournament can't be started", tournament.Status)
+	}
+
+	if len(tournament.Users) < playersToStart(tournament) {
+		return kerror.Newf(kerror.BadRequest, "tournament should have at least %d players", playersToStart(tournament))
+	}
+
+	if err := tu.capture(ctx, store, tournament); err != nil {
+		return kerror.Errorf(err, "charge stakes")
+	}
+
+	if tournament.Format.HasMatches() {
+		if err := tu.scheduleFirstMatches(ctx, store, tournament); err != nil {
+			return kerror.Errorf(err, "schedule matches")
+		}
+	}
+
+	if err := tu.changeStatus(ctx, store, tournament, models.InProgress); err != nil {
+		return kerror.Errorf(err, "change status")
+	}
+
+	return nil
+}
+
+// playersToStart returns how many players tournament needs to schedule its matches.
+func playersToStart(tournament *models.Tournament) int {
+	if tournament.MinPlayers > minPlayersToStart {
+		return tournament.MinPlayers
+	}
+
+	return minPlayersToStart
+}
+
+func (tu *TournamentInteractor) scheduleFirstMatches(ctx context.Context, store tx.DBTX, tournament *models.Tournament) error {
+	seeded := shufflePlayers(playersOf(tournament))
+
+	var matches []*models.Match
+	switch tournament.Format {
+	case models.RoundRobin:
+		matches = roundRobinSchedule(tournament.ID, seeded)
+	case models.Swiss:
+		matches = pairSwissRound(tournament.ID, 1, seeded, nil)
+	default:
+		matches = buildBracket(tournament.ID, seeded)
+	}
+
+	return tu.insertMatches(ctx, store, matches)
+}
+
+func playersOf(tournament *models.Tournament) []uuid.UUID {
+	players := make([]uuid.UUID, 0, len(tournament.Users))
+	for _, user := range tournament.Users {
+		players = append(players, user.ID)
+	}
+
+	return players
+}
+
+func (tu *TournamentInteractor) insertMatches(ctx context.Context, store tx.DBTX, matches []*models.Match) error {
+	for _, match := range matches {
+		if _, err := tu.matchRepo.Insert(ctx, store, match); err != nil {
+			return kerror.Errorf(err, "insert match(round: %v, position: %v)", match.Round, match.Position)
+		}
+	}
+
+	return nil
+}
+
+func (tu *TournamentInteractor) GetMatches(ctx context.Context, id uuid.UUID) ([]models.Match, error) {
+	var matches []models.Match
+
+	err := tu.store.WithTransaction(func(store tx.DBTX) error {
+		if _, err := tu.repo.SelectByID(ctx, store, id); err != nil {
+			return kerror.Errorf(err, "get tournament")
+		}
+
+		var err error
+
+		matches, err = tu.matchRepo.SelectByTournament(ctx, store, id)
+		if err != nil {
+			return kerror.Errorf(err, "repository")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, kerror.Errorf(err, "execution transaction")
+	}
+
+	return matches, nil
+}
+
+func (tu *TournamentInteractor) GetStandings(ctx context.Context, id uuid.UUID) ([]models.Standing, error) {
+	var standings []models.Standing
+
+	err := tu.store.WithTransaction(func(store tx.DBTX) error {
+		tournament, err := tu.repo.SelectByID(ctx, store, id)
+		if err != nil {
+			return kerror.Errorf(err, "get tournament")
+		}
+
+		matches, err := tu.matchRepo.SelectByTournament(ctx, store, id)
+		if err != nil {
+			return kerror.Errorf(err, "get matches")
+		}
+
+		standings = computeStandings(playersOf(tournament), matches, tournament.Tiebreakers)
+
+		return nil
+	})
+	if err != nil {
+		return nil, kerror.Errorf(err, "execution transaction")
+	}
+
+	return standings, nil
+}
+
+func (tu *TournamentInteractor) ReportMatchResult(ctx context.Context, tournamentID, matchID, winnerID uuid.UUID, draw bool) error {
+	err := tu.store.WithTransaction(func(store tx.DBTX) error {
+		tournament, err := tu.lockTournament(ctx, store, tournamentID)
+		if err != nil {
+			return kerror.Errorf(err, "lock tournament")
+		}
+
+		if tournament.Status != models.InProgress {
+			return kerror.Newf(kerror.BadRequest, "tournament isn't in progress")
+		}
+
+		match, err := tu.matchRepo.SelectByID(ctx, store, matchID)
+		if err != nil {
+			return kerror.Errorf(err, "get match")
+		}
+
+		if err := validateMatchResult(tournament, match, winnerID, draw); err != nil {
+			return kerror.Errorf(err, "validate result")
+		}
+
+		match.Winner = winnerID
+		match.Draw = draw
+		if err := tu.matchRepo.Update(ctx, store, match); err != nil {
+			return kerror.Errorf(err, "save result of match")
+		}
+
+		switch tournament.Format {
+		case models.SingleElimination:
+			err = tu.advanceBracket(ctx, store, tournament, match)
+		case models.Swiss:
+			err = tu.pairNextSwissRound(ctx, store, tournament, match.Round)
+		}
+		if err != nil {
+			return kerror.Errorf(err, "advance tournament")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return kerror.Errorf(err, "execution transaction")
+	}
+
+	return nil
+}
+
+func validateMatchResult(tournament *models.Tournament, match *models.Match, winnerID uuid.UUID, draw bool) error {
+	if match.TournamentID != tournament.ID {
+		return kerror.Newf(kerror.NotFound, "match(%v) doesn't belong to tournament(%v)", match.ID, tournament.ID)
+	}
+
+	if match.IsDecided() {
+		return kerror.Newf(kerror.BadRequest, "match already has a result")
+	}
+
+	if !match.IsReady() {
+		return kerror.Newf(kerror.BadRequest, "match is waiting for players")
+	}
+
+	if draw {
+		if !tournament.Format.HasStandings() {
+			return kerror.Newf(kerror.BadRequest, "%v tournament doesn't allow draws", tournament.Format)
+		}
+
+		if winnerID != uuid.Nil {
+			return kerror.Newf(kerror.BadRequest, "drawn match can't have a winner")
+		}
+
+		return nil
+	}
+
+	if !match.IsPlayer(winnerID) {
+		return kerror.Newf(kerror.BadRequest, "user(%v) isn't a player of match", winnerID)
+	}
+
+	return nil
+}
+
+func (tu *TournamentInteractor) advanceBracket(ctx context.Context, store tx.DBTX, tournament *models.Tournament, match *models.Match) error {
+	if match.Round == bracketRounds(len(tournament.Users)) {
+		matches, err := tu.matchRepo.SelectByTournament(ctx, store, tournament.ID)
+		if err != nil {
+			return kerror.Errorf(err, "get matches")
+		}
+
+		if err := tu.rewardPlaces(ctx, store, tournament, eliminationRanking(matches)); err != nil {
+			return kerror.Errorf(err, "reward places after final")
+		}
+
+		return nil
+	}
+
+	nextRound, nextPosition := nextMatchOf(match)
+
+	next, err := tu.matchRepo.SelectByPosition(ctx, store, match.TournamentID, nextRound, nextPosition)
+	if err != nil {
+		return kerror.Errorf(err, "get next match")
+	}
+
+	placeInMatch(next, match)
+	if err := tu.matchRepo.Update(ctx, store, next); err != nil {
+		return kerror.Errorf(err, "place winner in next match")
+	}
+
+	return nil
+}
+
+func (tu *TournamentInteractor) pairNextSwissRound(ctx context.Context, store tx.DBTX, tournament *models.Tournament, round int) error {
+	if round >= swissRounds(tournament) {
+		return nil
+	}
+
+	matches, err := tu.matchRepo.SelectByTournament(ctx, store, tournament.ID)
+	if err != nil {
+		return kerror.Errorf(err, "get matches")
+	}
+
+	for _, match := range matches {
+		if match.Round == round && !match.IsDecided() {
+			return nil
+		}
+	}
+
+	standings := computeStandings(playersOf(tournament), matches, tournament.Tiebreakers)
+	next := pairSwissRound(tournament.ID, round+1, rankedPlayers(standings), matches)
+
+	return tu.insertMatches(ctx, store, next)
+}
+
+// finalStandings returns players ranked by standings once every scheduled match is played.
+func (tu *TournamentInteractor) finalStandings(ctx context.Context, store tx.DBTX, tournament *models.Tournament) ([]uuid.UUID, error) {
+	matches, err := tu.matchRepo.SelectByTournament(ctx, store, tournament.ID)
+	if err != nil {
+		return nil, kerror.Errorf(err, "get matches")
+	}
+
+	playedRounds := 0
+	for _, match := range matches {
+		if !match.IsDecided() {
+			return nil, kerror.Newf(kerror.BadRequest, "match(%v) of round %v isn't played", match.ID, match.Round)
+		}
+
+		if match.Round > playedRounds {
+			playedRounds = match.Round
+		}
+	}
+
+	if tournament.Format == models.Swiss && playedRounds < swissRounds(tournament) {
+		return nil, kerror.Newf(kerror.BadRequest, "only %v of %v rounds are played", playedRounds, swissRounds(tournament))
+	}
+
+	standings := computeStandings(playersOf(tournament), matches, tournament.Tiebreakers)
+	if len(standings) == 0 {
+		return nil, kerror.Newf(kerror.BadRequest, "tournament doesn't have players")
+	}
+
+	return rankedPlayers(standings), nil
+}
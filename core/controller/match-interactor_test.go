@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlayersToStart(t *testing.T) {
+	assert.Equal(t, minPlayersToStart, playersToStart(&models.Tournament{}), "matches need at least two players")
+	assert.Equal(t, minPlayersToStart, playersToStart(&models.Tournament{MinPlayers: 1}), "matches need at least two players")
+	assert.Equal(t, 8, playersToStart(&models.Tournament{MinPlayers: 8}), "minimum of tournament should be respected")
+}
+
+func TestCheckScoreReport(t *testing.T) {
+	tt := []struct {
+		name   string
+		status models.TournamentStatus
+		format models.TournamentFormat
+		valid  bool
+	}{
+		{name: "registration", status: models.RegistrationOpen, format: models.SingleElimination, valid: true},
+		{name: "closed registration", status: models.RegistrationClosed, format: models.RoundRobin, valid: true},
+		{name: "started without matches", status: models.InProgress, format: models.Unpaired, valid: true},
+		{name: "started bracket", status: models.InProgress, format: models.SingleElimination},
+		{name: "started round-robin", status: models.InProgress, format: models.RoundRobin},
+		{name: "started swiss", status: models.InProgress, format: models.Swiss},
+		{name: "finished", status: models.Finished, format: models.Unpaired},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkScoreReport(&models.Tournament{Status: tc.status, Format: tc.format})
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+)
+
+type MatchRepository interface {
+	Insert(ctx context.Context, repo tx.DBTX, match *models.Match) (uuid.UUID, error)
+
+	SelectByID(ctx context.Context, repo tx.DBTX, id uuid.UUID) (*models.Match, error)
+	SelectByPosition(ctx context.Context, repo tx.DBTX, tournamentID uuid.UUID, round, position int) (*models.Match, error)
+	SelectByTournament(ctx context.Context, repo tx.DBTX, tournamentID uuid.UUID) ([]models.Match, error)
+
+	Update(ctx context.Context, repo tx.DBTX, match *models.Match) error
+}
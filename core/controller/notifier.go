@@ -0,0 +1,13 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/kimbellG/tournament/core/models"
+)
+
+// Notifier delivers messages to users outside of the service.
+type Notifier interface {
+	NotifyPasswordReset(ctx context.Context, user *models.User, token string, expiresAt time.Time) error
+}
@@ -0,0 +1,16 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+)
+
+// PasswordResetRepository keeps hashed tokens of password reset.
+type PasswordResetRepository interface {
+	Insert(ctx context.Context, store tx.DBTX, reset *models.PasswordReset) error
+	Take(ctx context.Context, store tx.DBTX, tokenHash string) (*models.PasswordReset, error)
+	DeleteByUser(ctx context.Context, store tx.DBTX, userID uuid.UUID) error
+}
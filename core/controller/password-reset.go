@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+)
+
+// PasswordResets issues single-use tokens, which let users set new password without the old one.
+// Tokens expire after ttl and are delivered by notifier.
+type PasswordResets struct {
+	repo     PasswordResetRepository
+	notifier Notifier
+	ttl      time.Duration
+}
+
+func NewPasswordResets(repo PasswordResetRepository, notifier Notifier, ttl time.Duration) *PasswordResets {
+	return &PasswordResets{
+		repo:     repo,
+		notifier: notifier,
+		ttl:      ttl,
+	}
+}
+
+// Issue saves hash of new token for user and returns the token itself.
+func (pr *PasswordResets) Issue(ctx context.Context, store tx.DBTX, userID uuid.UUID) (string, *models.PasswordReset, error) {
+	token, err := generateToken()
+	if err != nil {
+		return "", nil, kerror.Errorf(err, "generate token")
+	}
+
+	reset := &models.PasswordReset{
+		TokenHash: hashToken(token),
+		UserID:    userID,
+		ExpiresAt: time.Now().Add(pr.ttl),
+	}
+	if err := pr.repo.Insert(ctx, store, reset); err != nil {
+		return "", nil, kerror.Errorf(err, "save token")
+	}
+
+	return token, reset, nil
+}
+
+// Notify sends token to user.
+func (pr *PasswordResets) Notify(ctx context.Context, user *models.User, token string, reset *models.PasswordReset) error {
+	if err := pr.notifier.NotifyPasswordReset(ctx, user, token, reset.ExpiresAt); err != nil {
+		return kerror.Newf(kerror.InternalServerError, "notify user(%v) about password reset: %v", user.ID, err)
+	}
+
+	return nil
+}
+
+// Consume uses up token and returns the user it was issued for. Other tokens of the user are revoked,
+// so only one reset is made with tokens requested before it.
+func (pr *PasswordResets) Consume(ctx context.Context, store tx.DBTX, token string) (uuid.UUID, error) {
+	reset, err := pr.repo.Take(ctx, store, hashToken(token))
+	if err != nil {
+		return uuid.Nil, kerror.Errorf(err, "take token")
+	}
+
+	if reset == nil || !time.Now().Before(reset.ExpiresAt) {
+		return uuid.Nil, kerror.Newf(kerror.BadRequest, "reset token is invalid or expired")
+	}
+
+	if err := pr.repo.DeleteByUser(ctx, store, reset.UserID); err != nil {
+		return uuid.Nil, kerror.Errorf(err, "revoke other tokens")
+	}
+
+	return reset.UserID, nil
+}
@@ -0,0 +1,80 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+	"github.com/stretchr/testify/assert"
+)
+
+type passwordResets map[string]models.PasswordReset
+
+func (pr passwordResets) Insert(_ context.Context, _ tx.DBTX, reset *models.PasswordReset) error {
+	pr[reset.TokenHash] = *reset
+	return nil
+}
+
+func (pr passwordResets) Take(_ context.Context, _ tx.DBTX, tokenHash string) (*models.PasswordReset, error) {
+	reset, ok := pr[tokenHash]
+	if !ok {
+		return nil, nil
+	}
+	delete(pr, tokenHash)
+
+	return &reset, nil
+}
+
+func (pr passwordResets) DeleteByUser(_ context.Context, _ tx.DBTX, userID uuid.UUID) error {
+	for hash, reset := range pr {
+		if reset.UserID == userID {
+			delete(pr, hash)
+		}
+	}
+
+	return nil
+}
+
+func TestPasswordResets(t *testing.T) {
+	repo := passwordResets{}
+	resets := NewPasswordResets(repo, nil, time.Hour)
+	userID, otherID := uuid.New(), uuid.New()
+
+	first, _, err := resets.Issue(context.Background(), nil, userID)
+	assert.NoError(t, err)
+	second, _, err := resets.Issue(context.Background(), nil, userID)
+	assert.NoError(t, err)
+	other, _, err := resets.Issue(context.Background(), nil, otherID)
+	assert.NoError(t, err)
+
+	assert.NotEqual(t, first, second, "tokens should be random")
+	assert.NotContains(t, repo, first, "token should be kept hashed")
+
+	id, err := resets.Consume(context.Background(), nil, first)
+	if assert.NoError(t, err) {
+		assert.Equal(t, userID, id)
+	}
+
+	_, err = resets.Consume(context.Background(), nil, first)
+	assert.Error(t, err, "token should be used once")
+	_, err = resets.Consume(context.Background(), nil, second)
+	assert.Error(t, err, "reset should revoke other tokens of user")
+
+	id, err = resets.Consume(context.Background(), nil, other)
+	if assert.NoError(t, err, "reset shouldn't revoke tokens of other users") {
+		assert.Equal(t, otherID, id)
+	}
+}
+
+func TestExpiredPasswordReset(t *testing.T) {
+	resets := NewPasswordResets(passwordResets{}, nil, -time.Second)
+
+	token, _, err := resets.Issue(context.Background(), nil, uuid.New())
+	assert.NoError(t, err)
+
+	_, err = resets.Consume(context.Background(), nil, token)
+	assert.Error(t, err, "expired token shouldn't be accepted")
+}
@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"github.com/google/uuid"
+	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/core/models"
+)
+
+func sumOf(amounts []models.Money) models.Money {
+	var sum models.Money
+	for _, amount := range amounts {
+		sum = sum.Add(amount)
+	}
+
+	return sum
+}
+
+func sumOfPercents(percents []models.Percent) models.Percent {
+	var sum models.Percent
+	for _, percent := range percents {
+		sum += percent
+	}
+
+	return sum
+}
+
+func validatePayouts(tournament *models.Tournament) error {
+	switch tournament.PayoutType {
+	case models.PercentagePayout:
+		for i, share := range tournament.Payouts {
+			if share <= 0 {
+				return kerror.Newf(kerror.BadRequest, "payout of %v place should be positive", i+1)
+			}
+		}
+
+		if sum := sumOfPercents(tournament.Payouts); sum != models.FullPercent {
+			return kerror.Newf(kerror.BadRequest, "percentages of payout should sum to %v, got %v", models.FullPercent, sum)
+		}
+	case models.FixedPayout:
+		if len(tournament.PayoutAmounts) == 0 {
+			return kerror.Newf(kerror.BadRequest, "fixed payouts should give prize to at least one place")
+		}
+
+		for i, prize := range tournament.PayoutAmounts {
+			if !prize.IsPositive() {
+				return kerror.Newf(kerror.BadRequest, "payout of %v place should be positive", i+1)
+			}
+		}
+	default:
+		return kerror.Newf(kerror.BadRequest, "unknown payout type: %v", tournament.PayoutType)
+	}
+
+	return nil
+}
+
+// distributePrize splits prize between ranked players by payout structure of tournament.
+// Shares of places that nobody took and the rounding remainder go to the winner.
+func distributePrize(tournament *models.Tournament, ranking []uuid.UUID) ([]models.Placement, error) {
+	if len(ranking) == 0 {
+		return nil, kerror.Newf(kerror.BadRequest, "ranking of tournament is empty")
+	}
+
+	prizes := tournament.PayoutAmounts
+	if tournament.PayoutType == models.FixedPayout {
+		if sumOf(prizes) != tournament.Prize {
+			return nil, kerror.Newf(kerror.BadRequest, "fixed payouts(%v) don't sum to prize pool(%v)", sumOf(prizes), tournament.Prize)
+		}
+	} else {
+		payouts := tournament.Payouts
+		if len(payouts) == 0 {
+			payouts = models.DefaultPayouts
+		}
+
+		prizes = make([]models.Money, 0, len(payouts))
+		for _, share := range payouts {
+			prizes = append(prizes, tournament.Prize.Percent(share))
+		}
+	}
+
+	placements := make([]models.Placement, 0, len(prizes))
+	var paid models.Money
+	for i := 0; i < len(prizes) && i < len(ranking); i++ {
+		placements = append(placements, models.Placement{
+			Place:  i + 1,
+			UserID: ranking[i],
+			Prize:  prizes[i],
+		})
+		paid = paid.Add(prizes[i])
+	}
+
+	if len(placements) == 0 {
+		return nil, kerror.Newf(kerror.BadRequest, "tournament has no paid places")
+	}
+
+	placements[0].Prize = placements[0].Prize.Add(tournament.Prize.Sub(paid))
+
+	return placements, nil
+}
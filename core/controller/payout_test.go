@@ -0,0 +1,108 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePayouts(t *testing.T) {
+	tt := []struct {
+		name       string
+		tournament *models.Tournament
+		valid      bool
+	}{
+		{name: "percentages sum to 100", tournament: &models.Tournament{PayoutType: models.PercentagePayout, Payouts: percents(50, 30, 20)}, valid: true},
+		{name: "percentages don't sum to 100", tournament: &models.Tournament{PayoutType: models.PercentagePayout, Payouts: percents(50, 30)}, valid: false},
+		{name: "fractional percentages", tournament: &models.Tournament{PayoutType: models.PercentagePayout, Payouts: percents(33.33, 33.33, 33.34)}, valid: true},
+		{name: "negative share", tournament: &models.Tournament{PayoutType: models.PercentagePayout, Payouts: percents(120, -20)}, valid: false},
+		{name: "fixed amounts", tournament: &models.Tournament{PayoutType: models.FixedPayout, PayoutAmounts: []models.Money{money(500), money(200)}}, valid: true},
+		{name: "fixed without amounts", tournament: &models.Tournament{PayoutType: models.FixedPayout}, valid: false},
+		{name: "negative fixed amount", tournament: &models.Tournament{PayoutType: models.FixedPayout, PayoutAmounts: []models.Money{money(-5)}}, valid: false},
+		{name: "unknown type", tournament: &models.Tournament{PayoutType: "Tickets", Payouts: percents(100)}, valid: false},
+	}
+
+	for _, tc := range tt {
+		err := validatePayouts(tc.tournament)
+		if tc.valid {
+			assert.NoError(t, err, tc.name)
+		} else {
+			assert.Error(t, err, tc.name)
+		}
+	}
+}
+
+func percents(percentages ...float64) []models.Percent {
+	shares := make([]models.Percent, 0, len(percentages))
+	for _, percentage := range percentages {
+		shares = append(shares, percent(percentage))
+	}
+
+	return shares
+}
+
+func TestDistributePrize(t *testing.T) {
+	first, second, third := uuid.New(), uuid.New(), uuid.New()
+
+	placements, err := distributePrize(&models.Tournament{
+		Prize:      money(1000),
+		PayoutType: models.PercentagePayout,
+		Payouts:    percents(50, 30, 20),
+	}, []uuid.UUID{first, second, third})
+	if assert.NoError(t, err, "prize should be distributed") {
+		assert.Equal(t, []models.Placement{
+			{Place: 1, UserID: first, Prize: money(500)},
+			{Place: 2, UserID: second, Prize: money(300)},
+			{Place: 3, UserID: third, Prize: money(200)},
+		}, placements, "every place should get its percentage")
+	}
+
+	placements, err = distributePrize(&models.Tournament{
+		Prize:      money(100),
+		PayoutType: models.PercentagePayout,
+		Payouts:    percents(50, 30, 20),
+	}, []uuid.UUID{first, second})
+	if assert.NoError(t, err, "prize should be distributed") {
+		assert.Equal(t, []models.Placement{
+			{Place: 1, UserID: first, Prize: money(70)},
+			{Place: 2, UserID: second, Prize: money(30)},
+		}, placements, "share of free place should go to winner")
+	}
+
+	placements, err = distributePrize(&models.Tournament{
+		Prize:      money(100),
+		PayoutType: models.PercentagePayout,
+		Payouts:    percents(33.33, 33.33, 33.34),
+	}, []uuid.UUID{first, second, third})
+	if assert.NoError(t, err, "prize should be distributed") {
+		var paid models.Money
+		for _, placement := range placements {
+			paid = paid.Add(placement.Prize)
+		}
+		assert.Equal(t, money(100), paid, "whole prize should be paid")
+	}
+
+	placements, err = distributePrize(&models.Tournament{
+		Prize:         money(100.01),
+		PayoutType:    models.FixedPayout,
+		PayoutAmounts: []models.Money{money(70.01), money(30)},
+	}, []uuid.UUID{first, second, third})
+	if assert.NoError(t, err, "prize should be distributed") {
+		assert.Equal(t, []models.Placement{
+			{Place: 1, UserID: first, Prize: money(70.01)},
+			{Place: 2, UserID: second, Prize: money(30)},
+		}, placements, "places should get fixed prizes exactly")
+	}
+
+	_, err = distributePrize(&models.Tournament{
+		Prize:         money(100),
+		PayoutType:    models.FixedPayout,
+		PayoutAmounts: []models.Money{money(60), money(30)},
+	}, []uuid.UUID{first, second})
+	assert.Error(t, err, "fixed payouts should sum to prize pool")
+
+	_, err = distributePrize(&models.Tournament{Prize: money(100)}, nil)
+	assert.Error(t, err, "prize can't be distributed without ranking")
+}
@@ -0,0 +1,40 @@
+package controller
+
+import (
+	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/core/models"
+)
+
+func validateRake(tournament *models.Tournament) error {
+	switch tournament.RakeType {
+	case models.PercentageRake:
+		if tournament.Rake < 0 {
+			return kerror.Newf(kerror.BadRequest, "rake should be positive")
+		}
+
+		if tournament.Rake >= models.FullPercent {
+			return kerror.Newf(kerror.BadRequest, "rake should be less than %v percent", models.FullPercent)
+		}
+	case models.FixedRake:
+		if tournament.RakeAmount.IsNegative() {
+			return kerror.Newf(kerror.BadRequest, "rake should be positive")
+		}
+
+		if tournament.Deposit.Less(tournament.RakeAmount) {
+			return kerror.Newf(kerror.BadRequest, "rake(%v) shouldn't exceed deposit(%v)", tournament.RakeAmount, tournament.Deposit)
+		}
+	default:
+		return kerror.Newf(kerror.BadRequest, "unknown rake type: %v", tournament.RakeType)
+	}
+
+	return nil
+}
+
+// rakeOf returns the part of entry that goes to the house instead of the prize pool.
+func rakeOf(tournament *models.Tournament, entry models.Money) models.Money {
+	if tournament.RakeType == models.FixedRake {
+		return tournament.RakeAmount
+	}
+
+	return entry.Percent(tournament.Rake)
+}
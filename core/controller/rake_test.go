@@ -0,0 +1,44 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateRake(t *testing.T) {
+	tt := []struct {
+		name       string
+		tournament *models.Tournament
+		valid      bool
+	}{
+		{name: "without rake", tournament: &models.Tournament{RakeType: models.PercentageRake}, valid: true},
+		{name: "percentage rake", tournament: &models.Tournament{RakeType: models.PercentageRake, Rake: percent(10)}, valid: true},
+		{name: "whole entry as rake", tournament: &models.Tournament{RakeType: models.PercentageRake, Rake: percent(100)}, valid: false},
+		{name: "fixed rake", tournament: &models.Tournament{RakeType: models.FixedRake, RakeAmount: money(5), Deposit: money(50)}, valid: true},
+		{name: "fixed rake above deposit", tournament: &models.Tournament{RakeType: models.FixedRake, RakeAmount: money(60), Deposit: money(50)}, valid: false},
+		{name: "negative rake", tournament: &models.Tournament{RakeType: models.FixedRake, RakeAmount: money(-1)}, valid: false},
+		{name: "negative percentage", tournament: &models.Tournament{RakeType: models.PercentageRake, Rake: percent(-1)}, valid: false},
+		{name: "unknown type", tournament: &models.Tournament{RakeType: "Tax"}, valid: false},
+	}
+
+	for _, tc := range tt {
+		err := validateRake(tc.tournament)
+		if tc.valid {
+			assert.NoError(t, err, tc.name)
+		} else {
+			assert.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestRakeOf(t *testing.T) {
+	percentage := &models.Tournament{RakeType: models.PercentageRake, Rake: percent(10)}
+	assert.Equal(t, money(10), rakeOf(percentage, money(100)), "percentage rake should depend on entry")
+	assert.Equal(t, money(33.33), rakeOf(percentage, money(333.33)), "rake should be rounded to cents")
+
+	fixed := &models.Tournament{RakeType: models.FixedRake, RakeAmount: money(5)}
+	assert.Equal(t, money(5), rakeOf(fixed, money(100)), "fixed rake shouldn't depend on entry")
+	assert.Equal(t, money(5), rakeOf(fixed, money(300)), "fixed rake shouldn't depend on stake")
+}
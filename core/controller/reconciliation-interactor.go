@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+)
+
+// ReconciliationInteractor checks that balances, holds and prizes agree with the ledger and participants.
+// The ledger is append-only and balanced, so it's trusted: corrections derive the other values from it.
+type ReconciliationInteractor struct {
+	repo  ReconciliationRepository
+	store tx.Store
+}
+
+func NewReconciliationController(repo ReconciliationRepository, store tx.Store) ReconciliationController {
+	return &ReconciliationInteractor{
+		repo:  repo,
+		store: store,
+	}
+}
+
+// Reconcile reports discrepancies and, if correct is set, corrects the correctable ones within the same transaction.
+// Changes of money wait until reconciliation finishes, so the report doesn't see them half-done.
+func (ri *ReconciliationInteractor) Reconcile(ctx context.Context, correct bool) (*models.ReconciliationReport, error) {
+	report := &models.ReconciliationReport{
+		CheckedAt:     time.Now(),
+		Discrepancies: []models.Discrepancy{},
+	}
+
+	if err := ri.store.WithTransaction(func(store tx.DBTX) error {
+		if err := ri.repo.Lock(ctx, store); err != nil {
+			return kerror.Errorf(err, "lock balances")
+		}
+
+		for _, kind := range models.DiscrepancyKinds {
+			discrepancies, err := ri.repo.SelectDiscrepancies(ctx, store, kind)
+			if err != nil {
+				return kerror.Errorf(err, "find discrepancies of %v", kind)
+			}
+
+			report.Discrepancies = append(report.Discrepancies, discrepancies...)
+		}
+
+		if !correct {
+			return nil
+		}
+
+		for i := range report.Discrepancies {
+			discrepancy := &report.Discrepancies[i]
+			if !discrepancy.Kind.Correctable() {
+				continue
+			}
+
+			if err := ri.repo.Correct(ctx, store, discrepancy); err != nil {
+				return kerror.Errorf(err, "correct %v of user(%v) in tournament(%v)", discrepancy.Kind, discrepancy.UserID, discrepancy.TournamentID)
+			}
+			discrepancy.Corrected = true
+		}
+
+		return nil
+	}); err != nil {
+		return nil, kerror.Errorf(err, "transaction")
+	}
+
+	return report, nil
+}
@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+	"github.com/stretchr/testify/assert"
+)
+
+type sameTransaction struct{}
+
+func (sameTransaction) WithTransaction(fn tx.TransactionFunction) error {
+	return fn(nil)
+}
+
+type discrepancies struct {
+	found     map[models.DiscrepancyKind][]models.Discrepancy
+	corrected []models.DiscrepancyKind
+	locked    bool
+}
+
+func (d *discrepancies) Lock(_ context.Context, _ tx.DBTX) error {
+	d.locked = true
+	return nil
+}
+
+func (d *discrepancies) SelectDiscrepancies(_ context.Context, _ tx.DBTX, kind models.DiscrepancyKind) ([]models.Discrepancy, error) {
+	return d.found[kind], nil
+}
+
+func (d *discrepancies) Correct(_ context.Context, _ tx.DBTX, discrepancy *models.Discrepancy) error {
+	d.corrected = append(d.corrected, discrepancy.Kind)
+	return nil
+}
+
+func TestReconcile(t *testing.T) {
+	userID := uuid.New()
+
+	newRepo := func() *discrepancies {
+		return &discrepancies{found: map[models.DiscrepancyKind][]models.Discrepancy{
+			models.UnpaidStakeDiscrepancy: {{Kind: models.UnpaidStakeDiscrepancy, UserID: userID, Expected: money(100)}},
+			models.UserBalanceDiscrepancy: {{Kind: models.UserBalanceDiscrepancy, UserID: userID, Recorded: money(10), Expected: money(20)}},
+			models.OrphanHoldDiscrepancy:  {{Kind: models.OrphanHoldDiscrepancy, UserID: userID, Recorded: money(5)}},
+		}}
+	}
+
+	repo := newRepo()
+	report, err := NewReconciliationController(repo, sameTransaction{}).Reconcile(context.Background(), false)
+	if assert.NoError(t, err) {
+		assert.True(t, repo.locked, "balances should be locked while reconciled")
+		assert.Len(t, report.Discrepancies, 3)
+		assert.Empty(t, repo.corrected, "report shouldn't correct anything")
+		assert.False(t, report.Consistent())
+	}
+
+	repo = newRepo()
+	report, err = NewReconciliationController(repo, sameTransaction{}).Reconcile(context.Background(), true)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []models.DiscrepancyKind{models.OrphanHoldDiscrepancy, models.UserBalanceDiscrepancy}, repo.corrected,
+			"holds should be released before balances are corrected")
+		assert.False(t, report.Consistent(), "unpaid stake shouldn't be corrected")
+
+		for _, discrepancy := range report.Discrepancies {
+			assert.Equal(t, discrepancy.Kind.Correctable(), discrepancy.Corrected, "%v", discrepancy.Kind)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+)
+
+// ReconciliationRepository finds values that don't match the records they are derived from
+// and derives them again.
+type ReconciliationRepository interface {
+	Lock(ctx context.Context, store tx.DBTX) error
+	SelectDiscrepancies(ctx context.Context, store tx.DBTX, kind models.DiscrepancyKind) ([]models.Discrepancy, error)
+	Correct(ctx context.Context, store tx.DBTX, discrepancy *models.Discrepancy) error
+}
@@ -0,0 +1,11 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/kimbellG/tournament/core/models"
+)
+
+type ReconciliationController interface {
+	Reconcile(ctx context.Context, correct bool) (*models.ReconciliationReport, error)
+}
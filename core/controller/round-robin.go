@@ -0,0 +1,46 @@
+package controller
+
+import (
+	"github.com/google/uuid"
+	"github.com/kimbellG/tournament/core/models"
+)
+
+// roundRobinSchedule pairs every player with every other player once using the circle method.
+// With an odd number of players one of them sits out each round.
+func roundRobinSchedule(tournamentID uuid.UUID, players []uuid.UUID) []*models.Match {
+	circle := make([]uuid.UUID, len(players))
+	copy(circle, players)
+
+	if len(circle)%2 != 0 {
+		circle = append(circle, uuid.Nil)
+	}
+
+	n := len(circle)
+	var matches []*models.Match
+
+	for round := 1; round < n; round++ {
+		position := 0
+
+		for i := 0; i < n/2; i++ {
+			first, second := circle[i], circle[n-1-i]
+			if first == uuid.Nil || second == uuid.Nil {
+				continue
+			}
+
+			matches = append(matches, &models.Match{
+				TournamentID: tournamentID,
+				Round:        round,
+				Position:     position,
+				FirstUser:    first,
+				SecondUser:   second,
+			})
+			position++
+		}
+
+		last := circle[n-1]
+		copy(circle[2:], circle[1:n-1])
+		circle[1] = last
+	}
+
+	return matches
+}
@@ -0,0 +1,44 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundRobinSchedule(t *testing.T) {
+	for _, n := range []int{2, 3, 4, 5, 6} {
+		var players []uuid.UUID
+		for i := 0; i < n; i++ {
+			players = append(players, uuid.New())
+		}
+
+		matches := roundRobinSchedule(uuid.New(), players)
+		assert.Equalf(t, n*(n-1)/2, len(matches), "every pair of %d players should meet", n)
+
+		met := map[pairing]int{}
+		perRound := map[int]map[uuid.UUID]bool{}
+		for _, match := range matches {
+			met[pairing{match.FirstUser, match.SecondUser}]++
+			met[pairing{match.SecondUser, match.FirstUser}]++
+
+			if perRound[match.Round] == nil {
+				perRound[match.Round] = map[uuid.UUID]bool{}
+			}
+
+			for _, player := range []uuid.UUID{match.FirstUser, match.SecondUser} {
+				assert.Falsef(t, perRound[match.Round][player], "player shouldn't play twice in round %d", match.Round)
+				perRound[match.Round][player] = true
+			}
+		}
+
+		for _, a := range players {
+			for _, b := range players {
+				if a != b {
+					assert.Equal(t, 1, met[pairing{a, b}], "players should meet exactly once")
+				}
+			}
+		}
+	}
+}
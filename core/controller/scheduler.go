@@ -0,0 +1,203 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+	log "github.com/sirupsen/logrus"
+)
+
+// maxScheduledPerRun bounds the work of one run, so that the rest is left to the next run or other replicas.
+const maxScheduledPerRun = 100
+
+// Scheduler moves tournaments through their lifecycle when the moments of their schedule arrive.
+type Scheduler interface {
+	RunSchedule(ctx context.Context, now time.Time) (int, error)
+}
+
+func validateSchedule(schedule models.Schedule) error {
+	moments := []struct {
+		name string
+		at   time.Time
+	}{
+		{name: "registration opening", at: schedule.RegistrationOpensAt},
+		{name: "registration closing", at: schedule.RegistrationClosesAt},
+		{name: "start", at: schedule.StartTime},
+		{name: "finish deadline", at: schedule.FinishDeadline},
+	}
+
+	var previous string
+	var latest time.Time
+	for _, moment := range moments {
+		if moment.at.IsZero() {
+			continue
+		}
+
+		if moment.at.Before(latest) {
+			return kerror.Newf(kerror.BadRequest, "%v shouldn't be before %v", moment.name, previous)
+		}
+		previous, latest = moment.name, moment.at
+	}
+
+	return nil
+}
+
+func isDue(at, now time.Time) bool {
+	return !at.IsZero() && !at.After(now)
+}
+
+// scheduledStatus returns the status tournament should move to at moment now by its schedule.
+func scheduledStatus(tournament *models.Tournament, now time.Time) (models.TournamentStatus, bool) {
+	schedule := tournament.Schedule
+
+	if (tournament.Status.IsRegistration() || tournament.Status == models.InProgress) && isDue(schedule.FinishDeadline, now) {
+		return models.Finished, true
+	}
+
+	switch tournament.Status {
+	case models.Draft:
+		if isDue(schedule.RegistrationOpensAt, now) {
+			return models.RegistrationOpen, true
+		}
+	case models.RegistrationOpen, models.RegistrationClosed:
+		if isDue(schedule.StartTime, now) {
+			return models.InProgress, true
+		}
+
+		if tournament.Status == models.RegistrationOpen && isDue(schedule.RegistrationClosesAt, now) {
+			return models.RegistrationClosed, true
+		}
+	}
+
+	return "", false
+}
+
+// RunSchedule performs every transition that is due at moment now and returns the number of them.
+// Every tournament is locked for its transition, so replicas running the schedule concurrently skip it.
+// Tournament whose transition fails is skipped until the next run, so it doesn't hold back the others.
+func (tu *TournamentInteractor) RunSchedule(ctx context.Context, now time.Time) (int, error) {
+	var failed []uuid.UUID
+
+	processed := 0
+	for processed+len(failed) < maxScheduledPerRun {
+		id, err := tu.runNextScheduled(ctx, now, failed)
+		if id == uuid.Nil {
+			if err != nil {
+				return processed, kerror.Errorf(err, "run scheduled transition")
+			}
+
+			return processed, nil
+		}
+
+		if err != nil {
+			log.Errorf("Scheduled transition of tournament(%v) failed: %v", id, err)
+			failed = append(failed, id)
+			continue
+		}
+
+		processed++
+	}
+
+	return processed, nil
+}
+
+// runNextScheduled performs the due transition of the next tournament except the skipped ones and returns its id.
+// Transition rejected by the rules of tournament is parked, so it isn't retried on every run.
+func (tu *TournamentInteractor) runNextScheduled(ctx context.Context, now time.Time, skipped []uuid.UUID) (uuid.UUID, error) {
+	var (
+		id   uuid.UUID
+		next models.TournamentStatus
+	)
+
+	err := tu.store.WithTransaction(func(store tx.DBTX) error {
+		var err error
+
+		id, err = tu.repo.LockNextScheduled(ctx, store, now, skipped)
+		if err != nil {
+			return kerror.Errorf(err, "lock scheduled tournament")
+		}
+
+		if id == uuid.Nil {
+			return nil
+		}
+
+		// LockNextScheduled has locked the tournament already, so manual transitions wait for this one.
+		tournament, err := tu.repo.SelectByID(ctx, store, id)
+		if err != nil {
+			return kerror.Errorf(err, "get tournament")
+		}
+
+		var ok bool
+		if next, ok = scheduledStatus(tournament, now); !ok {
+			return nil
+		}
+
+		if err := tu.runScheduledTransition(ctx, store, tournament, next); err != nil {
+			return kerror.Errorf(err, "move tournament(%v) to %v", id, next)
+		}
+
+		return nil
+	})
+	if err == nil {
+		return id, nil
+	}
+
+	if next == "" || !isRejected(err) {
+		return id, kerror.Errorf(err, "execution transaction")
+	}
+
+	log.Warnf("Tournament(%v) can't move to %v by schedule: %v", id, next, err)
+	if err := tu.parkTransition(ctx, id, next); err != nil {
+		return id, kerror.Errorf(err, "park transition to %v", next)
+	}
+
+	return id, nil
+}
+
+// isRejected reports whether err is caused by the rules of tournament rather than a failure of storage.
+func isRejected(err error) bool {
+	var kerr kerror.Error
+	return errors.As(err, &kerr) && kerr.StatusCode() == kerror.BadRequest
+}
+
+func (tu *TournamentInteractor) runScheduledTransition(ctx context.Context, store tx.DBTX, tournament *models.Tournament, next models.TournamentStatus) error {
+	switch next {
+	case models.InProgress:
+		if len(tournament.Users) < playersToStart(tournament) {
+			return tu.cancel(ctx, store, tournament)
+		}
+
+		return tu.start(ctx, store, tournament)
+	case models.Finished:
+		return tu.finish(ctx, store, tournament, nil)
+	}
+
+	return tu.changeStatus(ctx, store, tournament, next)
+}
+
+// parkTransition stops automatic transition of tournament to next, which the rules of tournament reject,
+// so the transition is left to the organizer. Tournament that hasn't started and can't be finished by deadline is cancelled instead.
+func (tu *TournamentInteractor) parkTransition(ctx context.Context, id uuid.UUID, next models.TournamentStatus) error {
+	err := tu.store.WithTransaction(func(store tx.DBTX) error {
+		tournament, err := tu.lockTournament(ctx, store, id)
+		if err != nil {
+			return kerror.Errorf(err, "lock tournament")
+		}
+
+		if next == models.Finished && tournament.Status != models.InProgress && tournament.Status.CanBecome(models.Cancelled) {
+			return tu.cancel(ctx, store, tournament)
+		}
+
+		return tu.repo.ClearSchedule(ctx, store, id, next)
+	})
+	if err != nil {
+		return kerror.Errorf(err, "execution transaction")
+	}
+
+	return nil
+}
@@ -0,0 +1,187 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSchedule(t *testing.T) {
+	now := time.Now()
+
+	assert.NoError(t, validateSchedule(models.Schedule{}), "tournament can be unscheduled")
+	assert.NoError(t, validateSchedule(models.Schedule{
+		RegistrationOpensAt:  now,
+		RegistrationClosesAt: now.Add(time.Hour),
+		StartTime:            now.Add(2 * time.Hour),
+		FinishDeadline:       now.Add(3 * time.Hour),
+	}), "moments in order should be valid")
+	assert.NoError(t, validateSchedule(models.Schedule{
+		RegistrationOpensAt: now,
+		FinishDeadline:      now.Add(time.Hour),
+	}), "moments can be skipped")
+	assert.Error(t, validateSchedule(models.Schedule{
+		RegistrationClosesAt: now.Add(time.Hour),
+		StartTime:            now,
+	}), "tournament can't start before registration is closed")
+	assert.Error(t, validateSchedule(models.Schedule{
+		RegistrationOpensAt: now.Add(time.Hour),
+		FinishDeadline:      now,
+	}), "deadline can't be before registration")
+}
+
+func TestScheduledStatus(t *testing.T) {
+	now := time.Now()
+	past, future := now.Add(-time.Minute), now.Add(time.Minute)
+
+	tt := []struct {
+		name     string
+		status   models.TournamentStatus
+		schedule models.Schedule
+		want     models.TournamentStatus
+		due      bool
+	}{
+		{
+			name:     "registration opens",
+			status:   models.Draft,
+			schedule: models.Schedule{RegistrationOpensAt: past, StartTime: future},
+			want:     models.RegistrationOpen,
+			due:      true,
+		},
+		{
+			name:     "registration isn't open yet",
+			status:   models.Draft,
+			schedule: models.Schedule{RegistrationOpensAt: future},
+		},
+		{
+			name:     "registration closes",
+			status:   models.RegistrationOpen,
+			schedule: models.Schedule{RegistrationClosesAt: past, StartTime: future},
+			want:     models.RegistrationClosed,
+			due:      true,
+		},
+		{
+			name:     "tournament starts",
+			status:   models.RegistrationClosed,
+			schedule: models.Schedule{RegistrationClosesAt: past, StartTime: past},
+			want:     models.InProgress,
+			due:      true,
+		},
+		{
+			name:     "tournament is finished by deadline",
+			status:   models.InProgress,
+			schedule: models.Schedule{StartTime: past, FinishDeadline: past},
+			want:     models.Finished,
+			due:      true,
+		},
+		{
+			name:     "finished tournament is left alone",
+			status:   models.Finished,
+			schedule: models.Schedule{StartTime: past, FinishDeadline: past},
+		},
+		{
+			name:   "unscheduled tournament",
+			status: models.RegistrationOpen,
+		},
+	}
+
+	for _, tc := range tt {
+		next, due := scheduledStatus(&models.Tournament{Status: tc.status, Schedule: tc.schedule}, now)
+		assert.Equal(t, tc.due, due, tc.name)
+		assert.Equal(t, tc.want, next, tc.name)
+	}
+}
+
+// scheduledTournaments keeps tournaments in the order they are due. Status of the broken tournament can't be saved.
+type scheduledTournaments struct {
+	TournamentRepository
+	tournaments []*models.Tournament
+	broken      uuid.UUID
+}
+
+func (st *scheduledTournaments) LockNextScheduled(_ context.Context, _ tx.DBTX, now time.Time, skipped []uuid.UUID) (uuid.UUID, error) {
+	for _, tournament := range st.tournaments {
+		if _, due := scheduledStatus(tournament, now); due && !containsID(skipped, tournament.ID) {
+			return tournament.ID, nil
+		}
+	}
+
+	return uuid.Nil, nil
+}
+
+func containsID(ids []uuid.UUID, id uuid.UUID) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (st *scheduledTournaments) find(id uuid.UUID) *models.Tournament {
+	for _, tournament := range st.tournaments {
+		if tournament.ID == id {
+			return tournament
+		}
+	}
+
+	return nil
+}
+
+func (st *scheduledTournaments) SelectByID(_ context.Context, _ tx.DBTX, id uuid.UUID) (*models.Tournament, error) {
+	tournament := *st.find(id)
+	return &tournament, nil
+}
+
+func (st *scheduledTournaments) LockByID(_ context.Context, _ tx.DBTX, _ uuid.UUID) error {
+	return nil
+}
+
+func (st *scheduledTournaments) UpdateStatus(_ context.Context, _ tx.DBTX, id uuid.UUID, _, next models.TournamentStatus) error {
+	if id == st.broken {
+		return kerror.Newf(kerror.SQLExecutionError, "storage of tournament(%v) is broken", id)
+	}
+	st.find(id).Status = next
+
+	return nil
+}
+
+func (st *scheduledTournaments) ClearSchedule(_ context.Context, _ tx.DBTX, id uuid.UUID, next models.TournamentStatus) error {
+	if next == models.Finished {
+		st.find(id).Schedule.FinishDeadline = time.Time{}
+	}
+
+	return nil
+}
+
+func TestRunScheduleSkipsFailures(t *testing.T) {
+	now := time.Now()
+	broken := &models.Tournament{ID: uuid.New(), Status: models.Draft, Schedule: models.Schedule{RegistrationOpensAt: now.Add(-time.Hour)}}
+	rejected := &models.Tournament{ID: uuid.New(), Status: models.InProgress, Format: models.SingleElimination,
+		Schedule: models.Schedule{FinishDeadline: now.Add(-time.Minute * 30)}}
+	healthy := &models.Tournament{ID: uuid.New(), Status: models.Draft, Schedule: models.Schedule{RegistrationOpensAt: now.Add(-time.Minute)}}
+
+	repo := &scheduledTournaments{tournaments: []*models.Tournament{broken, rejected, healthy}, broken: broken.ID}
+	scheduler := &TournamentInteractor{repo: repo, store: sameTransaction{}}
+
+	for run := 0; run < 2; run++ {
+		processed, err := scheduler.RunSchedule(context.Background(), now)
+		assert.NoError(t, err)
+		if run == 0 {
+			assert.Equal(t, 2, processed, "healthy and rejected tournaments should be processed")
+		}
+	}
+
+	assert.Equal(t, models.RegistrationOpen, healthy.Status, "failing tournament shouldn't hold back others")
+	assert.True(t, rejected.Schedule.FinishDeadline.IsZero(), "rejected transition should be parked")
+	assert.Equal(t, models.InProgress, rejected.Status)
+	assert.Equal(t, models.Draft, broken.Status)
+	assert.False(t, broken.Schedule.RegistrationOpensAt.IsZero(), "failed transition should be retried by the next run")
+}
@@ -0,0 +1,22 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+)
+
+// SessionRepository keeps sessions of users and their hashed refresh tokens.
+type SessionRepository interface {
+	Insert(ctx context.Context, store tx.DBTX, session *models.Session) (uuid.UUID, error)
+	SelectByID(ctx context.Context, store tx.DBTX, id uuid.UUID) (*models.Session, error)
+	UpdateExpiration(ctx context.Context, store tx.DBTX, id uuid.UUID, expiresAt time.Time) error
+	Revoke(ctx context.Context, store tx.DBTX, id uuid.UUID) error
+	RevokeByUser(ctx context.Context, store tx.DBTX, userID, except uuid.UUID) error
+	InsertRefreshToken(ctx context.Context, store tx.DBTX, token *models.RefreshToken) error
+	SelectRefreshToken(ctx context.Context, store tx.DBTX, tokenHash string) (*models.RefreshToken, error)
+	UseRefreshToken(ctx context.Context, store tx.DBTX, tokenHash string) error
+}
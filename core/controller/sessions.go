@@ -0,0 +1,138 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+)
+
+// Sessions opens sessions of users and extends them with rotating refresh tokens. Refresh uses up the token
+// and gives a new one. Token used again is taken for a stolen one, so it revokes the whole session.
+type Sessions struct {
+	repo SessionRepository
+	ttl  time.Duration
+}
+
+func NewSessions(repo SessionRepository, ttl time.Duration) *Sessions {
+	return &Sessions{
+		repo: repo,
+		ttl:  ttl,
+	}
+}
+
+// Open starts session of user, which lasts for ttl unless it's refreshed, and returns its first refresh token.
+func (s *Sessions) Open(ctx context.Context, store tx.DBTX, userID uuid.UUID) (*models.Session, string, error) {
+	session := &models.Session{
+		UserID:    userID,
+		ExpiresAt: time.Now().Add(s.ttl),
+	}
+
+	var err error
+	session.ID, err = s.repo.Insert(ctx, store, session)
+	if err != nil {
+		return nil, "", kerror.Errorf(err, "save session")
+	}
+
+	token, err := s.issueRefreshToken(ctx, store, session.ID)
+	if err != nil {
+		return nil, "", kerror.Errorf(err, "issue refresh token")
+	}
+
+	return session, token, nil
+}
+
+// Refresh uses up refresh token, extends its session for ttl and returns the session with a new token.
+// Unknown token and token of inactive session give nil session. Token used again revokes its session
+// and gives nil session too.
+func (s *Sessions) Refresh(ctx context.Context, store tx.DBTX, token string) (*models.Session, string, error) {
+	refresh, err := s.repo.SelectRefreshToken(ctx, store, hashToken(token))
+	if err != nil {
+		return nil, "", kerror.Errorf(err, "get refresh token")
+	}
+
+	if refresh == nil {
+		return nil, "", nil
+	}
+
+	if !refresh.UsedAt.IsZero() {
+		if err := s.repo.Revoke(ctx, store, refresh.SessionID); err != nil {
+			return nil, "", kerror.Errorf(err, "revoke session of reused token")
+		}
+
+		return nil, "", nil
+	}
+
+	session, err := s.repo.SelectByID(ctx, store, refresh.SessionID)
+	if err != nil {
+		return nil, "", kerror.Errorf(err, "get session")
+	}
+
+	now := time.Now()
+	if session == nil || !session.Active(now) {
+		return nil, "", nil
+	}
+
+	if err := s.repo.UseRefreshToken(ctx, store, refresh.TokenHash); err != nil {
+		return nil, "", kerror.Errorf(err, "use refresh token")
+	}
+
+	session.ExpiresAt = now.Add(s.ttl)
+	if err := s.repo.UpdateExpiration(ctx, store, session.ID, session.ExpiresAt); err != nil {
+		return nil, "", kerror.Errorf(err, "extend session")
+	}
+
+	next, err := s.issueRefreshToken(ctx, store, session.ID)
+	if err != nil {
+		return nil, "", kerror.Errorf(err, "issue refresh token")
+	}
+
+	return session, next, nil
+}
+
+func (s *Sessions) issueRefreshToken(ctx context.Context, store tx.DBTX, sessionID uuid.UUID) (string, error) {
+	token, err := generateToken()
+	if err != nil {
+		return "", kerror.Errorf(err, "generate token")
+	}
+
+	if err := s.repo.InsertRefreshToken(ctx, store, &models.RefreshToken{
+		TokenHash: hashToken(token),
+		SessionID: sessionID,
+	}); err != nil {
+		return "", kerror.Errorf(err, "save refresh token")
+	}
+
+	return token, nil
+}
+
+// Revoke ends session, so neither its tokens nor its refresh tokens work anymore.
+func (s *Sessions) Revoke(ctx context.Context, store tx.DBTX, id uuid.UUID) error {
+	if err := s.repo.Revoke(ctx, store, id); err != nil {
+		return kerror.Errorf(err, "revoke session")
+	}
+
+	return nil
+}
+
+// RevokeByUser ends every session of user but the except one. uuid.Nil as except ends them all.
+func (s *Sessions) RevokeByUser(ctx context.Context, store tx.DBTX, userID, except uuid.UUID) error {
+	if err := s.repo.RevokeByUser(ctx, store, userID, except); err != nil {
+		return kerror.Errorf(err, "revoke sessions of user")
+	}
+
+	return nil
+}
+
+// Active reports whether session exists, isn't expired and isn't revoked.
+func (s *Sessions) Active(ctx context.Context, store tx.DBTX, id uuid.UUID) (bool, error) {
+	session, err := s.repo.SelectByID(ctx, store, id)
+	if err != nil {
+		return false, kerror.Errorf(err, "get session")
+	}
+
+	return session != nil && session.Active(time.Now()), nil
+}
@@ -0,0 +1,205 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+	"github.com/stretchr/testify/assert"
+)
+
+type sessionRepo struct {
+	sessions map[uuid.UUID]models.Session
+	tokens   map[string]models.RefreshToken
+}
+
+func newSessionRepo() *sessionRepo {
+	return &sessionRepo{
+		sessions: map[uuid.UUID]models.Session{},
+		tokens:   map[string]models.RefreshToken{},
+	}
+}
+
+func (sr *sessionRepo) Insert(_ context.Context, _ tx.DBTX, session *models.Session) (uuid.UUID, error) {
+	id := uuid.New()
+	saved := *session
+	saved.ID = id
+	sr.sessions[id] = saved
+
+	return id, nil
+}
+
+func (sr *sessionRepo) SelectByID(_ context.Context, _ tx.DBTX, id uuid.UUID) (*models.Session, error) {
+	session, ok := sr.sessions[id]
+	if !ok {
+		return nil, nil
+	}
+
+	return &session, nil
+}
+
+func (sr *sessionRepo) UpdateExpiration(_ context.Context, _ tx.DBTX, id uuid.UUID, expiresAt time.Time) error {
+	session := sr.sessions[id]
+	session.ExpiresAt = expiresAt
+	sr.sessions[id] = session
+
+	return nil
+}
+
+func (sr *sessionRepo) Revoke(_ context.Context, _ tx.DBTX, id uuid.UUID) error {
+	session := sr.sessions[id]
+	session.RevokedAt = time.Now()
+	sr.sessions[id] = session
+
+	return nil
+}
+
+func (sr *sessionRepo) RevokeByUser(_ context.Context, _ tx.DBTX, userID, except uuid.UUID) error {
+	for id, session := range sr.sessions {
+		if session.UserID == userID && id != except {
+			session.RevokedAt = time.Now()
+			sr.sessions[id] = session
+		}
+	}
+
+	return nil
+}
+
+func (sr *sessionRepo) InsertRefreshToken(_ context.Context, _ tx.DBTX, token *models.RefreshToken) error {
+	sr.tokens[token.TokenHash] = *token
+	return nil
+}
+
+func (sr *sessionRepo) SelectRefreshToken(_ context.Context, _ tx.DBTX, tokenHash string) (*models.RefreshToken, error) {
+	token, ok := sr.tokens[tokenHash]
+	if !ok {
+		return nil, nil
+	}
+
+	return &token, nil
+}
+
+func (sr *sessionRepo) UseRefreshToken(_ context.Context, _ tx.DBTX, tokenHash string) error {
+	token := sr.tokens[tokenHash]
+	token.UsedAt = time.Now()
+	sr.tokens[tokenHash] = token
+
+	return nil
+}
+
+func TestSessionRefresh(t *testing.T) {
+	repo := newSessionRepo()
+	sessions := NewSessions(repo, time.Hour)
+	userID := uuid.New()
+
+	opened, first, err := sessions.Open(context.Background(), nil, userID)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, userID, opened.UserID)
+	assert.NotContains(t, repo.tokens, first, "token should be kept hashed")
+
+	refreshed, second, err := sessions.Refresh(context.Background(), nil, first)
+	if assert.NoError(t, err) && assert.NotNil(t, refreshed) {
+		assert.Equal(t, opened.ID, refreshed.ID, "refresh should keep the session")
+		assert.False(t, refreshed.ExpiresAt.Before(opened.ExpiresAt), "refresh should extend the session")
+		assert.NotEqual(t, first, second, "refresh should rotate the token")
+	}
+
+	unknown, _, err := sessions.Refresh(context.Background(), nil, "unknown")
+	assert.NoError(t, err)
+	assert.Nil(t, unknown, "unknown token shouldn't refresh session")
+
+	reused, _, err := sessions.Refresh(context.Background(), nil, first)
+	assert.NoError(t, err)
+	assert.Nil(t, reused, "token should be used once")
+
+	active, err := sessions.Active(context.Background(), nil, opened.ID)
+	assert.NoError(t, err)
+	assert.False(t, active, "reused token should revoke the session")
+
+	revoked, _, err := sessions.Refresh(context.Background(), nil, second)
+	assert.NoError(t, err)
+	assert.Nil(t, revoked, "tokens of revoked session shouldn't refresh it")
+}
+
+func TestSessionRevoke(t *testing.T) {
+	sessions := NewSessions(newSessionRepo(), time.Hour)
+
+	session, token, err := sessions.Open(context.Background(), nil, uuid.New())
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	active, err := sessions.Active(context.Background(), nil, session.ID)
+	assert.NoError(t, err)
+	assert.True(t, active)
+
+	assert.NoError(t, sessions.Revoke(context.Background(), nil, session.ID))
+
+	active, err = sessions.Active(context.Background(), nil, session.ID)
+	assert.NoError(t, err)
+	assert.False(t, active, "revoked session shouldn't be active")
+
+	refreshed, _, err := sessions.Refresh(context.Background(), nil, token)
+	assert.NoError(t, err)
+	assert.Nil(t, refreshed, "revoked session shouldn't be refreshed")
+}
+
+func TestSessionRevokeByUser(t *testing.T) {
+	sessions := NewSessions(newSessionRepo(), time.Hour)
+	userID := uuid.New()
+
+	current, _, err := sessions.Open(context.Background(), nil, userID)
+	if !assert.NoError(t, err) {
+		return
+	}
+	other, _, err := sessions.Open(context.Background(), nil, userID)
+	if !assert.NoError(t, err) {
+		return
+	}
+	stranger, _, err := sessions.Open(context.Background(), nil, uuid.New())
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.NoError(t, sessions.RevokeByUser(context.Background(), nil, userID, current.ID))
+
+	active, err := sessions.Active(context.Background(), nil, current.ID)
+	assert.NoError(t, err)
+	assert.True(t, active, "excepted session should stay active")
+
+	active, err = sessions.Active(context.Background(), nil, other.ID)
+	assert.NoError(t, err)
+	assert.False(t, active, "other session of user should be revoked")
+
+	active, err = sessions.Active(context.Background(), nil, stranger.ID)
+	assert.NoError(t, err)
+	assert.True(t, active, "sessions of other users shouldn't be revoked")
+
+	assert.NoError(t, sessions.RevokeByUser(context.Background(), nil, userID, uuid.Nil))
+
+	active, err = sessions.Active(context.Background(), nil, current.ID)
+	assert.NoError(t, err)
+	assert.False(t, active, "every session of user should be revoked")
+}
+
+func TestExpiredSession(t *testing.T) {
+	sessions := NewSessions(newSessionRepo(), -time.Second)
+
+	session, token, err := sessions.Open(context.Background(), nil, uuid.New())
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	active, err := sessions.Active(context.Background(), nil, session.ID)
+	assert.NoError(t, err)
+	assert.False(t, active, "expired session shouldn't be active")
+
+	refreshed, _, err := sessions.Refresh(context.Background(), nil, token)
+	assert.NoError(t, err)
+	assert.Nil(t, refreshed, "expired session shouldn't be refreshed")
+}
@@ -0,0 +1,130 @@
+package controller
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/tournament/core/models"
+)
+
+// computeStandings ranks players by points and breaks ties with the tiebreakers in the given order.
+func computeStandings(players []uuid.UUID, matches []models.Match, tiebreakers []models.Tiebreaker) []models.Standing {
+	standings := make(map[uuid.UUID]*models.Standing, len(players))
+	for _, player := range players {
+		standings[player] = &models.Standing{UserID: player}
+	}
+
+	for _, match := range matches {
+		if !match.IsDecided() {
+			continue
+		}
+
+		if match.IsBye() {
+			if standing, ok := standings[match.FirstUser]; ok {
+				standing.Played++
+				standing.Wins++
+				standing.Points += models.WinPoints
+			}
+
+			continue
+		}
+
+		for _, player := range []uuid.UUID{match.FirstUser, match.SecondUser} {
+			standing, ok := standings[player]
+			if !ok {
+				continue
+			}
+
+			standing.Played++
+			switch {
+			case match.Draw:
+				standing.Draws++
+				standing.Points += models.DrawPoints
+			case match.Winner == player:
+				standing.Wins++
+				standing.Points += models.WinPoints
+			default:
+				standing.Losses++
+			}
+		}
+	}
+
+	for _, match := range matches {
+		if !match.IsDecided() || match.IsBye() {
+			continue
+		}
+
+		first, okFirst := standings[match.FirstUser]
+		second, okSecond := standings[match.SecondUser]
+		if !okFirst || !okSecond {
+			continue
+		}
+
+		first.Buchholz += second.Points
+		second.Buchholz += first.Points
+
+		if first.Points == second.Points {
+			first.HeadToHead += pointsOf(match, first.UserID)
+			second.HeadToHead += pointsOf(match, second.UserID)
+		}
+	}
+
+	ranked := make([]models.Standing, 0, len(standings))
+	for _, player := range players {
+		ranked = append(ranked, *standings[player])
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return isRankedHigher(ranked[i], ranked[j], tiebreakers)
+	})
+
+	return ranked
+}
+
+func pointsOf(match models.Match, player uuid.UUID) float64 {
+	switch {
+	case match.Draw:
+		return models.DrawPoints
+	case match.Winner == player:
+		return models.WinPoints
+	}
+
+	return 0
+}
+
+func isRankedHigher(a, b models.Standing, tiebreakers []models.Tiebreaker) bool {
+	if a.Points != b.Points {
+		return a.Points > b.Points
+	}
+
+	for _, tb := range tiebreakers {
+		x, y := tiebreakValue(a, tb), tiebreakValue(b, tb)
+		if x != y {
+			return x > y
+		}
+	}
+
+	return false
+}
+
+func tiebreakValue(standing models.Standing, tb models.Tiebreaker) float64 {
+	switch tb {
+	case models.Buchholz:
+		return standing.Buchholz
+	case models.HeadToHead:
+		return standing.HeadToHead
+	case models.Wins:
+		return float64(standing.Wins)
+	}
+
+	return 0
+}
+
+func rankedPlayers(standings []models.Standing) []uuid.UUID {
+	players := make([]uuid.UUID, 0, len(standings))
+	for _, standing := range standings {
+		players = append(players, standing.UserID)
+	}
+
+	return players
+}
@@ -0,0 +1,37 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeStandings(t *testing.T) {
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	players := []uuid.UUID{a, b, c, d}
+
+	matches := []models.Match{
+		{Round: 1, FirstUser: a, SecondUser: b, Winner: a},
+		{Round: 1, FirstUser: c, SecondUser: d, Draw: true},
+		{Round: 2, FirstUser: a, SecondUser: c, Winner: c},
+		{Round: 2, FirstUser: b, SecondUser: d, Winner: b},
+		{Round: 3, FirstUser: a, SecondUser: d, Winner: a},
+		{Round: 3, FirstUser: b, SecondUser: c, Winner: b},
+	}
+
+	standings := computeStandings(players, matches, []models.Tiebreaker{models.HeadToHead, models.Buchholz})
+	if !assert.Equal(t, 4, len(standings), "every player should have standing") {
+		return
+	}
+
+	assert.Equal(t, []uuid.UUID{a, b, c, d}, rankedPlayers(standings), "a should be ranked over b by head-to-head")
+	assert.Equal(t, 2.0, standings[0].Points, "a should have 2 points")
+	assert.Equal(t, 1.0, standings[0].HeadToHead, "a should win head-to-head")
+	assert.Equal(t, 1, standings[2].Draws, "c should have one draw")
+	assert.Equal(t, 1.5, standings[2].Points, "c should have 1.5 points")
+
+	assert.Equal(t, 4.0, standings[0].Buchholz, "buchholz of a should be sum of opponents points")
+	assert.Equal(t, standings[0].Buchholz, standings[1].Buchholz, "a and b should be tied on buchholz")
+}
@@ -0,0 +1,82 @@
+package controller
+
+import (
+	"github.com/google/uuid"
+	"github.com/kimbellG/tournament/core/models"
+)
+
+type pairing struct {
+	first, second uuid.UUID
+}
+
+func swissRounds(tournament *models.Tournament) int {
+	if tournament.Rounds > 0 {
+		return tournament.Rounds
+	}
+
+	return bracketRounds(len(tournament.Users))
+}
+
+// pairSwissRound pairs players ranked from first to last place with the nearest opponent
+// they haven't met yet. The lowest ranked player without a bye gets one if the number of players is odd.
+func pairSwissRound(tournamentID uuid.UUID, round int, ranked []uuid.UUID, history []models.Match) []*models.Match {
+	met := map[pairing]bool{}
+	hadBye := map[uuid.UUID]bool{}
+
+	for _, match := range history {
+		if match.IsBye() {
+			hadBye[match.FirstUser] = true
+			continue
+		}
+
+		met[pairing{match.FirstUser, match.SecondUser}] = true
+		met[pairing{match.SecondUser, match.FirstUser}] = true
+	}
+
+	unpaired := make([]uuid.UUID, len(ranked))
+	copy(unpaired, ranked)
+
+	var matches []*models.Match
+
+	if len(unpaired)%2 != 0 {
+		bye := len(unpaired) - 1
+		for i := len(unpaired) - 1; i >= 0; i-- {
+			if !hadBye[unpaired[i]] {
+				bye = i
+				break
+			}
+		}
+
+		matches = append(matches, &models.Match{
+			TournamentID: tournamentID,
+			Round:        round,
+			FirstUser:    unpaired[bye],
+			Winner:       unpaired[bye],
+		})
+		unpaired = append(unpaired[:bye], unpaired[bye+1:]...)
+	}
+
+	for position := len(matches); len(unpaired) > 0; position++ {
+		first := unpaired[0]
+
+		opponent := 1
+		for i := 1; i < len(unpaired); i++ {
+			if !met[pairing{first, unpaired[i]}] {
+				opponent = i
+				break
+			}
+		}
+
+		matches = append(matches, &models.Match{
+			TournamentID: tournamentID,
+			Round:        round,
+			Position:     position,
+			FirstUser:    first,
+			SecondUser:   unpaired[opponent],
+		})
+
+		unpaired = append(unpaired[1:opponent], unpaired[opponent+1:]...)
+	}
+
+	return matches
+}
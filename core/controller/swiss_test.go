@@ -0,0 +1,47 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPairSwissRound(t *testing.T) {
+	var players []uuid.UUID
+	for i := 0; i < 5; i++ {
+		players = append(players, uuid.New())
+	}
+
+	first := pairSwissRound(uuid.New(), 1, players, nil)
+	if !assert.Equal(t, 3, len(first), "5 players should be paired in 2 matches and a bye") {
+		return
+	}
+	assert.True(t, first[0].IsBye(), "first match should be a bye")
+	assert.Equal(t, players[4], first[0].FirstUser, "lowest ranked player should get the bye")
+	assert.Equal(t, players[4], first[0].Winner, "bye should be won")
+
+	var history []models.Match
+	for _, match := range first {
+		if !match.IsBye() {
+			match.Winner = match.FirstUser
+		}
+		history = append(history, *match)
+	}
+
+	second := pairSwissRound(uuid.New(), 2, players, history)
+	assert.True(t, second[0].IsBye(), "first match should be a bye")
+	assert.NotEqual(t, players[4], second[0].FirstUser, "player shouldn't get the bye twice")
+
+	for _, match := range second[1:] {
+		for _, previous := range history {
+			if previous.IsBye() {
+				continue
+			}
+
+			samePair := previous.IsPlayer(match.FirstUser) && previous.IsPlayer(match.SecondUser)
+			assert.False(t, samePair, "players shouldn't meet again while other opponents are available")
+		}
+	}
+}
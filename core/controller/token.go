@@ -0,0 +1,26 @@
+package controller
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/kimbellG/kerror"
+)
+
+const tokenSize = 32
+
+// generateToken returns random token to be given to user. Only hash of the token should be kept.
+func generateToken() (string, error) {
+	token := make([]byte, tokenSize)
+	if _, err := rand.Read(token); err != nil {
+		return "", kerror.Newf(kerror.InternalServerError, "read random token: %v", err)
+	}
+
+	return hex.EncodeToString(token), nil
+}
+
+func hashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
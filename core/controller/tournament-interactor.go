@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/kimbellG/kerror"
@@ -10,23 +11,43 @@ import (
 )
 
 type TournamentInteractor struct {
-	repo     TournamentRepository
-	store    tx.Store
-	userRepo UserRepository
+	repo        TournamentRepository
+	store       tx.Store
+	userRepo    UserRepository
+	matchRepo   MatchRepository
+	ledger      *Ledger
+	idempotency *Idempotency
+	limits      *Limits
+	selectors   map[models.WinnerStrategy]WinnerSelector
 }
 
-func NewTournamentController(repo TournamentRepository, userRepo UserRepository, store tx.Store) TournamentController {
+func NewTournamentController(repo TournamentRepository, userRepo UserRepository, matchRepo MatchRepository, ledger *Ledger, idempotency *Idempotency, limits *Limits, store tx.Store) TournamentController {
 	return &TournamentInteractor{
-		repo:     repo,
-		userRepo: userRepo,
-		store:    store,
+		repo:        repo,
+		userRepo:    userRepo,
+		matchRepo:   matchRepo,
+		ledger:      ledger,
+		idempotency: idempotency,
+		limits:      limits,
+		store:       store,
+		selectors:   defaultWinnerSelectors(repo),
 	}
 }
 
 func (tu *TournamentInteractor) Create(ctx context.Context, tournament *models.Tournament) (uuid.UUID, error) {
 	var id uuid.UUID
 
-	err := tu.store.WithTransaction(func(store tx.DBTX) error {
+	if err := tu.prepareFormat(tournament); err != nil {
+		return id, kerror.Errorf(err, "format of tournament")
+	}
+
+	seed, err := generateServerSeed()
+	if err != nil {
+		return id, kerror.Errorf(err, "commit server seed")
+	}
+	tournament.ServerSeed, tournament.ServerSeedHash = seed, hashServerSeed(seed)
+
+	err = tu.store.WithTransaction(func(store tx.DBTX) error {
 		var err error
 
 		id, err = tu.repo.Insert(ctx, store, tournament)
@@ -34,6 +55,11 @@ func (tu *TournamentInteractor) Create(ctx context.Context, tournament *models.T
 			return kerror.Errorf(err, "repository")
 		}
 
+		tournament.ID = id
+		if err := tu.repo.InsertPayouts(ctx, store, tournament); err != nil {
+			return kerror.Errorf(err, "save payout structure")
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -44,6 +70,100 @@ func (tu *TournamentInteractor) Create(ctx context.Context, tournament *models.T
 
 }
 
+func (tu *TournamentInteractor) prepareFormat(tournament *models.Tournament) error {
+	if tournament.Status == "" {
+		tournament.Status = models.RegistrationOpen
+	}
+
+	if tournament.Status != models.Draft && tournament.Status != models.RegistrationOpen {
+		return kerror.Newf(kerror.BadRequest, "tournament can be created only as %v or %v", models.Draft, models.RegistrationOpen)
+	}
+
+	if err := validateSchedule(tournament.Schedule); err != nil {
+		return kerror.Errorf(err, "schedule")
+	}
+
+	if tournament.Schedule.RegistrationOpensAt.After(time.Now()) {
+		tournament.Status = models.Draft
+	}
+
+	if tournament.Currency == "" {
+		tournament.Currency = models.DefaultCurrency
+	}
+
+	if !tournament.Currency.Valid() {
+		return kerror.Newf(kerror.BadRequest, "invalid currency: %q", tournament.Currency)
+	}
+
+	if tournament.Format == "" {
+		tournament.Format = models.SingleElimination
+	}
+
+	if !tournament.Format.Valid() {
+		return kerror.Newf(kerror.BadRequest, "unknown format: %v", tournament.Format)
+	}
+
+	if tournament.Rounds < 0 {
+		return kerror.Newf(kerror.BadRequest, "number of rounds should be positive")
+	}
+
+	if tournament.WinnerStrategy == "" {
+		tournament.WinnerStrategy = models.RandomWinner
+	}
+
+	if _, ok := tu.selectors[tournament.WinnerStrategy]; !ok {
+		return kerror.Newf(kerror.BadRequest, "unknown winner strategy: %v", tournament.WinnerStrategy)
+	}
+
+	if len(tournament.Tiebreakers) == 0 {
+		tournament.Tiebreakers = models.DefaultTiebreakers
+	}
+
+	for _, tb := range tournament.Tiebreakers {
+		if !tb.Valid() {
+			return kerror.Newf(kerror.BadRequest, "unknown tiebreaker: %v", tb)
+		}
+	}
+
+	if tournament.PayoutType == "" {
+		tournament.PayoutType = models.PercentagePayout
+	}
+
+	if tournament.PayoutType == models.PercentagePayout && len(tournament.Payouts) == 0 {
+		tournament.Payouts = models.DefaultPayouts
+	}
+
+	if err := validatePayouts(tournament); err != nil {
+		return kerror.Errorf(err, "payout structure")
+	}
+
+	if tournament.MinPlayers == 0 {
+		tournament.MinPlayers = minPlayersToStart
+	}
+
+	if tournament.MinPlayers < 0 || tournament.MaxPlayers < 0 {
+		return kerror.Newf(kerror.BadRequest, "limits of players should be positive")
+	}
+
+	if tournament.MaxPlayers != 0 && tournament.MaxPlayers < tournament.MinPlayers {
+		return kerror.Newf(kerror.BadRequest, "maximum of players(%v) is less than minimum(%v)", tournament.MaxPlayers, tournament.MinPlayers)
+	}
+
+	if tournament.RakeType == "" {
+		tournament.RakeType = models.PercentageRake
+	}
+
+	if err := validateRake(tournament); err != nil {
+		return kerror.Errorf(err, "rake")
+	}
+
+	if err := validateWithdrawalPenalty(tournament.WithdrawalPenalty); err != nil {
+		return kerror.Errorf(err, "withdrawal penalty")
+	}
+
+	return nil
+}
+
 func (tu *TournamentInteractor) GetByID(ctx context.Context, id uuid.UUID) (*models.Tournament, error) {
 	var tournament *models.Tournament
 
@@ -64,32 +184,201 @@ func (tu *TournamentInteractor) GetByID(ctx context.Context, id uuid.UUID) (*mod
 	return tournament, nil
 }
 
-func (tu *TournamentInteractor) Join(ctx context.Context, tournamentID uuid.UUID, userID uuid.UUID) error {
+func (tu *TournamentInteractor) Join(ctx context.Context, tournamentID uuid.UUID, userID uuid.UUID, input *JoinInput) (*JoinResult, error) {
+	if len(input.ClientSeed) > maxClientSeedSize {
+		return nil, kerror.Newf(kerror.BadRequest, "client seed should be at most %d characters", maxClientSeedSize)
+	}
+
+	request := &models.IdempotentRequest{
+		Key:       input.IdempotencyKey,
+		UserID:    userID,
+		Operation: models.JoinOperation,
+		Hash:      hashRequest(tournamentID, input.Stake, input.ClientSeed, input.Waitlist),
+	}
+	result := &JoinResult{}
+
 	err := tu.store.WithTransaction(func(store tx.DBTX) error {
-		isActiveTournament, err := tu.isActiveTournament(ctx, store, tournamentID)
-		if err != nil {
-			return kerror.Errorf(err, "check status of tournament")
+		return tu.idempotency.Do(ctx, store, request, result, func() error {
+			tournament, err := tu.lockTournament(ctx, store, tournamentID)
+			if err != nil {
+				return kerror.Errorf(err, "lock tournament")
+			}
+
+			if tournament.Status != models.RegistrationOpen {
+				return kerror.Newf(kerror.BadRequest, "registration of tournament isn't open")
+			}
+
+			if tournament.HasUser(userID) {
+				return kerror.Newf(kerror.AlreadyJoined, "user(%v) already joined tournament(%v)", userID, tournamentID)
+			}
+
+			stake := input.Stake
+			if stake.IsZero() {
+				stake = tournament.Deposit
+			}
+
+			if stake.Less(tournament.Deposit) {
+				return kerror.Newf(kerror.BadRequest, "stake(%v) should be at least deposit(%v)", stake, tournament.Deposit)
+			}
+
+			if err := tu.limits.CheckEntry(ctx, store, userID, tournament.Currency, stake); err != nil {
+				return kerror.Errorf(err, "entry limits")
+			}
+
+			if tournament.IsFull() {
+				if !input.Waitlist {
+					return kerror.Newf(kerror.TournamentIsFull, "tournament already has %v players", tournament.MaxPlayers)
+				}
+
+				result.Waitlisted = true
+				result.Position, err = tu.wait(ctx, store, tournamentID, &models.WaitlistEntry{
+					UserID:     userID,
+					Stake:      stake,
+					ClientSeed: input.ClientSeed,
+				})
+				if err != nil {
+					return kerror.Errorf(err, "put user on waitlist")
+				}
+
+				return nil
+			}
+
+			participant := &models.Participant{
+				UserID:     userID,
+				Stake:      stake,
+				ClientSeed: input.ClientSeed,
+			}
+
+			if err := tu.enter(ctx, store, tournament, participant); err != nil {
+				return kerror.Errorf(err, "enter tournament")
+			}
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, kerror.Errorf(err, "execution transaction")
+	}
+
+	return result, nil
+}
+
+// enter holds the stake of participant and adds it to tournament. The stake is charged when tournament starts.
+func (tu *TournamentInteractor) enter(ctx context.Context, store tx.DBTX, tournament *models.Tournament, participant *models.Participant) error {
+	if err := tu.ledger.Hold(ctx, store, &models.Hold{
+		TournamentID: tournament.ID,
+		UserID:       participant.UserID,
+		Currency:     tournament.Currency,
+		Amount:       participant.Stake,
+	}); err != nil {
+		return kerror.Errorf(err, "hold stake")
+	}
+
+	if err := tu.repo.InsertUserToTournament(ctx, store, tournament.ID, participant); err != nil {
+		return kerror.Errorf(err, "adding user to tournament")
+	}
+
+	return nil
+}
+
+// capture charges the held stakes of participants into the prize pool, taking rake from each of them.
+// Participants who joined before stakes were held have been charged on entry and have no holds.
+func (tu *TournamentInteractor) capture(ctx context.Context, store tx.DBTX, tournament *models.Tournament) error {
+	holds, err := tu.ledger.Holds(ctx, store, tournament.ID)
+	if err != nil {
+		return kerror.Errorf(err, "get holds")
+	}
+
+	for i := range holds {
+		hold := &holds[i]
+
+		rake := rakeOf(tournament, hold.Amount)
+		if err := tu.ledger.Capture(ctx, store, hold, newTransaction(models.JoinTransaction, tournament.Currency, tournament.ID,
+			userEntry(hold.UserID, hold.Amount.Neg()),
+			tournamentEntry(tournament.ID, hold.Amount.Sub(rake)),
+			houseEntry(rake),
+		)); err != nil {
+			return kerror.Errorf(err, "charge stake of user(%v)", hold.UserID)
+		}
+
+		if err := tu.repo.AddRake(ctx, store, tournament.ID, rake); err != nil {
+			return kerror.Errorf(err, "collect rake")
+		}
+
+		if err := tu.repo.AddToPrize(ctx, store, tournament.ID, hold.Amount.Sub(rake)); err != nil {
+			return kerror.Errorf(err, "adding to prize of tournament")
+		}
+
+		tournament.RakeCollected = tournament.RakeCollected.Add(rake)
+		tournament.Prize = tournament.Prize.Add(hold.Amount.Sub(rake))
+	}
+
+	return nil
+}
+
+// holdOf returns the hold of participant or nil, if the stake isn't held.
+func (tu *TournamentInteractor) holdOf(ctx context.Context, store tx.DBTX, tournamentID, userID uuid.UUID) (*models.Hold, error) {
+	holds, err := tu.ledger.Holds(ctx, store, tournamentID)
+	if err != nil {
+		return nil, kerror.Errorf(err, "get holds")
+	}
+
+	for i := range holds {
+		if holds[i].UserID == userID {
+			return &holds[i], nil
 		}
+	}
+
+	return nil, nil
+}
+
+// wait puts user at the end of waitlist and returns position of the entry.
+func (tu *TournamentInteractor) wait(ctx context.Context, store tx.DBTX, tournamentID uuid.UUID, entry *models.WaitlistEntry) (int, error) {
+	waitlist, err := tu.repo.SelectWaitlist(ctx, store, tournamentID)
+	if err != nil {
+		return 0, kerror.Errorf(err, "get waitlist")
+	}
 
-		if !isActiveTournament {
-			return kerror.Newf(kerror.BadRequest, "tournament isn't active")
+	for _, waiting := range waitlist {
+		if waiting.UserID == entry.UserID {
+			return 0, kerror.Newf(kerror.AlreadyJoined, "user(%v) already waits for tournament(%v)", entry.UserID, tournamentID)
 		}
+	}
 
-		deposit, err := tu.getDeposit(ctx, store, tournamentID)
+	if err := tu.repo.InsertWaitlistEntry(ctx, store, tournamentID, entry); err != nil {
+		return 0, kerror.Errorf(err, "save waitlist entry")
+	}
+
+	return len(waitlist) + 1, nil
+}
+
+func (tu *TournamentInteractor) RemoveParticipant(ctx context.Context, tournamentID, userID uuid.UUID) error {
+	return tu.withdraw(ctx, tournamentID, userID, false)
+}
+
+func (tu *TournamentInteractor) Leave(ctx context.Context, tournamentID, userID uuid.UUID) error {
+	return tu.withdraw(ctx, tournamentID, userID, true)
+}
+
+// withdraw takes user out of tournament before its start and gives the free place to waitlist.
+// The withdrawal penalty is taken only when participant leaves on request.
+func (tu *TournamentInteractor) withdraw(ctx context.Context, tournamentID, userID uuid.UUID, penalize bool) error {
+	err := tu.store.WithTransaction(func(store tx.DBTX) error {
+		tournament, err := tu.lockTournament(ctx, store, tournamentID)
 		if err != nil {
-			return kerror.Errorf(err, "getting deposit")
+			return kerror.Errorf(err, "lock tournament")
 		}
 
-		if err := tu.userRepo.UpdateBalanceBySum(ctx, store, userID, -deposit); err != nil {
-			return kerror.Errorf(err, "subtraction from the balance")
+		if !tournament.Status.IsRegistration() {
+			return kerror.Newf(kerror.BadRequest, "participants can't leave %v tournament", tournament.Status)
 		}
 
-		if err := tu.repo.AddToPrize(ctx, store, tournamentID, deposit); err != nil {
-			return kerror.Errorf(err, "adding to prize of tournament")
+		if err := tu.leave(ctx, store, tournament, userID, penalize); err != nil {
+			return kerror.Errorf(err, "remove participant")
 		}
 
-		if err := tu.repo.InsertUserToTournament(ctx, store, tournamentID, userID); err != nil {
-			return kerror.Errorf(err, "adding user to tournament")
+		if err := tu.promoteFromWaitlist(ctx, store, tournamentID); err != nil {
+			return kerror.Errorf(err, "promote from waitlist")
 		}
 
 		return nil
@@ -101,64 +390,183 @@ func (tu *TournamentInteractor) Join(ctx context.Context, tournamentID uuid.UUID
 	return nil
 }
 
-func (tu *TournamentInteractor) getDeposit(ctx context.Context, store tx.DBTX, tournamentID uuid.UUID) (float64, error) {
-	tournament, err := tu.repo.SelectByID(ctx, store, tournamentID)
+// leave takes participant out of tournament, releasing the held stake.
+// The withdrawal penalty is charged into the prize pool.
+// Stake charged on entry is refunded together with the rake taken from it.
+func (tu *TournamentInteractor) leave(ctx context.Context, store tx.DBTX, tournament *models.Tournament, userID uuid.UUID, penalize bool) error {
+	participant, err := tu.repo.SelectParticipant(ctx, store, tournament.ID, userID)
 	if err != nil {
-		return -1, kerror.Errorf(err, "get tournament")
+		return kerror.Errorf(err, "get participant")
 	}
 
-	return tournament.Deposit, nil
-}
+	if err := tu.repo.DeleteUserFromTournament(ctx, store, tournament.ID, userID); err != nil {
+		return kerror.Errorf(err, "delete user from tournament")
+	}
+
+	var penalty models.Money
+	if penalize {
+		penalty = withdrawalPenaltyOf(tournament, participant.Stake)
+	}
 
-func (tu *TournamentInteractor) isActiveTournament(ctx context.Context, store tx.DBTX, tournamentID uuid.UUID) (bool, error) {
-	status, err := tu.getStatus(ctx, store, tournamentID)
+	hold, err := tu.holdOf(ctx, store, tournament.ID, userID)
 	if err != nil {
-		return false, kerror.Errorf(err, "get status of tournament")
+		return kerror.Errorf(err, "get hold of participant")
+	}
+
+	if hold != nil {
+		if err := tu.ledger.Release(ctx, store, hold); err != nil {
+			return kerror.Errorf(err, "release stake")
+		}
+
+		if err := tu.ledger.Post(ctx, store, newTransaction(models.JoinTransaction, tournament.Currency, tournament.ID,
+			userEntry(userID, penalty.Neg()),
+			tournamentEntry(tournament.ID, penalty),
+		)); err != nil {
+			return kerror.Errorf(err, "charge withdrawal penalty")
+		}
+
+		if err := tu.repo.AddToPrize(ctx, store, tournament.ID, penalty); err != nil {
+			return kerror.Errorf(err, "adding penalty to prize of tournament")
+		}
+
+		return nil
 	}
 
-	return status == models.Active, nil
+	rake := rakeOf(tournament, participant.Stake)
+	if err := tu.ledger.Post(ctx, store, newTransaction(models.RefundTransaction, tournament.Currency, tournament.ID,
+		userEntry(userID, participant.Stake.Sub(penalty)),
+		tournamentEntry(tournament.ID, rake.Add(penalty).Sub(participant.Stake)),
+		houseEntry(rake.Neg()),
+	)); err != nil {
+		return kerror.Errorf(err, "refund stake")
+	}
+
+	if err := tu.repo.AddRake(ctx, store, tournament.ID, rake.Neg()); err != nil {
+		return kerror.Errorf(err, "return rake")
+	}
+
+	if err := tu.repo.AddToPrize(ctx, store, tournament.ID, rake.Add(penalty).Sub(participant.Stake)); err != nil {
+		return kerror.Errorf(err, "subtraction from prize of tournament")
+	}
+
+	return nil
 }
 
-func (tu *TournamentInteractor) getStatus(ctx context.Context, store tx.DBTX, tournamentID uuid.UUID) (models.TournamentStatus, error) {
+// promoteFromWaitlist fills free places of tournament with waitlisted users in order of waiting.
+// Users who can no longer pay their stake lose their place in waitlist.
+func (tu *TournamentInteractor) promoteFromWaitlist(ctx context.Context, store tx.DBTX, tournamentID uuid.UUID) error {
 	tournament, err := tu.repo.SelectByID(ctx, store, tournamentID)
 	if err != nil {
-		return "", kerror.Errorf(err, "get tournament")
+		return kerror.Errorf(err, "get tournament")
 	}
 
-	return tournament.Status, nil
-}
+	waitlist, err := tu.repo.SelectWaitlist(ctx, store, tournamentID)
+	if err != nil {
+		return kerror.Errorf(err, "get waitlist")
+	}
 
-func (tu *TournamentInteractor) Finish(ctx context.Context, id uuid.UUID) error {
-	err := tu.store.WithTransaction(func(store tx.DBTX) error {
+	for _, entry := range waitlist {
+		if tournament.IsFull() {
+			break
+		}
+
+		if err := tu.repo.DeleteWaitlistEntry(ctx, store, tournamentID, entry.UserID); err != nil {
+			return kerror.Errorf(err, "delete waitlist entry")
+		}
 
-		isActiveTournament, err := tu.isActiveTournament(ctx, store, id)
+		user, err := tu.userRepo.SelectByID(ctx, store, entry.UserID)
 		if err != nil {
-			return kerror.Errorf(err, "check status of tournament")
+			return kerror.Errorf(err, "get waitlisted user")
 		}
 
-		if !isActiveTournament {
-			return kerror.Newf(kerror.BadRequest, "tournament isn't active")
+		if user.AvailableIn(tournament.Currency).Less(entry.Stake) {
+			continue
 		}
 
-		prize, err := tu.getPrize(ctx, store, id)
-		if err != nil {
-			return kerror.Errorf(err, "get prize")
+		participant := &models.Participant{
+			UserID:     entry.UserID,
+			Stake:      entry.Stake,
+			ClientSeed: entry.ClientSeed,
 		}
 
-		winner, err := tu.generateWinner(ctx, store, id)
-		if err != nil {
-			return kerror.Errorf(err, "generate winner")
+		if err := tu.enter(ctx, store, tournament, participant); err != nil {
+			return kerror.Errorf(err, "enter tournament from waitlist")
 		}
+		tournament.Users = append(tournament.Users, *user)
+	}
 
-		if err := tu.userRepo.UpdateBalanceBySum(ctx, store, winner.ID, prize); err != nil {
-			return kerror.Errorf(err, "add prize to winner's balance")
+	return nil
+}
+
+func (tu *TournamentInteractor) GetWaitlist(ctx context.Context, tournamentID uuid.UUID) ([]models.WaitlistEntry, error) {
+	var waitlist []models.WaitlistEntry
+
+	err := tu.store.WithTransaction(func(store tx.DBTX) error {
+		if _, err := tu.repo.SelectByID(ctx, store, tournamentID); err != nil {
+			return kerror.Errorf(err, "get tournament")
 		}
 
-		if err := tu.repo.SetWinner(ctx, store, id, winner.ID); err != nil {
-			return kerror.Errorf(err, "set winner")
+		var err error
+
+		waitlist, err = tu.repo.SelectWaitlist(ctx, store, tournamentID)
+		if err != nil {
+			return kerror.Errorf(err, "get waitlist")
 		}
 
-		if err := tu.repo.UpdateStatus(ctx, store, id, models.Finish); err != nil {
+		return nil
+	})
+	if err != nil {
+		return nil, kerror.Errorf(err, "execution transaction")
+	}
+
+	return waitlist, nil
+}
+
+// lockTournament locks tournament until the end of transaction and returns it, so that entries and
+// transitions of its lifecycle are serialized.
+func (tu *TournamentInteractor) lockTournament(ctx context.Context, store tx.DBTX, id uuid.UUID) (*models.Tournament, error) {
+	if err := tu.repo.LockByID(ctx, store, id); err != nil {
+		return nil, kerror.Errorf(err, "lock tournament")
+	}
+
+	tournament, err := tu.repo.SelectByID(ctx, store, id)
+	if err != nil {
+		return nil, kerror.Errorf(err, "get tournament")
+	}
+
+	return tournament, nil
+}
+
+// changeStatus moves tournament to the next status if its lifecycle allows that.
+func (tu *TournamentInteractor) changeStatus(ctx context.Context, store tx.DBTX, tournament *models.Tournament, next models.TournamentStatus) error {
+	if !tournament.Status.CanBecome(next) {
+		return kerror.Newf(kerror.BadRequest, "tournament can't become %v from %v", next, tournament.Status)
+	}
+
+	if err := tu.repo.UpdateStatus(ctx, store, tournament.ID, tournament.Status, next); err != nil {
+		return kerror.Errorf(err, "update status")
+	}
+	tournament.Status = next
+
+	return nil
+}
+
+func (tu *TournamentInteractor) OpenRegistration(ctx context.Context, id uuid.UUID) error {
+	return tu.moveTo(ctx, id, models.RegistrationOpen)
+}
+
+func (tu *TournamentInteractor) CloseRegistration(ctx context.Context, id uuid.UUID) error {
+	return tu.moveTo(ctx, id, models.RegistrationClosed)
+}
+
+func (tu *TournamentInteractor) moveTo(ctx context.Context, id uuid.UUID, next models.TournamentStatus) error {
+	err := tu.store.WithTransaction(func(store tx.DBTX) error {
+		tournament, err := tu.lockTournament(ctx, store, id)
+		if err != nil {
+			return kerror.Errorf(err, "lock tournament")
+		}
+
+		if err := tu.changeStatus(ctx, store, tournament, next); err != nil {
 			return kerror.Errorf(err, "change status")
 		}
 
@@ -171,41 +579,223 @@ func (tu *TournamentInteractor) Finish(ctx context.Context, id uuid.UUID) error
 	return nil
 }
 
-func (tu *TournamentInteractor) generateWinner(ctx context.Context, store tx.DBTX, tournamentID uuid.UUID) (*models.User, error) {
-	winner, err := tu.repo.SelectRandomUserOfTournament(ctx, store, tournamentID)
+func (tu *TournamentInteractor) Finish(ctx context.Context, id uuid.UUID, input *FinishInput) error {
+	err := tu.store.WithTransaction(func(store tx.DBTX) error {
+		tournament, err := tu.lockTournament(ctx, store, id)
+		if err != nil {
+			return kerror.Errorf(err, "lock tournament")
+		}
+
+		return tu.finish(ctx, store, tournament, input)
+	})
 	if err != nil {
-		return nil, kerror.Errorf(err, "get random user")
+		return kerror.Errorf(err, "execution transaction")
 	}
 
-	return winner, nil
+	return nil
 }
 
-func (tu *TournamentInteractor) getPrize(ctx context.Context, store tx.DBTX, tournamentID uuid.UUID) (float64, error) {
-	tournament, err := tu.repo.SelectByID(ctx, store, tournamentID)
+// finish pays the places of tournament. Tournament that hasn't gathered the minimum of players is cancelled instead.
+// Stakes of tournament finished without start are charged first.
+func (tu *TournamentInteractor) finish(ctx context.Context, store tx.DBTX, tournament *models.Tournament, input *FinishInput) error {
+	if tournament.Status.IsRegistration() && len(tournament.Users) < tournament.MinPlayers {
+		if err := tu.cancel(ctx, store, tournament); err != nil {
+			return kerror.Errorf(err, "cancel tournament without minimum of players")
+		}
+
+		return nil
+	}
+
+	if tournament.Status.IsRegistration() {
+		if err := tu.capture(ctx, store, tournament); err != nil {
+			return kerror.Errorf(err, "charge stakes")
+		}
+	}
+
+	ranking, err := tu.decideRanking(ctx, store, tournament, input)
+	if err != nil {
+		return kerror.Errorf(err, "decide ranking")
+	}
+
+	if err := tu.rewardPlaces(ctx, store, tournament, ranking); err != nil {
+		return kerror.Errorf(err, "reward places")
+	}
+
+	return nil
+}
+
+// rewardPlaces pays every place of ranking and finishes tournament.
+func (tu *TournamentInteractor) rewardPlaces(ctx context.Context, store tx.DBTX, tournament *models.Tournament, ranking []uuid.UUID) error {
+	placements, err := distributePrize(tournament, ranking)
+	if err != nil {
+		return kerror.Errorf(err, "distribute prize")
+	}
+
+	for _, placement := range placements {
+		if err := tu.ledger.Post(ctx, store, newTransaction(models.PrizeTransaction, tournament.Currency, tournament.ID,
+			userEntry(placement.UserID, placement.Prize),
+			tournamentEntry(tournament.ID, placement.Prize.Neg()),
+		)); err != nil {
+			return kerror.Errorf(err, "pay prize of %v place", placement.Place)
+		}
+
+		if err := tu.repo.InsertPlacement(ctx, store, tournament.ID, &placement); err != nil {
+			return kerror.Errorf(err, "save %v place", placement.Place)
+		}
+	}
+
+	if err := tu.repo.SetWinner(ctx, store, tournament.ID, ranking[0]); err != nil {
+		return kerror.Errorf(err, "set winner")
+	}
+
+	if err := tu.repo.RevealServerSeed(ctx, store, tournament.ID); err != nil {
+		return kerror.Errorf(err, "reveal server seed")
+	}
+
+	if err := tu.changeStatus(ctx, store, tournament, models.Finished); err != nil {
+		return kerror.Errorf(err, "change status")
+	}
+
+	return nil
+}
+
+func (tu *TournamentInteractor) decideRanking(ctx context.Context, store tx.DBTX, tournament *models.Tournament, input *FinishInput) ([]uuid.UUID, error) {
+	switch tournament.Status {
+	case models.RegistrationOpen, models.RegistrationClosed:
+		return tu.rankByStrategy(ctx, store, tournament, input)
+	case models.InProgress:
+		if !tournament.Format.HasMatches() {
+			return tu.rankByStrategy(ctx, store, tournament, input)
+		}
+
+		if !tournament.Format.HasStandings() {
+			return nil, kerror.Newf(kerror.BadRequest, "winner of %v tournament is decided by final", tournament.Format)
+		}
+
+		ranking, err := tu.finalStandings(ctx, store, tournament)
+		if err != nil {
+			return nil, kerror.Errorf(err, "get final standings")
+		}
+
+		return ranking, nil
+	}
+
+	return nil, kerror.Newf(kerror.BadRequest, "%v tournament can't be finished", tournament.Status)
+}
+
+func (tu *TournamentInteractor) rankByStrategy(ctx context.Context, store tx.DBTX, tournament *models.Tournament, input *FinishInput) ([]uuid.UUID, error) {
+	selector, ok := tu.selectors[tournament.WinnerStrategy]
+	if !ok {
+		return nil, kerror.Newf(kerror.InternalServerError, "unknown winner strategy: %v", tournament.WinnerStrategy)
+	}
+
+	ranking, err := selector.RankPlayers(ctx, store, tournament, input)
 	if err != nil {
-		return -1, kerror.Errorf(err, "get tournament")
+		return nil, kerror.Errorf(err, "rank players by %v strategy", tournament.WinnerStrategy)
 	}
 
-	return tournament.Prize, nil
+	return ranking, nil
 }
 
 func (tu *TournamentInteractor) Cancel(ctx context.Context, id uuid.UUID) error {
 	err := tu.store.WithTransaction(func(store tx.DBTX) error {
-		isActiveTournament, err := tu.isActiveTournament(ctx, store, id)
+		tournament, err := tu.lockTournament(ctx, store, id)
 		if err != nil {
-			return kerror.Errorf(err, "check status of tournament")
+			return kerror.Errorf(err, "lock tournament")
 		}
 
-		if !isActiveTournament {
-			return kerror.Newf(kerror.BadRequest, "tournament isn't active")
+		return tu.cancel(ctx, store, tournament)
+	})
+	if err != nil {
+		return kerror.Errorf(err, "execution transaction")
+	}
+
+	return nil
+}
+
+// cancel releases held stakes, refunds entries charged on join or on start and moves tournament to Cancelled with empty prize pool.
+func (tu *TournamentInteractor) cancel(ctx context.Context, store tx.DBTX, tournament *models.Tournament) error {
+	if !tournament.Status.CanBecome(models.Cancelled) {
+		return kerror.Newf(kerror.BadRequest, "%v tournament can't be cancelled", tournament.Status)
+	}
+
+	holds, err := tu.ledger.Holds(ctx, store, tournament.ID)
+	if err != nil {
+		return kerror.Errorf(err, "get holds")
+	}
+
+	held := make(map[uuid.UUID]bool, len(holds))
+	for i := range holds {
+		if err := tu.ledger.Release(ctx, store, &holds[i]); err != nil {
+			return kerror.Errorf(err, "release stake of user(%v)", holds[i].UserID)
 		}
+		held[holds[i].UserID] = true
+	}
 
-		if err := tu.repo.RefundDepositToUsers(ctx, store, id); err != nil {
-			return kerror.Errorf(err, "add deposit to user balance")
+	// Rake goes back to the pool first, so that entries charged on join are refunded from it in whole.
+	pool := tournament.GrossEntries()
+	if err := tu.ledger.Post(ctx, store, newTransaction(models.RefundTransaction, tournament.Currency, tournament.ID,
+		houseEntry(tournament.RakeCollected.Neg()),
+		tournamentEntry(tournament.ID, tournament.RakeCollected),
+	)); err != nil {
+		return kerror.Errorf(err, "return rake to prize pool")
+	}
+
+	if err := tu.repo.AddRake(ctx, store, tournament.ID, tournament.RakeCollected.Neg()); err != nil {
+		return kerror.Errorf(err, "return rake")
+	}
+
+	participants, err := tu.repo.SelectParticipants(ctx, store, tournament.ID)
+	if err != nil {
+		return kerror.Errorf(err, "get participants")
+	}
+
+	for _, participant := range participants {
+		if held[participant.UserID] {
+			continue
 		}
 
-		if err := tu.repo.UpdateStatus(ctx, store, id, models.Cancel); err != nil {
-			return kerror.Errorf(err, "change status")
+		if err := tu.ledger.Post(ctx, store, newTransaction(models.RefundTransaction, tournament.Currency, tournament.ID,
+			userEntry(participant.UserID, participant.Stake),
+			tournamentEntry(tournament.ID, participant.Stake.Neg()),
+		)); err != nil {
+			return kerror.Errorf(err, "refund stake to user(%v)", participant.UserID)
+		}
+		pool = pool.Sub(participant.Stake)
+	}
+
+	// Only withdrawal penalties are left in the pool. Nobody plays for them anymore, so the house keeps them.
+	if err := tu.ledger.Post(ctx, store, newTransaction(models.JoinTransaction, tournament.Currency, tournament.ID,
+		tournamentEntry(tournament.ID, pool.Neg()),
+		houseEntry(pool),
+	)); err != nil {
+		return kerror.Errorf(err, "move withdrawal penalties to house")
+	}
+
+	if err := tu.repo.AddToPrize(ctx, store, tournament.ID, tournament.Prize.Neg()); err != nil {
+		return kerror.Errorf(err, "empty prize pool")
+	}
+
+	if err := tu.changeStatus(ctx, store, tournament, models.Cancelled); err != nil {
+		return kerror.Errorf(err, "change status")
+	}
+
+	return nil
+}
+
+func (tu *TournamentInteractor) ReportScore(ctx context.Context, tournamentID, userID uuid.UUID, score float64) error {
+	err := tu.store.WithTransaction(func(store tx.DBTX) error {
+		tournament, err := tu.lockTournament(ctx, store, tournamentID)
+		if err != nil {
+			return kerror.Errorf(err, "lock tournament")
+		}
+
+		if err := checkScoreReport(tournament); err != nil {
+			return kerror.Errorf(err, "check score")
+		}
+
+		if err := tu.repo.UpdateScore(ctx, store, tournamentID, userID, score); err != nil {
+			return kerror.Errorf(err, "save score")
 		}
 
 		return nil
@@ -216,3 +806,21 @@ func (tu *TournamentInteractor) Cancel(ctx context.Context, id uuid.UUID) error
 
 	return nil
 }
+
+// checkScoreReport lets scores be reported during registration and to started tournament without matches.
+// Started tournament with matches is decided by their results, so scores of its players aren't taken.
+func checkScoreReport(tournament *models.Tournament) error {
+	if tournament.Status.IsRegistration() {
+		return nil
+	}
+
+	if tournament.Status != models.InProgress {
+		return kerror.Newf(kerror.BadRequest, "scores can't be reported to %v tournament", tournament.Status)
+	}
+
+	if tournament.Format.HasMatches() {
+		return kerror.Newf(kerror.BadRequest, "started %v tournament is decided by its matches, not scores", tournament.Format)
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/kimbellG/tournament/core/models"
@@ -10,14 +11,31 @@ import (
 
 type TournamentRepository interface {
 	Insert(ctx context.Context, repo tx.DBTX, tournament *models.Tournament) (uuid.UUID, error)
-	InsertUserToTournament(ctx context.Context, repo tx.DBTX, tournamentID, userID uuid.UUID) error
+	InsertUserToTournament(ctx context.Context, repo tx.DBTX, tournamentID uuid.UUID, participant *models.Participant) error
+	// InsertPayouts saves percentages or fixed prizes of paid places, whichever payout type of tournament uses.
+	InsertPayouts(ctx context.Context, repo tx.DBTX, tournament *models.Tournament) error
+	InsertPlacement(ctx context.Context, repo tx.DBTX, tournamentID uuid.UUID, placement *models.Placement) error
 
 	SelectByID(ctx context.Context, repo tx.DBTX, id uuid.UUID) (*models.Tournament, error)
-	SelectRandomUserOfTournament(ctx context.Context, repo tx.DBTX, tournamentID uuid.UUID) (*models.User, error)
+	LockByID(ctx context.Context, repo tx.DBTX, id uuid.UUID) error
+	SelectParticipants(ctx context.Context, repo tx.DBTX, tournamentID uuid.UUID) ([]models.Participant, error)
+	SelectParticipant(ctx context.Context, repo tx.DBTX, tournamentID, userID uuid.UUID) (*models.Participant, error)
+	DeleteUserFromTournament(ctx context.Context, repo tx.DBTX, tournamentID, userID uuid.UUID) error
 
-	AddToPrize(ctx context.Context, repo tx.DBTX, ID uuid.UUID, end float64) error
-	RefundDepositToUsers(ctx context.Context, repo tx.DBTX, tournamentID uuid.UUID) error
+	InsertWaitlistEntry(ctx context.Context, repo tx.DBTX, tournamentID uuid.UUID, entry *models.WaitlistEntry) error
+	SelectWaitlist(ctx context.Context, repo tx.DBTX, tournamentID uuid.UUID) ([]models.WaitlistEntry, error)
+	DeleteWaitlistEntry(ctx context.Context, repo tx.DBTX, tournamentID, userID uuid.UUID) error
+
+	AddToPrize(ctx context.Context, repo tx.DBTX, ID uuid.UUID, end models.Money) error
+	AddRake(ctx context.Context, repo tx.DBTX, tournamentID uuid.UUID, rake models.Money) error
 	SetWinner(ctx context.Context, repo tx.DBTX, tournamentID, userID uuid.UUID) error
+	UpdateScore(ctx context.Context, repo tx.DBTX, tournamentID, userID uuid.UUID, score float64) error
+	RevealServerSeed(ctx context.Context, repo tx.DBTX, tournamentID uuid.UUID) error
+
+	// UpdateStatus moves tournament from status to newStatus and fails, if tournament isn't in status anymore.
+	UpdateStatus(ctx context.Context, repo tx.DBTX, tournamentID uuid.UUID, status, newStatus models.TournamentStatus) error
 
-	UpdateStatus(ctx context.Context, repo tx.DBTX, tournamentID uuid.UUID, newStatus models.TournamentStatus) error
+	LockNextScheduled(ctx context.Context, repo tx.DBTX, now time.Time, skipped []uuid.UUID) (uuid.UUID, error)
+	// ClearSchedule drops the moment of schedule, at which tournament moves to next status.
+	ClearSchedule(ctx context.Context, repo tx.DBTX, tournamentID uuid.UUID, next models.TournamentStatus) error
 }
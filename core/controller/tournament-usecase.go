@@ -7,10 +7,47 @@ import (
 	"github.com/kimbellG/tournament/core/models"
 )
 
+// FinishInput carries what the winner strategy of tournament needs to rank the players.
+// Ranking lists players from the first place down.
+type FinishInput struct {
+	Ranking []uuid.UUID
+}
+
+// JoinInput carries optional parameters of entry to tournament.
+// Waitlist asks to wait for a free place instead of being rejected by full tournament.
+// Retry of join with the same IdempotencyKey returns the first result.
+type JoinInput struct {
+	Stake          models.Money
+	ClientSeed     string
+	Waitlist       bool
+	IdempotencyKey string
+}
+
+// JoinResult tells whether user entered tournament or was put on its waitlist.
+type JoinResult struct {
+	Waitlisted bool `json:"waitlisted"`
+	Position   int  `json:"position"`
+}
+
 type TournamentController interface {
 	Create(ctx context.Context, tournament *models.Tournament) (uuid.UUID, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*models.Tournament, error)
-	Join(ctx context.Context, tournamnetID uuid.UUID, userID uuid.UUID) error
-	Finish(ctx context.Context, id uuid.UUID) error
+	Join(ctx context.Context, tournamnetID uuid.UUID, userID uuid.UUID, input *JoinInput) (*JoinResult, error)
+	Leave(ctx context.Context, tournamentID, userID uuid.UUID) error
+	RemoveParticipant(ctx context.Context, tournamentID, userID uuid.UUID) error
+	GetWaitlist(ctx context.Context, tournamentID uuid.UUID) ([]models.WaitlistEntry, error)
+	Finish(ctx context.Context, id uuid.UUID, input *FinishInput) error
 	Cancel(ctx context.Context, id uuid.UUID) error
+	OpenRegistration(ctx context.Context, id uuid.UUID) error
+	CloseRegistration(ctx context.Context, id uuid.UUID) error
+	ReportScore(ctx context.Context, tournamentID, userID uuid.UUID, score float64) error
+
+	Start(ctx context.Context, id uuid.UUID) error
+	GetMatches(ctx context.Context, id uuid.UUID) ([]models.Match, error)
+	GetStandings(ctx context.Context, id uuid.UUID) ([]models.Standing, error)
+	ReportMatchResult(ctx context.Context, tournamentID, matchID, winnerID uuid.UUID, draw bool) error
+
+	GetDrawProof(ctx context.Context, id uuid.UUID) (*models.DrawProof, error)
+
+	Scheduler
 }
@@ -2,8 +2,9 @@ package controller
 
 import (
 	"context"
-	"crypto/sha256"
-	"fmt"
+	"errors"
+	"net/mail"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/kimbellG/kerror"
@@ -12,26 +13,68 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+const maxEmailSize = 255
+
 type UserInteractor struct {
-	UserRepo UserRepository
-	store    tx.Store
+	UserRepo    UserRepository
+	ledger      *Ledger
+	idempotency *Idempotency
+	limits      *Limits
+	passwords   models.PasswordPolicy
+	resets      *PasswordResets
+	sessions    *Sessions
+	store       tx.Store
 }
 
-func NewUserController(repo UserRepository, store tx.Store) UserController {
+func NewUserController(repo UserRepository, ledger *Ledger, idempotency *Idempotency, limits *Limits,
+	passwords models.PasswordPolicy, resets *PasswordResets, sessions *Sessions, store tx.Store) UserController {
 	return &UserInteractor{
-		UserRepo: repo,
-		store:    store,
+		UserRepo:    repo,
+		ledger:      ledger,
+		idempotency: idempotency,
+		limits:      limits,
+		passwords:   passwords,
+		resets:      resets,
+		sessions:    sessions,
+		store:       store,
 	}
 }
 
+// Save creates user with the password user has chosen and empty balances. User without password gets
+// a generated one, which is returned in Password of the created user.
 func (ui *UserInteractor) Save(ctx context.Context, user *models.User) (*models.User, error) {
 	created := &models.User{
 		Name:     user.Name,
-		Password: generatePassword(),
-		Balance:  user.Balance,
+		Email:    user.Email,
+		Balances: user.Balances,
+	}
+
+	if created.Email != "" {
+		if _, err := mail.ParseAddress(created.Email); err != nil || len(created.Email) > maxEmailSize {
+			return nil, kerror.Newf(kerror.BadRequest, "invalid email: %q", created.Email)
+		}
 	}
 
-	hash, err := hashPassword(fmt.Sprintf("%x", sha256.Sum256([]byte(created.Password))))
+	password := user.Password
+	if password == "" {
+		password = generatePassword()
+		created.Password = password
+	} else if err := ui.passwords.Check(password); err != nil {
+		return nil, kerror.Newf(kerror.BadRequest, "weak password: %v", err)
+	}
+
+	for _, balance := range created.Balances {
+		if !balance.Currency.Valid() {
+			return nil, kerror.Newf(kerror.BadRequest, "invalid currency of balance: %q", balance.Currency)
+		}
+
+		// Sign-up is open to anyone, so money comes only through funding of the created account.
+		if !balance.Amount.IsZero() {
+			return nil, kerror.Newf(kerror.BadRequest, "balance in %v should be funded after sign-up", balance.Currency)
+		}
+	}
+
+	hash, err := hashPassword(password)
 	if err != nil {
 		return nil, kerror.Newf(kerror.InternalServerError, "hashing password: %v", err)
 	}
@@ -89,13 +132,40 @@ func (ui *UserInteractor) DeleteByID(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
-func (ui *UserInteractor) UpdateBalance(ctx context.Context, id uuid.UUID, addend float64) error {
+func (ui *UserInteractor) UpdateBalance(ctx context.Context, id uuid.UUID, currency models.Currency, addend models.Money, idempotencyKey string) error {
+	if !currency.Valid() {
+		return kerror.Newf(kerror.BadRequest, "invalid currency: %q", currency)
+	}
+
+	request := &models.IdempotentRequest{
+		Key:       idempotencyKey,
+		UserID:    id,
+		Operation: models.UpdateBalanceOperation,
+		Hash:      hashRequest(currency, addend),
+	}
+
 	err := ui.store.WithTransaction(func(store tx.DBTX) error {
-		if err := ui.UserRepo.UpdateBalanceBySum(ctx, store, id, addend); err != nil {
-			return kerror.Errorf(err, "repository")
-		}
+		return ui.idempotency.Do(ctx, store, request, nil, func() error {
+			transactionType := models.FundTransaction
+			if addend.IsNegative() {
+				transactionType = models.TakeTransaction
+			}
 
-		return nil
+			if addend.IsPositive() {
+				if err := ui.limits.CheckDeposit(ctx, store, id, currency, addend); err != nil {
+					return kerror.Errorf(err, "deposit limits")
+				}
+			}
+
+			if err := ui.ledger.Post(ctx, store, newTransaction(transactionType, currency, uuid.Nil,
+				userEntry(id, addend),
+				externalEntry(addend.Neg()),
+			)); err != nil {
+				return kerror.Errorf(err, "ledger")
+			}
+
+			return nil
+		})
 	})
 	if err != nil {
 		return kerror.Errorf(err, "execution transaction")
@@ -104,7 +174,8 @@ func (ui *UserInteractor) UpdateBalance(ctx context.Context, id uuid.UUID, adden
 	return nil
 }
 
-func (ui *UserInteractor) Authorization(ctx context.Context, username, password string) (*models.User, error) {
+// Authorization checks password of user and opens a new session of the user.
+func (ui *UserInteractor) Authorization(ctx context.Context, username, password string) (*models.Grant, error) {
 	var user *models.User
 
 	err := ui.store.WithTransaction(func(store tx.DBTX) error {
@@ -126,5 +197,335 @@ func (ui *UserInteractor) Authorization(ctx context.Context, username, password
 		return nil, kerror.Newf(kerror.IncorrectPassword, "compare password: %v", err)
 	}
 
-	return user, nil
+	grant := &models.Grant{Role: user.Role}
+	err = ui.store.WithTransaction(func(store tx.DBTX) error {
+		session, token, err := ui.sessions.Open(ctx, store, user.ID)
+		if err != nil {
+			return kerror.Errorf(err, "open session")
+		}
+		grant.Session, grant.RefreshToken = *session, token
+
+		return nil
+	})
+	if err != nil {
+		return nil, kerror.Errorf(err, "execution transaction")
+	}
+
+	return grant, nil
+}
+
+// RefreshSession extends session the refresh token belongs to and rotates the token.
+func (ui *UserInteractor) RefreshSession(ctx context.Context, token string) (*models.Grant, error) {
+	var grant *models.Grant
+
+	err := ui.store.WithTransaction(func(store tx.DBTX) error {
+		session, next, err := ui.sessions.Refresh(ctx, store, token)
+		if err != nil {
+			return kerror.Errorf(err, "refresh session")
+		}
+
+		if session == nil {
+			return nil
+		}
+
+		user, err := ui.UserRepo.SelectByID(ctx, store, session.UserID)
+		if err != nil {
+			return kerror.Errorf(err, "get user of session")
+		}
+
+		grant = &models.Grant{
+			Session:      *session,
+			Role:         user.Role,
+			RefreshToken: next,
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, kerror.Errorf(err, "execution transaction")
+	}
+
+	// Reused token revokes its session, so the transaction is committed before the failure is reported.
+	if grant == nil {
+		return nil, kerror.Newf(kerror.Unauthenticated, "refresh token is invalid, used or expired")
+	}
+
+	return grant, nil
+}
+
+// Logout revokes session, so its access and refresh tokens stop working.
+func (ui *UserInteractor) Logout(ctx context.Context, sessionID uuid.UUID) error {
+	err := ui.store.WithTransaction(func(store tx.DBTX) error {
+		if err := ui.sessions.Revoke(ctx, store, sessionID); err != nil {
+			return kerror.Errorf(err, "revoke session")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return kerror.Errorf(err, "execution transaction")
+	}
+
+	return nil
+}
+
+func (ui *UserInteractor) SessionActive(ctx context.Context, sessionID uuid.UUID) (bool, error) {
+	var active bool
+
+	err := ui.store.WithTransaction(func(store tx.DBTX) error {
+		var err error
+
+		active, err = ui.sessions.Active(ctx, store, sessionID)
+		if err != nil {
+			return kerror.Errorf(err, "check session")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, kerror.Errorf(err, "execution transaction")
+	}
+
+	return active, nil
+}
+
+// ChangePassword replaces password of user, once the old one is confirmed, and revokes the sessions of user
+// except the current session of the caller.
+func (ui *UserInteractor) ChangePassword(ctx context.Context, id, sessionID uuid.UUID, oldPassword, newPassword string) error {
+	if err := ui.passwords.Check(newPassword); err != nil {
+		return kerror.Newf(kerror.BadRequest, "weak password: %v", err)
+	}
+
+	if newPassword == oldPassword {
+		return kerror.Newf(kerror.BadRequest, "new password should differ from the old one")
+	}
+
+	hash, err := hashPassword(newPassword)
+	if err != nil {
+		return kerror.Newf(kerror.InternalServerError, "hashing password: %v", err)
+	}
+
+	err = ui.store.WithTransaction(func(store tx.DBTX) error {
+		user, err := ui.UserRepo.SelectByID(ctx, store, id)
+		if err != nil {
+			return kerror.Errorf(err, "get user")
+		}
+
+		if err := checkPassword(user.Password, oldPassword); err != nil {
+			return kerror.Newf(kerror.IncorrectPassword, "compare password: %v", err)
+		}
+
+		if err := ui.UserRepo.UpdatePassword(ctx, store, id, hash); err != nil {
+			return kerror.Errorf(err, "save password")
+		}
+
+		if err := ui.sessions.RevokeByUser(ctx, store, id, sessionID); err != nil {
+			return kerror.Errorf(err, "revoke other sessions")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return kerror.Errorf(err, "execution transaction")
+	}
+
+	return nil
+}
+
+// RequestPasswordReset sends token of password reset to user. Request for unknown user succeeds
+// without sending anything, so it can't be used to find out whether user exists.
+func (ui *UserInteractor) RequestPasswordReset(ctx context.Context, username string) error {
+	var (
+		user  *models.User
+		token string
+		reset *models.PasswordReset
+	)
+
+	err := ui.store.WithTransaction(func(store tx.DBTX) error {
+		var err error
+
+		user, err = ui.UserRepo.SelectByName(ctx, store, username)
+		if err != nil {
+			return kerror.Errorf(err, "get user")
+		}
+
+		token, reset, err = ui.resets.Issue(ctx, store, user.ID)
+		if err != nil {
+			return kerror.Errorf(err, "issue reset token")
+		}
+
+		return nil
+	})
+	if isUserMissing(err) {
+		return nil
+	}
+	if err != nil {
+		return kerror.Errorf(err, "execution transaction")
+	}
+
+	if err := ui.resets.Notify(ctx, user, token, reset); err != nil {
+		return kerror.Errorf(err, "send reset token")
+	}
+
+	return nil
+}
+
+// isUserMissing reports whether err is caused by the absence of user.
+func isUserMissing(err error) bool {
+	var kerr kerror.Error
+	return errors.As(err, &kerr) && kerr.StatusCode() == kerror.UserDoesntExists
+}
+
+// ResetPassword sets new password of user the token was issued for, uses the token up and revokes every session of user.
+func (ui *UserInteractor) ResetPassword(ctx context.Context, token, newPassword string) error {
+	if err := ui.passwords.Check(newPassword); err != nil {
+		return kerror.Newf(kerror.BadRequest, "weak password: %v", err)
+	}
+
+	hash, err := hashPassword(newPassword)
+	if err != nil {
+		return kerror.Newf(kerror.InternalServerError, "hashing password: %v", err)
+	}
+
+	err = ui.store.WithTransaction(func(store tx.DBTX) error {
+		id, err := ui.resets.Consume(ctx, store, token)
+		if err != nil {
+			return kerror.Errorf(err, "consume reset token")
+		}
+
+		if err := ui.UserRepo.UpdatePassword(ctx, store, id, hash); err != nil {
+			return kerror.Errorf(err, "save password")
+		}
+
+		if err := ui.sessions.RevokeByUser(ctx, store, id, uuid.Nil); err != nil {
+			return kerror.Errorf(err, "revoke sessions")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return kerror.Errorf(err, "execution transaction")
+	}
+
+	return nil
+}
+
+// SetRole gives role to user. Tokens carry the role they were issued with, so sessions of user are revoked
+// when the role changes.
+func (ui *UserInteractor) SetRole(ctx context.Context, id uuid.UUID, role models.Role) error {
+	if !role.Valid() {
+		return kerror.Newf(kerror.BadRequest, "unknown role: %q", role)
+	}
+
+	err := ui.store.WithTransaction(func(store tx.DBTX) error {
+		user, err := ui.UserRepo.SelectByID(ctx, store, id)
+		if err != nil {
+			return kerror.Errorf(err, "get user")
+		}
+
+		if user.Role == role {
+			return nil
+		}
+
+		if err := ui.UserRepo.UpdateRole(ctx, store, id, role); err != nil {
+			return kerror.Errorf(err, "save role")
+		}
+
+		if err := ui.sessions.RevokeByUser(ctx, store, id, uuid.Nil); err != nil {
+			return kerror.Errorf(err, "revoke sessions")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return kerror.Errorf(err, "execution transaction")
+	}
+
+	return nil
+}
+
+func (ui *UserInteractor) ListTransactions(ctx context.Context, id uuid.UUID, filter *models.TransactionFilter) ([]models.UserTransaction, int, error) {
+	var (
+		transactions []models.UserTransaction
+		nextOffset   int
+	)
+
+	err := ui.store.WithTransaction(func(store tx.DBTX) error {
+		if _, err := ui.UserRepo.SelectByID(ctx, store, id); err != nil {
+			return kerror.Errorf(err, "get user")
+		}
+
+		var err error
+
+		transactions, nextOffset, err = ui.ledger.UserTransactions(ctx, store, id, filter)
+		if err != nil {
+			return kerror.Errorf(err, "ledger")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, 0, kerror.Errorf(err, "execution transaction")
+	}
+
+	return transactions, nextOffset, nil
+}
+
+func (ui *UserInteractor) SetLimit(ctx context.Context, limit *models.Limit) (*models.Limit, error) {
+	var saved *models.Limit
+
+	err := ui.store.WithTransaction(func(store tx.DBTX) error {
+		if _, err := ui.UserRepo.SelectByID(ctx, store, limit.UserID); err != nil {
+			return kerror.Errorf(err, "get user")
+		}
+
+		var err error
+
+		saved, err = ui.limits.Set(ctx, store, limit)
+		if err != nil {
+			return kerror.Errorf(err, "set limit")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, kerror.Errorf(err, "execution transaction")
+	}
+
+	return saved, nil
+}
+
+func (ui *UserInteractor) GetLimits(ctx context.Context, id uuid.UUID) (*models.SelfLimits, error) {
+	var limits *models.SelfLimits
+
+	err := ui.store.WithTransaction(func(store tx.DBTX) error {
+		var err error
+
+		limits, err = ui.limits.Get(ctx, store, id)
+		if err != nil {
+			return kerror.Errorf(err, "get limits")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, kerror.Errorf(err, "execution transaction")
+	}
+
+	return limits, nil
+}
+
+func (ui *UserInteractor) SelfExclude(ctx context.Context, id uuid.UUID, until time.Time) error {
+	err := ui.store.WithTransaction(func(store tx.DBTX) error {
+		if err := ui.limits.Exclude(ctx, store, id, until); err != nil {
+			return kerror.Errorf(err, "exclude user")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return kerror.Errorf(err, "execution transaction")
+	}
+
+	return nil
 }
@@ -13,5 +13,7 @@ type UserRepository interface {
 	SelectByID(ctx context.Context, store tx.DBTX, id uuid.UUID) (*models.User, error)
 	SelectByName(ctx context.Context, store tx.DBTX, username string) (*models.User, error)
 	DeleteByID(ctx context.Context, store tx.DBTX, id uuid.UUID) error
-	UpdateBalanceBySum(ctx context.Context, store tx.DBTX, id uuid.UUID, d float64) error
+	UpdatePassword(ctx context.Context, store tx.DBTX, id uuid.UUID, password string) error
+	UpdateRole(ctx context.Context, store tx.DBTX, id uuid.UUID, role models.Role) error
+	UpdateBalanceBySum(ctx context.Context, store tx.DBTX, id uuid.UUID, currency models.Currency, d models.Money) error
 }
@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/kimbellG/tournament/core/models"
@@ -11,6 +12,17 @@ type UserController interface {
 	Save(ctx context.Context, user *models.User) (*models.User, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*models.User, error)
 	DeleteByID(ctx context.Context, id uuid.UUID) error
-	UpdateBalance(ctx context.Context, id uuid.UUID, addend float64) error
-	Authorization(ctx context.Context, username, password string) (*models.User, error)
+	UpdateBalance(ctx context.Context, id uuid.UUID, currency models.Currency, addend models.Money, idempotencyKey string) error
+	Authorization(ctx context.Context, username, password string) (*models.Grant, error)
+	RefreshSession(ctx context.Context, token string) (*models.Grant, error)
+	Logout(ctx context.Context, sessionID uuid.UUID) error
+	SessionActive(ctx context.Context, sessionID uuid.UUID) (bool, error)
+	ChangePassword(ctx context.Context, id, sessionID uuid.UUID, oldPassword, newPassword string) error
+	RequestPasswordReset(ctx context.Context, username string) error
+	ResetPassword(ctx context.Context, token, newPassword string) error
+	SetRole(ctx context.Context, id uuid.UUID, role models.Role) error
+	ListTransactions(ctx context.Context, id uuid.UUID, filter *models.TransactionFilter) ([]models.UserTransaction, int, error)
+	SetLimit(ctx context.Context, limit *models.Limit) (*models.Limit, error)
+	GetLimits(ctx context.Context, id uuid.UUID) (*models.SelfLimits, error)
+	SelfExclude(ctx context.Context, id uuid.UUID, until time.Time) error
 }
@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+	"github.com/stretchr/testify/assert"
+)
+
+type waitlistRepository struct {
+	TournamentRepository
+	waitlist []models.WaitlistEntry
+}
+
+func (wr *waitlistRepository) SelectWaitlist(_ context.Context, _ tx.DBTX, _ uuid.UUID) ([]models.WaitlistEntry, error) {
+	return append([]models.WaitlistEntry{}, wr.waitlist...), nil
+}
+
+func (wr *waitlistRepository) InsertWaitlistEntry(_ context.Context, _ tx.DBTX, _ uuid.UUID, entry *models.WaitlistEntry) error {
+	wr.waitlist = append(wr.waitlist, *entry)
+	return nil
+}
+
+func TestWait(t *testing.T) {
+	first, second := uuid.New(), uuid.New()
+	tu := &TournamentInteractor{repo: &waitlistRepository{}}
+
+	tt := []struct {
+		name     string
+		userID   uuid.UUID
+		position int
+		code     kerror.StatusCode
+	}{
+		{name: "first user", userID: first, position: 1},
+		{name: "second user", userID: second, position: 2},
+		{name: "same user again", userID: first, code: kerror.AlreadyJoined},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			position, err := tu.wait(context.Background(), nil, uuid.New(), &models.WaitlistEntry{UserID: tc.userID, Stake: money(100)})
+			if tc.code == kerror.AlreadyJoined {
+				var kerr kerror.Error
+				if assert.True(t, errors.As(err, &kerr), "duplicate entry should be rejected") {
+					assert.Equal(t, tc.code, kerr.StatusCode())
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.position, position)
+		})
+	}
+}
@@ -0,0 +1,243 @@
+package controller
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+)
+
+// WinnerSelector ranks players of a tournament that is finished without matches.
+// It ranks at most as many players as tournament has paid places.
+type WinnerSelector interface {
+	RankPlayers(ctx context.Context, store tx.DBTX, tournament *models.Tournament, input *FinishInput) ([]uuid.UUID, error)
+}
+
+func defaultWinnerSelectors(repo TournamentRepository) map[models.WinnerStrategy]WinnerSelector {
+	return map[models.WinnerStrategy]WinnerSelector{
+		models.RandomWinner: &randomSelector{repo: repo},
+		models.StakeWinner:  &stakeSelector{repo: repo},
+		models.ScoreWinner:  &scoreSelector{repo: repo},
+		models.ManualWinner: &manualSelector{},
+		models.FairWinner:   &fairSelector{repo: repo},
+	}
+}
+
+// rankBy fills places one by one, picking every next place among participants that aren't ranked yet.
+func rankBy(participants []models.Participant, places int, pick func([]models.Participant) (uuid.UUID, error)) ([]uuid.UUID, error) {
+	remaining := make([]models.Participant, len(participants))
+	copy(remaining, participants)
+
+	ranking := make([]uuid.UUID, 0, places)
+	for len(ranking) < places && len(remaining) > 0 {
+		userID, err := pick(remaining)
+		if err != nil {
+			return nil, kerror.Errorf(err, "pick %v place", len(ranking)+1)
+		}
+
+		ranking = append(ranking, userID)
+		remaining = withoutParticipant(remaining, userID)
+	}
+
+	if len(ranking) == 0 {
+		return nil, kerror.Newf(kerror.BadRequest, "tournament doesn't have participants")
+	}
+
+	return ranking, nil
+}
+
+func withoutParticipant(participants []models.Participant, userID uuid.UUID) []models.Participant {
+	rest := make([]models.Participant, 0, len(participants))
+	for _, participant := range participants {
+		if participant.UserID != userID {
+			rest = append(rest, participant)
+		}
+	}
+
+	return rest
+}
+
+type randomSelector struct {
+	repo TournamentRepository
+}
+
+func (rs *randomSelector) RankPlayers(ctx context.Context, store tx.DBTX, tournament *models.Tournament, _ *FinishInput) ([]uuid.UUID, error) {
+	participants, err := rs.repo.SelectParticipants(ctx, store, tournament.ID)
+	if err != nil {
+		return nil, kerror.Errorf(err, "get participants")
+	}
+
+	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	return rankBy(participants, tournament.Places(), func(remaining []models.Participant) (uuid.UUID, error) {
+		return remaining[rnd.Intn(len(remaining))].UserID, nil
+	})
+}
+
+type stakeSelector struct {
+	repo TournamentRepository
+}
+
+func (ss *stakeSelector) RankPlayers(ctx context.Context, store tx.DBTX, tournament *models.Tournament, _ *FinishInput) ([]uuid.UUID, error) {
+	participants, err := ss.repo.SelectParticipants(ctx, store, tournament.ID)
+	if err != nil {
+		return nil, kerror.Errorf(err, "get participants")
+	}
+
+	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	return rankBy(withStake(participants), tournament.Places(), func(remaining []models.Participant) (uuid.UUID, error) {
+		winner, ok := pickWeightedByStake(remaining, rnd.Float64())
+		if !ok {
+			return uuid.Nil, kerror.Newf(kerror.BadRequest, "tournament doesn't have participants with stake")
+		}
+
+		return winner, nil
+	})
+}
+
+func withStake(participants []models.Participant) []models.Participant {
+	staked := make([]models.Participant, 0, len(participants))
+	for _, participant := range participants {
+		if participant.Stake.IsPositive() {
+			staked = append(staked, participant)
+		}
+	}
+
+	return staked
+}
+
+// pickWeightedByStake returns the participant on which point falls when stakes are laid out on [0, 1).
+func pickWeightedByStake(participants []models.Participant, point float64) (uuid.UUID, bool) {
+	var total models.Money
+	for _, participant := range participants {
+		total = total.Add(participant.Stake)
+	}
+
+	if !total.IsPositive() {
+		return uuid.Nil, false
+	}
+
+	target := models.Cents(int64(point * float64(total.Cents())))
+	for _, participant := range participants {
+		if !participant.Stake.IsPositive() {
+			continue
+		}
+
+		if target.Less(participant.Stake) {
+			return participant.UserID, true
+		}
+		target = target.Sub(participant.Stake)
+	}
+
+	for i := len(participants) - 1; i >= 0; i-- {
+		if participants[i].Stake.IsPositive() {
+			return participants[i].UserID, true
+		}
+	}
+
+	return uuid.Nil, false
+}
+
+type scoreSelector struct {
+	repo TournamentRepository
+}
+
+func (ss *scoreSelector) RankPlayers(ctx context.Context, store tx.DBTX, tournament *models.Tournament, _ *FinishInput) ([]uuid.UUID, error) {
+	participants, err := ss.repo.SelectParticipants(ctx, store, tournament.ID)
+	if err != nil {
+		return nil, kerror.Errorf(err, "get participants")
+	}
+
+	scored := withScore(participants)
+	if len(scored) == 0 {
+		return nil, kerror.Newf(kerror.BadRequest, "no scores are reported")
+	}
+
+	return rankBy(scored, tournament.Places(), highestScore)
+}
+
+func withScore(participants []models.Participant) []models.Participant {
+	scored := make([]models.Participant, 0, len(participants))
+	for _, participant := range participants {
+		if participant.ScoreReported {
+			scored = append(scored, participant)
+		}
+	}
+
+	return scored
+}
+
+func highestScore(participants []models.Participant) (uuid.UUID, error) {
+	var (
+		best   *models.Participant
+		shared bool
+	)
+
+	for i := range participants {
+		participant := &participants[i]
+		if !participant.ScoreReported {
+			continue
+		}
+
+		switch {
+		case best == nil || participant.Score > best.Score:
+			best, shared = participant, false
+		case participant.Score == best.Score:
+			shared = true
+		}
+	}
+
+	if best == nil {
+		return uuid.Nil, kerror.Newf(kerror.BadRequest, "no scores are reported")
+	}
+
+	if shared {
+		return uuid.Nil, kerror.Newf(kerror.BadRequest, "highest score(%v) is shared by several participants", best.Score)
+	}
+
+	return best.UserID, nil
+}
+
+type manualSelector struct{}
+
+func (ms *manualSelector) RankPlayers(_ context.Context, _ tx.DBTX, tournament *models.Tournament, input *FinishInput) ([]uuid.UUID, error) {
+	if input == nil || len(input.Ranking) == 0 {
+		return nil, kerror.Newf(kerror.BadRequest, "ranking should be set by organizer")
+	}
+
+	if err := validateRanking(tournament, input.Ranking); err != nil {
+		return nil, kerror.Errorf(err, "validate ranking")
+	}
+
+	if len(input.Ranking) > tournament.Places() {
+		return input.Ranking[:tournament.Places()], nil
+	}
+
+	return input.Ranking, nil
+}
+
+func validateRanking(tournament *models.Tournament, ranking []uuid.UUID) error {
+	participants := make(map[uuid.UUID]bool, len(tournament.Users))
+	for _, user := range tournament.Users {
+		participants[user.ID] = true
+	}
+
+	ranked := make(map[uuid.UUID]bool, len(ranking))
+	for _, userID := range ranking {
+		if !participants[userID] {
+			return kerror.Newf(kerror.BadRequest, "user(%v) isn't a participant of tournament", userID)
+		}
+
+		if ranked[userID] {
+			return kerror.Newf(kerror.BadRequest, "user(%v) is ranked twice", userID)
+		}
+		ranked[userID] = true
+	}
+
+	return nil
+}
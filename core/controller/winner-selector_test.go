@@ -0,0 +1,80 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPickWeightedByStake(t *testing.T) {
+	small, large, empty := uuid.New(), uuid.New(), uuid.New()
+	participants := []models.Participant{
+		{UserID: small, Stake: money(100)},
+		{UserID: empty, Stake: money(0)},
+		{UserID: large, Stake: money(300)},
+	}
+
+	tt := []struct {
+		point float64
+		want  uuid.UUID
+	}{
+		{point: 0, want: small},
+		{point: 0.24, want: small},
+		{point: 0.25, want: large},
+		{point: 0.99, want: large},
+	}
+
+	for _, tc := range tt {
+		winner, ok := pickWeightedByStake(participants, tc.point)
+		assert.True(t, ok, "winner should be picked")
+		assert.Equalf(t, tc.want, winner, "point %v should fall on stake of other participant", tc.point)
+	}
+
+	_, ok := pickWeightedByStake([]models.Participant{{UserID: empty}}, 0.5)
+	assert.False(t, ok, "winner can't be picked without stakes")
+}
+
+func TestHighestScore(t *testing.T) {
+	first, second := uuid.New(), uuid.New()
+
+	winner, err := highestScore([]models.Participant{
+		{UserID: first, Score: 10, ScoreReported: true},
+		{UserID: second, Score: 15, ScoreReported: true},
+		{UserID: uuid.New()},
+	})
+	if assert.NoError(t, err, "winner should be selected") {
+		assert.Equal(t, second, winner, "participant with highest score should win")
+	}
+
+	_, err = highestScore([]models.Participant{
+		{UserID: first, Score: 15, ScoreReported: true},
+		{UserID: second, Score: 15, ScoreReported: true},
+	})
+	assert.Error(t, err, "shared highest score should be rejected")
+
+	_, err = highestScore([]models.Participant{{UserID: first}})
+	assert.Error(t, err, "winner can't be selected without scores")
+}
+
+func TestRankBy(t *testing.T) {
+	var participants []models.Participant
+	for i := 0; i < 4; i++ {
+		participants = append(participants, models.Participant{UserID: uuid.New(), Score: float64(i), ScoreReported: true})
+	}
+
+	ranking, err := rankBy(participants, 3, highestScore)
+	if assert.NoError(t, err, "players should be ranked") {
+		assert.Equal(t, []uuid.UUID{participants[3].UserID, participants[2].UserID, participants[1].UserID}, ranking,
+			"only paid places should be ranked by score")
+	}
+
+	ranking, err = rankBy(participants[:2], 3, highestScore)
+	if assert.NoError(t, err, "players should be ranked") {
+		assert.Equal(t, 2, len(ranking), "ranking can't be longer than list of participants")
+	}
+
+	_, err = rankBy(nil, 3, highestScore)
+	assert.Error(t, err, "ranking without participants should be rejected")
+}
@@ -0,0 +1,19 @@
+package controller
+
+import (
+	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/core/models"
+)
+
+func validateWithdrawalPenalty(penalty models.Percent) error {
+	if penalty < 0 || penalty > models.FullPercent {
+		return kerror.Newf(kerror.BadRequest, "withdrawal penalty should be between 0 and %v percent", models.FullPercent)
+	}
+
+	return nil
+}
+
+// withdrawalPenaltyOf returns the part of stake that participant loses by leaving tournament.
+func withdrawalPenaltyOf(tournament *models.Tournament, stake models.Money) models.Money {
+	return stake.Percent(tournament.WithdrawalPenalty)
+}
@@ -0,0 +1,22 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateWithdrawalPenalty(t *testing.T) {
+	assert.NoError(t, validateWithdrawalPenalty(0), "penalty should be optional")
+	assert.NoError(t, validateWithdrawalPenalty(percent(100)), "whole stake can be kept")
+	assert.Error(t, validateWithdrawalPenalty(percent(-1)), "negative penalty")
+	assert.Error(t, validateWithdrawalPenalty(percent(100.01)), "penalty above stake")
+}
+
+func TestWithdrawalPenaltyOf(t *testing.T) {
+	tournament := &models.Tournament{WithdrawalPenalty: percent(10)}
+	assert.Equal(t, money(10), withdrawalPenaltyOf(tournament, money(100)), "penalty should depend on stake")
+	assert.Equal(t, money(33.33), withdrawalPenaltyOf(tournament, money(333.33)), "penalty should be rounded to cents")
+	assert.Equal(t, money(0), withdrawalPenaltyOf(&models.Tournament{}, money(100)), "tournament without penalty should refund whole stake")
+}
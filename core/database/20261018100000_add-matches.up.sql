@@ -0,0 +1,12 @@
+ALTER TYPE TournamentStatus ADD VALUE IF NOT EXISTS 'InProgress' AFTER 'Active';
+
+CREATE TABLE IF NOT EXISTS Matches (
+	id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+	tournamentID uuid REFERENCES Tournaments(id) NOT NULL,
+	round integer NOT NULL CHECK(round > 0),
+	position integer NOT NULL CHECK(position >= 0),
+	firstUser uuid REFERENCES Users(id),
+	secondUser uuid REFERENCES Users(id),
+	winner uuid REFERENCES Users(id),
+	UNIQUE (tournamentID, round, position)
+);
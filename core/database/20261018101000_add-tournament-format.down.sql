@@ -0,0 +1,8 @@
+ALTER TABLE Matches DROP COLUMN IF EXISTS draw;
+
+ALTER TABLE Tournaments
+	DROP COLUMN IF EXISTS tiebreakers,
+	DROP COLUMN IF EXISTS rounds,
+	DROP COLUMN IF EXISTS format;
+
+DROP TYPE IF EXISTS TournamentFormat;
@@ -0,0 +1,8 @@
+CREATE TYPE TournamentFormat AS ENUM ('SingleElimination', 'RoundRobin', 'Swiss');
+
+ALTER TABLE Tournaments
+	ADD COLUMN format TournamentFormat NOT NULL DEFAULT 'SingleElimination',
+	ADD COLUMN rounds integer NOT NULL DEFAULT 0 CHECK(rounds >= 0),
+	ADD COLUMN tiebreakers varchar(200) NOT NULL DEFAULT 'Buchholz,HeadToHead';
+
+ALTER TABLE Matches ADD COLUMN draw boolean NOT NULL DEFAULT false;
@@ -0,0 +1,7 @@
+ALTER TABLE UsersOfTournaments
+	DROP COLUMN IF EXISTS score,
+	DROP COLUMN IF EXISTS stake;
+
+ALTER TABLE Tournaments DROP COLUMN IF EXISTS winnerStrategy;
+
+DROP TYPE IF EXISTS WinnerStrategy;
@@ -0,0 +1,7 @@
+CREATE TYPE WinnerStrategy AS ENUM ('Random', 'Stake', 'Score', 'Manual');
+
+ALTER TABLE Tournaments ADD COLUMN winnerStrategy WinnerStrategy NOT NULL DEFAULT 'Random';
+
+ALTER TABLE UsersOfTournaments
+	ADD COLUMN stake numeric(10, 2) NULL CHECK(stake >= 0.0),
+	ADD COLUMN score numeric(12, 2) NULL;
@@ -0,0 +1,8 @@
+ALTER TABLE UsersOfTournaments
+	DROP COLUMN IF EXISTS joinOrder,
+	DROP COLUMN IF EXISTS clientSeed;
+
+ALTER TABLE Tournaments
+	DROP COLUMN IF EXISTS seedRevealed,
+	DROP COLUMN IF EXISTS serverSeedHash,
+	DROP COLUMN IF EXISTS serverSeed;
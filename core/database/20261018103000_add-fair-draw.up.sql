@@ -0,0 +1,10 @@
+ALTER TYPE WinnerStrategy ADD VALUE IF NOT EXISTS 'ProvablyFair';
+
+ALTER TABLE Tournaments
+	ADD COLUMN serverSeed varchar(64) NULL,
+	ADD COLUMN serverSeedHash varchar(64) NULL,
+	ADD COLUMN seedRevealed boolean NOT NULL DEFAULT false;
+
+ALTER TABLE UsersOfTournaments
+	ADD COLUMN clientSeed varchar(64) NOT NULL DEFAULT '',
+	ADD COLUMN joinOrder bigserial;
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS Placements;
+
+DROP TABLE IF EXISTS PayoutPlaces;
+
+ALTER TABLE Tournaments DROP COLUMN IF EXISTS payoutType;
+
+DROP TYPE IF EXISTS PayoutType;
@@ -0,0 +1,18 @@
+CREATE TYPE PayoutType AS ENUM ('Percentage', 'Fixed');
+
+ALTER TABLE Tournaments ADD COLUMN payoutType PayoutType NOT NULL DEFAULT 'Percentage';
+
+CREATE TABLE IF NOT EXISTS PayoutPlaces (
+	tournamentID uuid REFERENCES Tournaments(id) NOT NULL,
+	place integer NOT NULL CHECK(place > 0),
+	share numeric(12, 2) NOT NULL CHECK(share > 0.0),
+	PRIMARY KEY (tournamentID, place)
+);
+
+CREATE TABLE IF NOT EXISTS Placements (
+	tournamentID uuid REFERENCES Tournaments(id) NOT NULL,
+	place integer NOT NULL CHECK(place > 0),
+	userID uuid REFERENCES Users(id) NOT NULL,
+	prize numeric(12, 2) NOT NULL CHECK(prize >= 0.0),
+	PRIMARY KEY (tournamentID, place)
+);
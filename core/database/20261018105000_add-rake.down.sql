@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS HouseAccount;
+
+ALTER TABLE Tournaments
+	DROP COLUMN IF EXISTS rakeCollected,
+	DROP COLUMN IF EXISTS rake,
+	DROP COLUMN IF EXISTS rakeType;
+
+DROP TYPE IF EXISTS RakeType;
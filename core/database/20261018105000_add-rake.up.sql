@@ -0,0 +1,13 @@
+CREATE TYPE RakeType AS ENUM ('Percentage', 'Fixed');
+
+ALTER TABLE Tournaments
+	ADD COLUMN rakeType RakeType NOT NULL DEFAULT 'Percentage',
+	ADD COLUMN rake numeric(10, 2) NOT NULL DEFAULT 0 CHECK(rake >= 0.0),
+	ADD COLUMN rakeCollected numeric(12, 2) NOT NULL DEFAULT 0 CHECK(rakeCollected >= 0.0);
+
+CREATE TABLE IF NOT EXISTS HouseAccount (
+	id integer PRIMARY KEY DEFAULT 1 CHECK(id = 1),
+	balance numeric(14, 2) NOT NULL DEFAULT 0 CHECK(balance >= 0.0)
+);
+
+INSERT INTO HouseAccount(id) VALUES (1) ON CONFLICT DO NOTHING;
@@ -0,0 +1,5 @@
+UPDATE Tournaments SET status = 'RegistrationOpen' WHERE status IN ('Draft', 'RegistrationClosed');
+
+ALTER TYPE TournamentStatus RENAME VALUE 'Finished' TO 'Finish';
+ALTER TYPE TournamentStatus RENAME VALUE 'Cancelled' TO 'Cancel';
+ALTER TYPE TournamentStatus RENAME VALUE 'RegistrationOpen' TO 'Active';
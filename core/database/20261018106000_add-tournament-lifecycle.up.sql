@@ -0,0 +1,6 @@
+ALTER TYPE TournamentStatus RENAME VALUE 'Active' TO 'RegistrationOpen';
+ALTER TYPE TournamentStatus RENAME VALUE 'Cancel' TO 'Cancelled';
+ALTER TYPE TournamentStatus RENAME VALUE 'Finish' TO 'Finished';
+
+ALTER TYPE TournamentStatus ADD VALUE IF NOT EXISTS 'Draft' BEFORE 'RegistrationOpen';
+ALTER TYPE TournamentStatus ADD VALUE IF NOT EXISTS 'RegistrationClosed' AFTER 'RegistrationOpen';
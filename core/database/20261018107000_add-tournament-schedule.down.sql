@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS tournaments_scheduled_idx;
+
+ALTER TABLE Tournaments
+	DROP COLUMN IF EXISTS finishDeadline,
+	DROP COLUMN IF EXISTS startTime,
+	DROP COLUMN IF EXISTS registrationClosesAt,
+	DROP COLUMN IF EXISTS registrationOpensAt;
@@ -0,0 +1,8 @@
+ALTER TABLE Tournaments
+	ADD COLUMN registrationOpensAt timestamptz NULL,
+	ADD COLUMN registrationClosesAt timestamptz NULL,
+	ADD COLUMN startTime timestamptz NULL,
+	ADD COLUMN finishDeadline timestamptz NULL;
+
+CREATE INDEX IF NOT EXISTS tournaments_scheduled_idx ON Tournaments(status)
+	WHERE status IN ('Draft', 'RegistrationOpen', 'RegistrationClosed', 'InProgress');
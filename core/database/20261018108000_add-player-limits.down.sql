@@ -0,0 +1,4 @@
+ALTER TABLE Tournaments
+	DROP CONSTRAINT IF EXISTS tournaments_player_limits_check,
+	DROP COLUMN IF EXISTS maxPlayers,
+	DROP COLUMN IF EXISTS minPlayers;
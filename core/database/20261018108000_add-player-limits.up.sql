@@ -0,0 +1,4 @@
+ALTER TABLE Tournaments
+	ADD COLUMN minPlayers integer NOT NULL DEFAULT 2 CHECK(minPlayers > 0),
+	ADD COLUMN maxPlayers integer NOT NULL DEFAULT 0 CHECK(maxPlayers >= 0),
+	ADD CONSTRAINT tournaments_player_limits_check CHECK(maxPlayers = 0 OR maxPlayers >= minPlayers);
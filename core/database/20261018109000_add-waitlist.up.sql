@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS Waitlist (
+	tournamentID uuid REFERENCES Tournaments(id) NOT NULL,
+	userID uuid REFERENCES Users(id) NOT NULL,
+	stake numeric(10, 2) NOT NULL CHECK(stake >= 0.0),
+	clientSeed varchar(64) NOT NULL DEFAULT '',
+	waitOrder bigserial,
+	PRIMARY KEY (tournamentID, userID)
+);
@@ -0,0 +1 @@
+ALTER TABLE Tournaments DROP COLUMN IF EXISTS withdrawalPenalty;
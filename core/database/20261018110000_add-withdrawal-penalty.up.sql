@@ -0,0 +1,2 @@
+ALTER TABLE Tournaments
+	ADD COLUMN withdrawalPenalty numeric(5, 2) NOT NULL DEFAULT 0 CHECK(withdrawalPenalty >= 0.0 AND withdrawalPenalty <= 100.0);
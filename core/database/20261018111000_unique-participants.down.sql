@@ -0,0 +1 @@
+ALTER TABLE UsersOfTournaments DROP CONSTRAINT IF EXISTS usersoftournaments_participant_key;
@@ -0,0 +1,44 @@
+CREATE TEMPORARY TABLE DuplicateEntries AS
+	SELECT duplicate.id, duplicate.tournamentID, duplicate.userID,
+		COALESCE(duplicate.stake, Tournaments.deposit) AS stake,
+		CASE Tournaments.rakeType
+			WHEN 'Fixed' THEN Tournaments.rake
+			ELSE ROUND(COALESCE(duplicate.stake, Tournaments.deposit) * Tournaments.rake / 100, 2)
+		END AS rake,
+		Tournaments.status IN ('Draft', 'RegistrationOpen', 'RegistrationClosed') AS refundable
+	FROM UsersOfTournaments AS duplicate
+	INNER JOIN Tournaments ON Tournaments.id = duplicate.tournamentID
+	WHERE EXISTS (
+		SELECT 1 FROM UsersOfTournaments AS first
+		WHERE first.tournamentID = duplicate.tournamentID
+			AND first.userID = duplicate.userID
+			AND first.joinOrder < duplicate.joinOrder
+	);
+
+-- Duplicate entries of tournaments that haven't started yet are refunded like a withdrawal.
+-- Started and settled tournaments only lose the extra rows.
+UPDATE Users
+	SET balance = balance + refunds.stake
+	FROM (
+		SELECT userID, SUM(stake) AS stake FROM DuplicateEntries WHERE refundable GROUP BY userID
+	) AS refunds
+	WHERE Users.id = refunds.userID;
+
+UPDATE Tournaments
+	SET prize = prize - refunds.stake + refunds.rake,
+		rakeCollected = rakeCollected - refunds.rake
+	FROM (
+		SELECT tournamentID, SUM(stake) AS stake, SUM(rake) AS rake FROM DuplicateEntries WHERE refundable GROUP BY tournamentID
+	) AS refunds
+	WHERE Tournaments.id = refunds.tournamentID;
+
+UPDATE HouseAccount
+	SET balance = balance - (SELECT COALESCE(SUM(rake), 0) FROM DuplicateEntries WHERE refundable)
+	WHERE id = 1;
+
+DELETE FROM UsersOfTournaments WHERE id IN (SELECT id FROM DuplicateEntries);
+
+DROP TABLE DuplicateEntries;
+
+ALTER TABLE UsersOfTournaments
+	ADD CONSTRAINT usersoftournaments_participant_key UNIQUE (tournamentID, userID);
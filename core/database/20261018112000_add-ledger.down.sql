@@ -0,0 +1,15 @@
+DROP TRIGGER IF EXISTS users_balance_ledger_check ON Users;
+
+DROP FUNCTION IF EXISTS ledger_check_user_balance();
+
+DROP TABLE IF EXISTS LedgerEntries;
+
+DROP TABLE IF EXISTS LedgerTransactions;
+
+DROP FUNCTION IF EXISTS ledger_check_balanced();
+
+DROP FUNCTION IF EXISTS ledger_append_only();
+
+DROP TYPE IF EXISTS LedgerTransactionType;
+
+DROP TYPE IF EXISTS LedgerAccountType;
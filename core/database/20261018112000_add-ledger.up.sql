@@ -0,0 +1,91 @@
+CREATE TYPE LedgerAccountType AS ENUM ('User', 'Tournament', 'House', 'External');
+
+CREATE TYPE LedgerTransactionType AS ENUM ('Fund', 'Take', 'Join', 'Refund', 'Prize', 'Adjustment');
+
+CREATE TABLE IF NOT EXISTS LedgerTransactions (
+	id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+	type LedgerTransactionType NOT NULL,
+	tournamentID uuid REFERENCES Tournaments(id) NULL,
+	createdAt timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS LedgerEntries (
+	id bigserial PRIMARY KEY,
+	transactionID uuid REFERENCES LedgerTransactions(id) NOT NULL,
+	accountType LedgerAccountType NOT NULL,
+	accountID uuid NULL,
+	amount numeric(14, 2) NOT NULL CHECK(amount <> 0.0),
+	CHECK((accountType IN ('House', 'External')) = (accountID IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS ledgerentries_account_idx ON LedgerEntries(accountType, accountID);
+
+-- Money that existed before the ledger is brought in from outside of the system.
+CREATE TEMPORARY TABLE OpeningBalances AS
+	SELECT gen_random_uuid() AS transactionID, 'User'::LedgerAccountType AS accountType, id AS accountID, balance
+	FROM Users WHERE balance <> 0
+	UNION ALL
+	SELECT gen_random_uuid(), 'House', NULL, balance
+	FROM HouseAccount WHERE balance <> 0
+	UNION ALL
+	SELECT gen_random_uuid(), 'Tournament', id, prize
+	FROM Tournaments WHERE prize <> 0 AND status IN ('Draft', 'RegistrationOpen', 'RegistrationClosed', 'InProgress');
+
+INSERT INTO LedgerTransactions(id, type, tournamentID)
+	SELECT transactionID, 'Adjustment', CASE WHEN accountType = 'Tournament' THEN accountID END
+	FROM OpeningBalances;
+
+INSERT INTO LedgerEntries(transactionID, accountType, accountID, amount)
+	SELECT transactionID, accountType, accountID, balance FROM OpeningBalances
+	UNION ALL
+	SELECT transactionID, 'External', NULL, -balance FROM OpeningBalances;
+
+DROP TABLE OpeningBalances;
+
+CREATE OR REPLACE FUNCTION ledger_append_only() RETURNS trigger AS $$
+BEGIN
+	RAISE EXCEPTION 'ledger can''t be changed, post a correcting transaction instead';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER ledgertransactions_append_only BEFORE UPDATE OR DELETE ON LedgerTransactions
+	FOR EACH ROW EXECUTE FUNCTION ledger_append_only();
+
+CREATE TRIGGER ledgerentries_append_only BEFORE UPDATE OR DELETE ON LedgerEntries
+	FOR EACH ROW EXECUTE FUNCTION ledger_append_only();
+
+CREATE OR REPLACE FUNCTION ledger_check_balanced() RETURNS trigger AS $$
+BEGIN
+	IF (SELECT SUM(amount) FROM LedgerEntries WHERE transactionID = NEW.transactionID) <> 0 THEN
+		RAISE EXCEPTION 'ledger transaction % isn''t balanced', NEW.transactionID;
+	END IF;
+
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER ledgerentries_balanced AFTER INSERT ON LedgerEntries
+	DEFERRABLE INITIALLY DEFERRED
+	FOR EACH ROW EXECUTE FUNCTION ledger_check_balanced();
+
+-- Balance of user is a projection of the ledger, checked when the transaction commits.
+CREATE OR REPLACE FUNCTION ledger_check_user_balance() RETURNS trigger AS $$
+DECLARE
+	projected numeric;
+BEGIN
+	SELECT balance INTO projected FROM Users WHERE id = NEW.id;
+	IF NOT FOUND THEN
+		RETURN NULL;
+	END IF;
+
+	IF projected <> COALESCE((SELECT SUM(amount) FROM LedgerEntries WHERE accountType = 'User' AND accountID = NEW.id), 0) THEN
+		RAISE EXCEPTION 'balance of user % doesn''t match the ledger', NEW.id;
+	END IF;
+
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER users_balance_ledger_check AFTER INSERT OR UPDATE OF balance ON Users
+	DEFERRABLE INITIALLY DEFERRED
+	FOR EACH ROW EXECUTE FUNCTION ledger_check_user_balance();
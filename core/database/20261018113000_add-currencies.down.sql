@@ -0,0 +1,45 @@
+DROP TRIGGER IF EXISTS userbalances_ledger_check ON UserBalances;
+
+DROP INDEX IF EXISTS ledgertransactions_currency_idx;
+
+ALTER TABLE LedgerTransactions DROP COLUMN IF EXISTS currency;
+
+DELETE FROM HouseAccount WHERE currency <> 'USD';
+
+ALTER TABLE HouseAccount DROP COLUMN currency;
+
+ALTER TABLE HouseAccount
+	ADD COLUMN id integer PRIMARY KEY DEFAULT 1 CHECK(id = 1);
+
+ALTER TABLE Users
+	ADD COLUMN balance numeric(10, 2) NOT NULL DEFAULT 0 CHECK(balance >= 0.0);
+
+UPDATE Users
+	SET balance = UserBalances.balance
+	FROM UserBalances
+	WHERE UserBalances.userID = Users.id AND UserBalances.currency = 'USD';
+
+DROP TABLE IF EXISTS UserBalances;
+
+CREATE OR REPLACE FUNCTION ledger_check_user_balance() RETURNS trigger AS $$
+DECLARE
+	projected numeric;
+BEGIN
+	SELECT balance INTO projected FROM Users WHERE id = NEW.id;
+	IF NOT FOUND THEN
+		RETURN NULL;
+	END IF;
+
+	IF projected <> COALESCE((SELECT SUM(amount) FROM LedgerEntries WHERE accountType = 'User' AND accountID = NEW.id), 0) THEN
+		RAISE EXCEPTION 'balance of user % doesn''t match the ledger', NEW.id;
+	END IF;
+
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER users_balance_ledger_check AFTER INSERT OR UPDATE OF balance ON Users
+	DEFERRABLE INITIALLY DEFERRED
+	FOR EACH ROW EXECUTE FUNCTION ledger_check_user_balance();
+
+ALTER TABLE Tournaments DROP COLUMN IF EXISTS currency;
@@ -0,0 +1,59 @@
+-- Money that existed before currencies came in is in US dollars.
+ALTER TABLE Tournaments
+	ADD COLUMN currency varchar(8) NOT NULL DEFAULT 'USD';
+
+CREATE TABLE IF NOT EXISTS UserBalances (
+	userID uuid REFERENCES Users(id) ON DELETE CASCADE NOT NULL,
+	currency varchar(8) NOT NULL,
+	balance numeric(10, 2) NOT NULL DEFAULT 0 CHECK(balance >= 0.0),
+	PRIMARY KEY (userID, currency)
+);
+
+INSERT INTO UserBalances(userID, currency, balance)
+	SELECT id, 'USD', balance FROM Users WHERE balance <> 0;
+
+DROP TRIGGER IF EXISTS users_balance_ledger_check ON Users;
+
+ALTER TABLE Users DROP COLUMN balance;
+
+ALTER TABLE HouseAccount DROP COLUMN id;
+
+ALTER TABLE HouseAccount
+	ADD COLUMN currency varchar(8) NOT NULL DEFAULT 'USD',
+	ADD PRIMARY KEY (currency);
+
+ALTER TABLE HouseAccount ALTER COLUMN currency DROP DEFAULT;
+
+-- Every ledger transaction moves money of a single currency.
+-- Exchange between currencies would be a pair of transactions and isn't done by the service.
+ALTER TABLE LedgerTransactions
+	ADD COLUMN currency varchar(8) NOT NULL DEFAULT 'USD';
+
+ALTER TABLE LedgerTransactions ALTER COLUMN currency DROP DEFAULT;
+
+CREATE INDEX IF NOT EXISTS ledgertransactions_currency_idx ON LedgerTransactions(currency);
+
+CREATE OR REPLACE FUNCTION ledger_check_user_balance() RETURNS trigger AS $$
+DECLARE
+	projected numeric;
+BEGIN
+	SELECT balance INTO projected FROM UserBalances WHERE userID = NEW.userID AND currency = NEW.currency;
+	IF NOT FOUND THEN
+		RETURN NULL;
+	END IF;
+
+	IF projected <> COALESCE((
+		SELECT SUM(LedgerEntries.amount)
+		FROM LedgerEntries INNER JOIN LedgerTransactions ON LedgerTransactions.id = LedgerEntries.transactionID
+		WHERE LedgerEntries.accountType = 'User' AND LedgerEntries.accountID = NEW.userID AND LedgerTransactions.currency = NEW.currency
+	), 0) THEN
+		RAISE EXCEPTION 'balance of user % in % doesn''t match the ledger', NEW.userID, NEW.currency;
+	END IF;
+
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER userbalances_ledger_check AFTER INSERT OR UPDATE OF balance ON UserBalances
+	DEFERRABLE INITIALLY DEFERRED
+	FOR EACH ROW EXECUTE FUNCTION ledger_check_user_balance();
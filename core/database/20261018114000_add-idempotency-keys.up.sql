@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS IdempotencyKeys (
+	userID uuid REFERENCES Users(id) ON DELETE CASCADE NOT NULL,
+	operation varchar(32) NOT NULL,
+	idempotencyKey varchar(255) NOT NULL,
+	requestHash char(64) NOT NULL,
+	response bytea NULL,
+	createdAt timestamptz NOT NULL DEFAULT now(),
+	PRIMARY KEY (userID, operation, idempotencyKey)
+);
@@ -0,0 +1,10 @@
+-- Stakes that are still held are forgotten, participants who own them stay in tournaments uncharged.
+DROP TRIGGER IF EXISTS holds_apply ON Holds;
+
+DROP FUNCTION IF EXISTS holds_apply();
+
+ALTER TABLE UserBalances
+	DROP CONSTRAINT IF EXISTS userbalances_held_check,
+	DROP COLUMN IF EXISTS held;
+
+DROP TABLE IF EXISTS Holds;
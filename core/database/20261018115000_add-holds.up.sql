@@ -0,0 +1,36 @@
+-- Stake of participant is held on the balance until tournament starts.
+-- Held money is still on the balance, but it can't be spent.
+CREATE TABLE IF NOT EXISTS Holds (
+	tournamentID uuid REFERENCES Tournaments(id) NOT NULL,
+	userID uuid REFERENCES Users(id) NOT NULL,
+	currency varchar(8) NOT NULL,
+	amount numeric(10, 2) NOT NULL CHECK(amount > 0.0),
+	createdAt timestamptz NOT NULL DEFAULT now(),
+	PRIMARY KEY (tournamentID, userID)
+);
+
+CREATE INDEX IF NOT EXISTS holds_user_idx ON Holds(userID, currency);
+
+ALTER TABLE UserBalances
+	ADD COLUMN held numeric(10, 2) NOT NULL DEFAULT 0,
+	ADD CONSTRAINT userbalances_held_check CHECK(held >= 0.0 AND held <= balance);
+
+-- Held part of balance is a projection of holds. Balance without enough money for the hold breaks the check of held.
+CREATE OR REPLACE FUNCTION holds_apply() RETURNS trigger AS $$
+BEGIN
+	IF TG_OP = 'DELETE' THEN
+		UPDATE UserBalances SET held = held - OLD.amount WHERE userID = OLD.userID AND currency = OLD.currency;
+		RETURN OLD;
+	END IF;
+
+	UPDATE UserBalances SET held = held + NEW.amount WHERE userID = NEW.userID AND currency = NEW.currency;
+	IF NOT FOUND THEN
+		RAISE EXCEPTION 'user % has no balance in %', NEW.userID, NEW.currency USING ERRCODE = 'check_violation';
+	END IF;
+
+	RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER holds_apply AFTER INSERT OR DELETE ON Holds
+	FOR EACH ROW EXECUTE FUNCTION holds_apply();
@@ -0,0 +1,11 @@
+ALTER TABLE Waitlist DROP COLUMN IF EXISTS joinedAt;
+
+ALTER TABLE UsersOfTournaments DROP COLUMN IF EXISTS joinedAt;
+
+ALTER TABLE Users DROP COLUMN IF EXISTS excludedUntil;
+
+DROP TABLE IF EXISTS UserLimits;
+
+DROP TYPE IF EXISTS LimitPeriod;
+
+DROP TYPE IF EXISTS LimitKind;
@@ -0,0 +1,31 @@
+CREATE TYPE LimitKind AS ENUM ('Deposit', 'Entry');
+
+CREATE TYPE LimitPeriod AS ENUM ('Day', 'Week', 'Month');
+
+-- Self-limits of players. Zero amount is no limit.
+-- Looser limit waits in pendingAmount until pendingFrom, when the cooling-off period ends.
+CREATE TABLE IF NOT EXISTS UserLimits (
+	userID uuid REFERENCES Users(id) ON DELETE CASCADE NOT NULL,
+	kind LimitKind NOT NULL,
+	period LimitPeriod NOT NULL,
+	currency varchar(8) NOT NULL,
+	amount numeric(10, 2) NOT NULL CHECK(amount >= 0.0),
+	pendingAmount numeric(10, 2) NULL CHECK(pendingAmount >= 0.0),
+	pendingFrom timestamptz NULL,
+	CHECK((pendingAmount IS NULL) = (pendingFrom IS NULL)),
+	PRIMARY KEY (userID, kind, period, currency)
+);
+
+ALTER TABLE Users
+	ADD COLUMN excludedUntil timestamptz NULL;
+
+-- Time of entries made before limits is unknown, so they aren't counted against limits.
+ALTER TABLE UsersOfTournaments
+	ADD COLUMN joinedAt timestamptz NULL;
+
+ALTER TABLE UsersOfTournaments ALTER COLUMN joinedAt SET DEFAULT now();
+
+ALTER TABLE Waitlist
+	ADD COLUMN joinedAt timestamptz NULL;
+
+ALTER TABLE Waitlist ALTER COLUMN joinedAt SET DEFAULT now();
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS PasswordResets;
+
+ALTER TABLE Users DROP COLUMN IF EXISTS email;
@@ -0,0 +1,12 @@
+-- Address password reset tokens are sent to. Users without it can't reset password by email.
+ALTER TABLE Users
+	ADD COLUMN email varchar(255) NULL;
+
+-- Tokens of password reset are kept hashed, so tokens can't be taken from the database.
+-- Token is deleted once it's used.
+CREATE TABLE IF NOT EXISTS PasswordResets (
+	tokenHash char(64) PRIMARY KEY,
+	userID uuid REFERENCES Users(id) ON DELETE CASCADE NOT NULL,
+	expiresAt timestamptz NOT NULL,
+	createdAt timestamptz NOT NULL DEFAULT now()
+);
@@ -0,0 +1,3 @@
+ALTER TABLE Users DROP COLUMN IF EXISTS role;
+
+DROP TYPE IF EXISTS UserRole;
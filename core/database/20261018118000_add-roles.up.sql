@@ -0,0 +1,5 @@
+CREATE TYPE UserRole AS ENUM ('Admin', 'Organizer', 'Player');
+
+-- Everybody is a player, until admin gives another role.
+ALTER TABLE Users
+	ADD COLUMN role UserRole NOT NULL DEFAULT 'Player';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS RefreshTokens;
+
+DROP TABLE IF EXISTS Sessions;
@@ -0,0 +1,16 @@
+-- Sessions are opened on log in and extended with refresh tokens until they expire or are revoked.
+-- Sessions of deleted user are deleted with the user, so tokens of the user stop working.
+CREATE TABLE IF NOT EXISTS Sessions (
+	id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+	userID uuid REFERENCES Users(id) ON DELETE CASCADE NOT NULL,
+	createdAt timestamptz NOT NULL DEFAULT now(),
+	expiresAt timestamptz NOT NULL,
+	revokedAt timestamptz NULL
+);
+
+-- Refresh tokens are kept hashed and used once. Token used again revokes its session.
+CREATE TABLE IF NOT EXISTS RefreshTokens (
+	tokenHash char(64) PRIMARY KEY,
+	sessionID uuid REFERENCES Sessions(id) ON DELETE CASCADE NOT NULL,
+	usedAt timestamptz NULL
+);
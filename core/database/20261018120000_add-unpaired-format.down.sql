@@ -0,0 +1 @@
+UPDATE Tournaments SET format = 'SingleElimination' WHERE format = 'Unpaired';
@@ -0,0 +1 @@
+ALTER TYPE TournamentFormat ADD VALUE IF NOT EXISTS 'Unpaired';
@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -21,20 +22,152 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Money is an exact amount of money: units are whole units and nanos are billionths of unit of the same sign.
+// Double fields with money are kept for clients that don't read Money yet.
+type Money struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Units int64 `protobuf:"varint,1,opt,name=units,proto3" json:"units,omitempty"`
+	Nanos int32 `protobuf:"varint,2,opt,name=nanos,proto3" json:"nanos,omitempty"`
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Money) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *Money) GetNanos() int32 {
+	if x != nil {
+		return x.Nanos
+	}
+	return 0
+}
+
+// Balance is the money of user in one currency.
+// Amount is the total money, available is its part not held for joined tournaments.
+type Balance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Currency  string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount    *Money `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Available *Money `protobuf:"bytes,3,opt,name=available,proto3" json:"available,omitempty"`
+}
+
+func (x *Balance) Reset() {
+	*x = Balance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Balance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Balance) ProtoMessage() {}
+
+func (x *Balance) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Balance.ProtoReflect.Descriptor instead.
+func (*Balance) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Balance) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Balance) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Balance) GetAvailable() *Money {
+	if x != nil {
+		return x.Available
+	}
+	return nil
+}
+
+// Balance of User is the one in the default currency, Balances hold all currencies.
+// AvailableBalanceMoney is the part of Balance not held for joined tournaments.
+// Password is the plain password chosen by user, it's only sent to SaveUser and never returned.
+// Email is where tokens of password reset are sent. Role is only returned, it's changed by SetUserRole.
 type User struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID      string  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Name    string  `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	Balance float64 `protobuf:"fixed64,3,opt,name=Balance,proto3" json:"Balance,omitempty"`
+	ID                    string     `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name                  string     `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Balance               float64    `protobuf:"fixed64,3,opt,name=Balance,proto3" json:"Balance,omitempty"`
+	BalanceMoney          *Money     `protobuf:"bytes,4,opt,name=BalanceMoney,proto3" json:"BalanceMoney,omitempty"`
+	Balances              []*Balance `protobuf:"bytes,5,rep,name=Balances,proto3" json:"Balances,omitempty"`
+	AvailableBalanceMoney *Money     `protobuf:"bytes,6,opt,name=AvailableBalanceMoney,proto3" json:"AvailableBalanceMoney,omitempty"`
+	Password              string     `protobuf:"bytes,7,opt,name=Password,proto3" json:"Password,omitempty"`
+	Email                 string     `protobuf:"bytes,8,opt,name=Email,proto3" json:"Email,omitempty"`
+	Role                  string     `protobuf:"bytes,9,opt,name=Role,proto3" json:"Role,omitempty"`
 }
 
 func (x *User) Reset() {
 	*x = User{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tournament_proto_msgTypes[0]
+		mi := &file_tournament_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -47,7 +180,7 @@ func (x *User) String() string {
 func (*User) ProtoMessage() {}
 
 func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_tournament_proto_msgTypes[0]
+	mi := &file_tournament_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -60,7 +193,7 @@ func (x *User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use User.ProtoReflect.Descriptor instead.
 func (*User) Descriptor() ([]byte, []int) {
-	return file_tournament_proto_rawDescGZIP(), []int{0}
+	return file_tournament_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *User) GetID() string {
@@ -84,6 +217,48 @@ func (x *User) GetBalance() float64 {
 	return 0
 }
 
+func (x *User) GetBalanceMoney() *Money {
+	if x != nil {
+		return x.BalanceMoney
+	}
+	return nil
+}
+
+func (x *User) GetBalances() []*Balance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+func (x *User) GetAvailableBalanceMoney() *Money {
+	if x != nil {
+		return x.AvailableBalanceMoney
+	}
+	return nil
+}
+
+func (x *User) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
 type SaveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -96,7 +271,7 @@ type SaveResponse struct {
 func (x *SaveResponse) Reset() {
 	*x = SaveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tournament_proto_msgTypes[1]
+		mi := &file_tournament_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -109,7 +284,7 @@ func (x *SaveResponse) String() string {
 func (*SaveResponse) ProtoMessage() {}
 
 func (x *SaveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tournament_proto_msgTypes[1]
+	mi := &file_tournament_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -122,7 +297,7 @@ func (x *SaveResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveResponse.ProtoReflect.Descriptor instead.
 func (*SaveResponse) Descriptor() ([]byte, []int) {
-	return file_tournament_proto_rawDescGZIP(), []int{1}
+	return file_tournament_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SaveResponse) GetId() string {
@@ -150,7 +325,7 @@ type UserRequest struct {
 func (x *UserRequest) Reset() {
 	*x = UserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tournament_proto_msgTypes[2]
+		mi := &file_tournament_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -163,7 +338,7 @@ func (x *UserRequest) String() string {
 func (*UserRequest) ProtoMessage() {}
 
 func (x *UserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tournament_proto_msgTypes[2]
+	mi := &file_tournament_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -176,7 +351,7 @@ func (x *UserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserRequest.ProtoReflect.Descriptor instead.
 func (*UserRequest) Descriptor() ([]byte, []int) {
-	return file_tournament_proto_rawDescGZIP(), []int{2}
+	return file_tournament_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *UserRequest) GetID() string {
@@ -191,14 +366,16 @@ type RequestToUpdateBalance struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID     string  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Addend float64 `protobuf:"fixed64,2,opt,name=addend,proto3" json:"addend,omitempty"`
+	ID          string  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Addend      float64 `protobuf:"fixed64,2,opt,name=addend,proto3" json:"addend,omitempty"`
+	AddendMoney *Money  `protobuf:"bytes,3,opt,name=addendMoney,proto3" json:"addendMoney,omitempty"`
+	Currency    string  `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
 }
 
 func (x *RequestToUpdateBalance) Reset() {
 	*x = RequestToUpdateBalance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tournament_proto_msgTypes[3]
+		mi := &file_tournament_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -211,7 +388,7 @@ func (x *RequestToUpdateBalance) String() string {
 func (*RequestToUpdateBalance) ProtoMessage() {}
 
 func (x *RequestToUpdateBalance) ProtoReflect() protoreflect.Message {
-	mi := &file_tournament_proto_msgTypes[3]
+	mi := &file_tournament_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -224,7 +401,7 @@ func (x *RequestToUpdateBalance) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestToUpdateBalance.ProtoReflect.Descriptor instead.
 func (*RequestToUpdateBalance) Descriptor() ([]byte, []int) {
-	return file_tournament_proto_rawDescGZIP(), []int{3}
+	return file_tournament_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *RequestToUpdateBalance) GetID() string {
@@ -241,6 +418,20 @@ func (x *RequestToUpdateBalance) GetAddend() float64 {
 	return 0
 }
 
+func (x *RequestToUpdateBalance) GetAddendMoney() *Money {
+	if x != nil {
+		return x.AddendMoney
+	}
+	return nil
+}
+
+func (x *RequestToUpdateBalance) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type AuthorizationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -253,7 +444,7 @@ type AuthorizationRequest struct {
 func (x *AuthorizationRequest) Reset() {
 	*x = AuthorizationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tournament_proto_msgTypes[4]
+		mi := &file_tournament_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -266,7 +457,7 @@ func (x *AuthorizationRequest) String() string {
 func (*AuthorizationRequest) ProtoMessage() {}
 
 func (x *AuthorizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tournament_proto_msgTypes[4]
+	mi := &file_tournament_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -279,7 +470,7 @@ func (x *AuthorizationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthorizationRequest.ProtoReflect.Descriptor instead.
 func (*AuthorizationRequest) Descriptor() ([]byte, []int) {
-	return file_tournament_proto_rawDescGZIP(), []int{4}
+	return file_tournament_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *AuthorizationRequest) GetUsername() string {
@@ -296,18 +487,24 @@ func (x *AuthorizationRequest) GetPassword() string {
 	return ""
 }
 
+// AuthorizationResponse carries the session tokens are issued within. Refresh token is empty,
+// when the response is to Authenticate.
 type AuthorizationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Role             string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	SessionID        string                 `protobuf:"bytes,3,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	RefreshToken     string                 `protobuf:"bytes,4,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	SessionExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=sessionExpiresAt,proto3" json:"sessionExpiresAt,omitempty"`
 }
 
 func (x *AuthorizationResponse) Reset() {
 	*x = AuthorizationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tournament_proto_msgTypes[5]
+		mi := &file_tournament_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -320,7 +517,7 @@ func (x *AuthorizationResponse) String() string {
 func (*AuthorizationResponse) ProtoMessage() {}
 
 func (x *AuthorizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tournament_proto_msgTypes[5]
+	mi := &file_tournament_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -333,7 +530,7 @@ func (x *AuthorizationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthorizationResponse.ProtoReflect.Descriptor instead.
 func (*AuthorizationResponse) Descriptor() ([]byte, []int) {
-	return file_tournament_proto_rawDescGZIP(), []int{5}
+	return file_tournament_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *AuthorizationResponse) GetId() string {
@@ -343,32 +540,59 @@ func (x *AuthorizationResponse) GetId() string {
 	return ""
 }
 
-type CreateTournamentRequest struct {
+func (x *AuthorizationResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AuthorizationResponse) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *AuthorizationResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *AuthorizationResponse) GetSessionExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SessionExpiresAt
+	}
+	return nil
+}
+
+type RefreshRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Deposit float64 `protobuf:"fixed64,2,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	RefreshToken string `protobuf:"bytes,1,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
 }
 
-func (x *CreateTournamentRequest) Reset() {
-	*x = CreateTournamentRequest{}
+func (x *RefreshRequest) Reset() {
+	*x = RefreshRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tournament_proto_msgTypes[6]
+		mi := &file_tournament_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateTournamentRequest) String() string {
+func (x *RefreshRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTournamentRequest) ProtoMessage() {}
+func (*RefreshRequest) ProtoMessage() {}
 
-func (x *CreateTournamentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tournament_proto_msgTypes[6]
+func (x *RefreshRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -379,50 +603,46 @@ func (x *CreateTournamentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTournamentRequest.ProtoReflect.Descriptor instead.
-func (*CreateTournamentRequest) Descriptor() ([]byte, []int) {
-	return file_tournament_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use RefreshRequest.ProtoReflect.Descriptor instead.
+func (*RefreshRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CreateTournamentRequest) GetName() string {
+func (x *RefreshRequest) GetRefreshToken() string {
 	if x != nil {
-		return x.Name
+		return x.RefreshToken
 	}
 	return ""
 }
 
-func (x *CreateTournamentRequest) GetDeposit() float64 {
-	if x != nil {
-		return x.Deposit
-	}
-	return 0
-}
-
-type CreateTournamentResponse struct {
+// ChangePasswordRequest carries plain passwords, unlike AuthorizationRequest with SHA-256 digest of password.
+type ChangePasswordRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserID      string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	OldPassword string `protobuf:"bytes,2,opt,name=oldPassword,proto3" json:"oldPassword,omitempty"`
+	NewPassword string `protobuf:"bytes,3,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
 }
 
-func (x *CreateTournamentResponse) Reset() {
-	*x = CreateTournamentResponse{}
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tournament_proto_msgTypes[7]
+		mi := &file_tournament_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateTournamentResponse) String() string {
+func (x *ChangePasswordRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTournamentResponse) ProtoMessage() {}
+func (*ChangePasswordRequest) ProtoMessage() {}
 
-func (x *CreateTournamentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tournament_proto_msgTypes[7]
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -433,43 +653,58 @@ func (x *CreateTournamentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTournamentResponse.ProtoReflect.Descriptor instead.
-func (*CreateTournamentResponse) Descriptor() ([]byte, []int) {
-	return file_tournament_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *CreateTournamentResponse) GetId() string {
+func (x *ChangePasswordRequest) GetUserID() string {
 	if x != nil {
-		return x.Id
+		return x.UserID
 	}
 	return ""
 }
 
-type TournamentRequest struct {
+func (x *ChangePasswordRequest) GetOldPassword() string {
+	if x != nil {
+		return x.OldPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type SetRoleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Role   string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
 }
 
-func (x *TournamentRequest) Reset() {
-	*x = TournamentRequest{}
+func (x *SetRoleRequest) Reset() {
+	*x = SetRoleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tournament_proto_msgTypes[8]
+		mi := &file_tournament_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TournamentRequest) String() string {
+func (x *SetRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TournamentRequest) ProtoMessage() {}
+func (*SetRoleRequest) ProtoMessage() {}
 
-func (x *TournamentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tournament_proto_msgTypes[8]
+func (x *SetRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -480,49 +715,50 @@ func (x *TournamentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TournamentRequest.ProtoReflect.Descriptor instead.
-func (*TournamentRequest) Descriptor() ([]byte, []int) {
-	return file_tournament_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use SetRoleRequest.ProtoReflect.Descriptor instead.
+func (*SetRoleRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *TournamentRequest) GetId() string {
+func (x *SetRoleRequest) GetUserID() string {
 	if x != nil {
-		return x.Id
+		return x.UserID
 	}
 	return ""
 }
 
-type Tournament struct {
-	state         protoimpl.MessageState
+func (x *SetRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type PasswordResetRequest struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name    string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Deposit float64  `protobuf:"fixed64,3,opt,name=deposit,proto3" json:"deposit,omitempty"`
-	Prize   float64  `protobuf:"fixed64,4,opt,name=prize,proto3" json:"prize,omitempty"`
-	Users   []string `protobuf:"bytes,5,rep,name=users,proto3" json:"users,omitempty"`
-	Winner  string   `protobuf:"bytes,6,opt,name=winner,proto3" json:"winner,omitempty"`
-	Status  string   `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 }
 
-func (x *Tournament) Reset() {
-	*x = Tournament{}
+func (x *PasswordResetRequest) Reset() {
+	*x = PasswordResetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tournament_proto_msgTypes[9]
+		mi := &file_tournament_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Tournament) String() string {
+func (x *PasswordResetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Tournament) ProtoMessage() {}
+func (*PasswordResetRequest) ProtoMessage() {}
 
-func (x *Tournament) ProtoReflect() protoreflect.Message {
-	mi := &file_tournament_proto_msgTypes[9]
+func (x *PasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -533,86 +769,202 @@ func (x *Tournament) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Tournament.ProtoReflect.Descriptor instead.
-func (*Tournament) Descriptor() ([]byte, []int) {
-	return file_tournament_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use PasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*PasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Tournament) GetId() string {
+func (x *PasswordResetRequest) GetUsername() string {
 	if x != nil {
-		return x.Id
+		return x.Username
 	}
 	return ""
 }
 
-func (x *Tournament) GetName() string {
+// ResetPasswordRequest carries plain new password and the token sent to user.
+type ResetPasswordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token       string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	NewPassword string `protobuf:"bytes,2,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ResetPasswordRequest) GetToken() string {
 	if x != nil {
-		return x.Name
+		return x.Token
 	}
 	return ""
 }
 
-func (x *Tournament) GetDeposit() float64 {
+func (x *ResetPasswordRequest) GetNewPassword() string {
 	if x != nil {
-		return x.Deposit
+		return x.NewPassword
 	}
-	return 0
+	return ""
 }
 
-func (x *Tournament) GetPrize() float64 {
+type UserTransactionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Types    []string               `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
+	From     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Limit    int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset   int32                  `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	Currency string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+}
+
+func (x *UserTransactionsRequest) Reset() {
+	*x = UserTransactionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserTransactionsRequest) ProtoMessage() {}
+
+func (x *UserTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*UserTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UserTransactionsRequest) GetUserID() string {
 	if x != nil {
-		return x.Prize
+		return x.UserID
 	}
-	return 0
+	return ""
 }
 
-func (x *Tournament) GetUsers() []string {
+func (x *UserTransactionsRequest) GetTypes() []string {
 	if x != nil {
-		return x.Users
+		return x.Types
 	}
 	return nil
 }
 
-func (x *Tournament) GetWinner() string {
+func (x *UserTransactionsRequest) GetFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Winner
+		return x.From
 	}
-	return ""
+	return nil
 }
 
-func (x *Tournament) GetStatus() string {
+func (x *UserTransactionsRequest) GetTo() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Status
+		return x.To
+	}
+	return nil
+}
+
+func (x *UserTransactionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *UserTransactionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *UserTransactionsRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
 	}
 	return ""
 }
 
-type JoinRequest struct {
+type UserTransaction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TournamentID string `protobuf:"bytes,1,opt,name=tournamentID,proto3" json:"tournamentID,omitempty"`
-	UserID       string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type              string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	TournamentID      string                 `protobuf:"bytes,3,opt,name=tournamentID,proto3" json:"tournamentID,omitempty"`
+	Amount            float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	BalanceAfter      float64                `protobuf:"fixed64,5,opt,name=balanceAfter,proto3" json:"balanceAfter,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	AmountMoney       *Money                 `protobuf:"bytes,7,opt,name=amountMoney,proto3" json:"amountMoney,omitempty"`
+	BalanceAfterMoney *Money                 `protobuf:"bytes,8,opt,name=balanceAfterMoney,proto3" json:"balanceAfterMoney,omitempty"`
+	Currency          string                 `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`
 }
 
-func (x *JoinRequest) Reset() {
-	*x = JoinRequest{}
+func (x *UserTransaction) Reset() {
+	*x = UserTransaction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tournament_proto_msgTypes[10]
+		mi := &file_tournament_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JoinRequest) String() string {
+func (x *UserTransaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinRequest) ProtoMessage() {}
+func (*UserTransaction) ProtoMessage() {}
 
-func (x *JoinRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tournament_proto_msgTypes[10]
+func (x *UserTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -623,239 +975,3135 @@ func (x *JoinRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinRequest.ProtoReflect.Descriptor instead.
-func (*JoinRequest) Descriptor() ([]byte, []int) {
-	return file_tournament_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use UserTransaction.ProtoReflect.Descriptor instead.
+func (*UserTransaction) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *JoinRequest) GetTournamentID() string {
+func (x *UserTransaction) GetId() string {
 	if x != nil {
-		return x.TournamentID
+		return x.Id
 	}
 	return ""
 }
 
-func (x *JoinRequest) GetUserID() string {
+func (x *UserTransaction) GetType() string {
 	if x != nil {
-		return x.UserID
+		return x.Type
 	}
 	return ""
 }
 
-var File_tournament_proto protoreflect.FileDescriptor
+func (x *UserTransaction) GetTournamentID() string {
+	if x != nil {
+		return x.TournamentID
+	}
+	return ""
+}
 
-var file_tournament_proto_rawDesc = []byte{
-	0x0a, 0x10, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x07, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x1a, 0x1b, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70,
-	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x44, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72,
-	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44,
-	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x3a,
-	0x0a, 0x0c, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x1d, 0x0a, 0x0b, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x40, 0x0a, 0x16, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x54, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x22, 0x4e, 0x0a, 0x14, 0x41,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x27, 0x0a, 0x15, 0x41,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x47, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f,
-	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0x2a, 0x0a,
-	0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x23, 0x0a, 0x11, 0x54, 0x6f, 0x75,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xa6,
-	0x01, 0x0a, 0x0a, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70,
-	0x72, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x7a,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65,
-	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x49, 0x0a, 0x0b, 0x4a, 0x6f, 0x69, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f,
-	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x32, 0xd9, 0x05, 0x0a, 0x11, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
-	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x53, 0x61, 0x76, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x0d, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x1a, 0x15, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x61,
-	0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0b,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x49, 0x44, 0x12, 0x14, 0x2e, 0x68, 0x61,
-	0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x0d, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x22, 0x00, 0x12, 0x40, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x42, 0x79, 0x49, 0x44, 0x12, 0x14, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0c, 0x53, 0x75, 0x6d, 0x54, 0x6f, 0x42, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
-	0x54, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x41,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x41, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
-	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x2e, 0x68, 0x61, 0x6e, 0x64,
-	0x6c, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x68, 0x61,
-	0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x46, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
-	0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x1a, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e,
-	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x13, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x75, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0e, 0x4a, 0x6f, 0x69, 0x6e,
-	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x2e, 0x68, 0x61, 0x6e,
-	0x64, 0x6c, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x10, 0x46, 0x69,
-	0x6e, 0x69, 0x73, 0x68, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a,
-	0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x6f,
-	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c,
-	0x65, 0x72, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x0f,
-	0x5a, 0x0d, 0x2f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *UserTransaction) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
 }
 
-var (
-	file_tournament_proto_rawDescOnce sync.Once
-	file_tournament_proto_rawDescData = file_tournament_proto_rawDesc
-)
+func (x *UserTransaction) GetBalanceAfter() float64 {
+	if x != nil {
+		return x.BalanceAfter
+	}
+	return 0
+}
 
-func file_tournament_proto_rawDescGZIP() []byte {
-	file_tournament_proto_rawDescOnce.Do(func() {
-		file_tournament_proto_rawDescData = protoimpl.X.CompressGZIP(file_tournament_proto_rawDescData)
-	})
-	return file_tournament_proto_rawDescData
+func (x *UserTransaction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-var file_tournament_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
-var file_tournament_proto_goTypes = []interface{}{
-	(*User)(nil),                     // 0: handler.User
-	(*SaveResponse)(nil),             // 1: handler.SaveResponse
-	(*UserRequest)(nil),              // 2: handler.UserRequest
-	(*RequestToUpdateBalance)(nil),   // 3: handler.RequestToUpdateBalance
-	(*AuthorizationRequest)(nil),     // 4: handler.AuthorizationRequest
-	(*AuthorizationResponse)(nil),    // 5: handler.AuthorizationResponse
-	(*CreateTournamentRequest)(nil),  // 6: handler.CreateTournamentRequest
-	(*CreateTournamentResponse)(nil), // 7: handler.CreateTournamentResponse
-	(*TournamentRequest)(nil),        // 8: handler.TournamentRequest
-	(*Tournament)(nil),               // 9: handler.Tournament
-	(*JoinRequest)(nil),              // 10: handler.JoinRequest
-	(*emptypb.Empty)(nil),            // 11: google.protobuf.Empty
+func (x *UserTransaction) GetAmountMoney() *Money {
+	if x != nil {
+		return x.AmountMoney
+	}
+	return nil
 }
-var file_tournament_proto_depIdxs = []int32{
-	0,  // 0: handler.TournamentService.SaveUser:input_type -> handler.User
-	2,  // 1: handler.TournamentService.GetUserByID:input_type -> handler.UserRequest
-	2,  // 2: handler.TournamentService.DeleteUserByID:input_type -> handler.UserRequest
-	3,  // 3: handler.TournamentService.SumToBalance:input_type -> handler.RequestToUpdateBalance
-	4,  // 4: handler.TournamentService.UserAuthorization:input_type -> handler.AuthorizationRequest
-	6,  // 5: handler.TournamentService.CreateTournament:input_type -> handler.CreateTournamentRequest
-	8,  // 6: handler.TournamentService.GetTournamentByID:input_type -> handler.TournamentRequest
-	10, // 7: handler.TournamentService.JoinTournament:input_type -> handler.JoinRequest
-	8,  // 8: handler.TournamentService.FinishTournament:input_type -> handler.TournamentRequest
-	8,  // 9: handler.TournamentService.CancelTournament:input_type -> handler.TournamentRequest
-	1,  // 10: handler.TournamentService.SaveUser:output_type -> handler.SaveResponse
-	0,  // 11: handler.TournamentService.GetUserByID:output_type -> handler.User
-	11, // 12: handler.TournamentService.DeleteUserByID:output_type -> google.protobuf.Empty
-	11, // 13: handler.TournamentService.SumToBalance:output_type -> google.protobuf.Empty
-	5,  // 14: handler.TournamentService.UserAuthorization:output_type -> handler.AuthorizationResponse
-	7,  // 15: handler.TournamentService.CreateTournament:output_type -> handler.CreateTournamentResponse
-	9,  // 16: handler.TournamentService.GetTournamentByID:output_type -> handler.Tournament
-	11, // 17: handler.TournamentService.JoinTournament:output_type -> google.protobuf.Empty
-	11, // 18: handler.TournamentService.FinishTournament:output_type -> google.protobuf.Empty
-	11, // 19: handler.TournamentService.CancelTournament:output_type -> google.protobuf.Empty
-	10, // [10:20] is the sub-list for method output_type
-	0,  // [0:10] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+
+func (x *UserTransaction) GetBalanceAfterMoney() *Money {
+	if x != nil {
+		return x.BalanceAfterMoney
+	}
+	return nil
 }
 
-func init() { file_tournament_proto_init() }
-func file_tournament_proto_init() {
-	if File_tournament_proto != nil {
-		return
+func (x *UserTransaction) GetCurrency() string {
+	if x != nil {
+		return x.Currency
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_tournament_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*User); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_tournament_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SaveResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_tournament_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return ""
+}
+
+type UserTransactionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transactions []*UserTransaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	NextOffset   int32              `protobuf:"varint,2,opt,name=nextOffset,proto3" json:"nextOffset,omitempty"`
+}
+
+func (x *UserTransactionsResponse) Reset() {
+	*x = UserTransactionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserTransactionsResponse) ProtoMessage() {}
+
+func (x *UserTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_tournament_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestToUpdateBalance); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*UserTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UserTransactionsResponse) GetTransactions() []*UserTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *UserTransactionsResponse) GetNextOffset() int32 {
+	if x != nil {
+		return x.NextOffset
+	}
+	return 0
+}
+
+// Limit caps deposits or entries of user within a Day, Week or Month in one currency. Zero amount is no limit.
+// Looser limit waits in pendingAmount until pendingFrom.
+type Limit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Period        string                 `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	PendingAmount *Money                 `protobuf:"bytes,5,opt,name=pendingAmount,proto3" json:"pendingAmount,omitempty"`
+	PendingFrom   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=pendingFrom,proto3" json:"pendingFrom,omitempty"`
+}
+
+func (x *Limit) Reset() {
+	*x = Limit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Limit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Limit) ProtoMessage() {}
+
+func (x *Limit) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Limit.ProtoReflect.Descriptor instead.
+func (*Limit) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Limit) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Limit) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *Limit) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Limit) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Limit) GetPendingAmount() *Money {
+	if x != nil {
+		return x.PendingAmount
+	}
+	return nil
+}
+
+func (x *Limit) GetPendingFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PendingFrom
+	}
+	return nil
+}
+
+type SetLimitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Kind     string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Period   string `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`
+	Currency string `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount   *Money `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *SetLimitRequest) Reset() {
+	*x = SetLimitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLimitRequest) ProtoMessage() {}
+
+func (x *SetLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLimitRequest.ProtoReflect.Descriptor instead.
+func (*SetLimitRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetLimitRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SetLimitRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SetLimitRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *SetLimitRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *SetLimitRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type LimitsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limits        []*Limit               `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits,omitempty"`
+	ExcludedUntil *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=excludedUntil,proto3" json:"excludedUntil,omitempty"`
+}
+
+func (x *LimitsResponse) Reset() {
+	*x = LimitsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LimitsResponse) ProtoMessage() {}
+
+func (x *LimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LimitsResponse.ProtoReflect.Descriptor instead.
+func (*LimitsResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *LimitsResponse) GetLimits() []*Limit {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *LimitsResponse) GetExcludedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExcludedUntil
+	}
+	return nil
+}
+
+type SelfExcludeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Until  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=until,proto3" json:"until,omitempty"`
+}
+
+func (x *SelfExcludeRequest) Reset() {
+	*x = SelfExcludeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelfExcludeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelfExcludeRequest) ProtoMessage() {}
+
+func (x *SelfExcludeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelfExcludeRequest.ProtoReflect.Descriptor instead.
+func (*SelfExcludeRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SelfExcludeRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SelfExcludeRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+type CreateTournamentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Deposit              float64                `protobuf:"fixed64,2,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	Format               string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	Rounds               int32                  `protobuf:"varint,4,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	Tiebreakers          []string               `protobuf:"bytes,5,rep,name=tiebreakers,proto3" json:"tiebreakers,omitempty"`
+	WinnerStrategy       string                 `protobuf:"bytes,6,opt,name=winnerStrategy,proto3" json:"winnerStrategy,omitempty"`
+	PayoutType           string                 `protobuf:"bytes,7,opt,name=payoutType,proto3" json:"payoutType,omitempty"`
+	Payouts              []float64              `protobuf:"fixed64,8,rep,packed,name=payouts,proto3" json:"payouts,omitempty"`
+	RakeType             string                 `protobuf:"bytes,9,opt,name=rakeType,proto3" json:"rakeType,omitempty"`
+	Rake                 float64                `protobuf:"fixed64,10,opt,name=rake,proto3" json:"rake,omitempty"`
+	Draft                bool                   `protobuf:"varint,11,opt,name=draft,proto3" json:"draft,omitempty"`
+	RegistrationOpensAt  *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=registrationOpensAt,proto3" json:"registrationOpensAt,omitempty"`
+	RegistrationClosesAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=registrationClosesAt,proto3" json:"registrationClosesAt,omitempty"`
+	StartTime            *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	FinishDeadline       *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=finishDeadline,proto3" json:"finishDeadline,omitempty"`
+	MinPlayers           int32                  `protobuf:"varint,16,opt,name=minPlayers,proto3" json:"minPlayers,omitempty"`
+	MaxPlayers           int32                  `protobuf:"varint,17,opt,name=maxPlayers,proto3" json:"maxPlayers,omitempty"`
+	WithdrawalPenalty    float64                `protobuf:"fixed64,18,opt,name=withdrawalPenalty,proto3" json:"withdrawalPenalty,omitempty"`
+	DepositMoney         *Money                 `protobuf:"bytes,19,opt,name=depositMoney,proto3" json:"depositMoney,omitempty"`
+	Currency             string                 `protobuf:"bytes,20,opt,name=currency,proto3" json:"currency,omitempty"`
+}
+
+func (x *CreateTournamentRequest) Reset() {
+	*x = CreateTournamentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTournamentRequest) ProtoMessage() {}
+
+func (x *CreateTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTournamentRequest.ProtoReflect.Descriptor instead.
+func (*CreateTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateTournamentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTournamentRequest) GetDeposit() float64 {
+	if x != nil {
+		return x.Deposit
+	}
+	return 0
+}
+
+func (x *CreateTournamentRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *CreateTournamentRequest) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *CreateTournamentRequest) GetTiebreakers() []string {
+	if x != nil {
+		return x.Tiebreakers
+	}
+	return nil
+}
+
+func (x *CreateTournamentRequest) GetWinnerStrategy() string {
+	if x != nil {
+		return x.WinnerStrategy
+	}
+	return ""
+}
+
+func (x *CreateTournamentRequest) GetPayoutType() string {
+	if x != nil {
+		return x.PayoutType
+	}
+	return ""
+}
+
+func (x *CreateTournamentRequest) GetPayouts() []float64 {
+	if x != nil {
+		return x.Payouts
+	}
+	return nil
+}
+
+func (x *CreateTournamentRequest) GetRakeType() string {
+	if x != nil {
+		return x.RakeType
+	}
+	return ""
+}
+
+func (x *CreateTournamentRequest) GetRake() float64 {
+	if x != nil {
+		return x.Rake
+	}
+	return 0
+}
+
+func (x *CreateTournamentRequest) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
+}
+
+func (x *CreateTournamentRequest) GetRegistrationOpensAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RegistrationOpensAt
+	}
+	return nil
+}
+
+func (x *CreateTournamentRequest) GetRegistrationClosesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RegistrationClosesAt
+	}
+	return nil
+}
+
+func (x *CreateTournamentRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CreateTournamentRequest) GetFinishDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishDeadline
+	}
+	return nil
+}
+
+func (x *CreateTournamentRequest) GetMinPlayers() int32 {
+	if x != nil {
+		return x.MinPlayers
+	}
+	return 0
+}
+
+func (x *CreateTournamentRequest) GetMaxPlayers() int32 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
+}
+
+func (x *CreateTournamentRequest) GetWithdrawalPenalty() float64 {
+	if x != nil {
+		return x.WithdrawalPenalty
+	}
+	return 0
+}
+
+func (x *CreateTournamentRequest) GetDepositMoney() *Money {
+	if x != nil {
+		return x.DepositMoney
+	}
+	return nil
+}
+
+func (x *CreateTournamentRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type CreateTournamentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CreateTournamentResponse) Reset() {
+	*x = CreateTournamentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTournamentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTournamentResponse) ProtoMessage() {}
+
+func (x *CreateTournamentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTournamentResponse.ProtoReflect.Descriptor instead.
+func (*CreateTournamentResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateTournamentResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type TournamentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *TournamentRequest) Reset() {
+	*x = TournamentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentRequest) ProtoMessage() {}
+
+func (x *TournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentRequest.ProtoReflect.Descriptor instead.
+func (*TournamentRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TournamentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Tournament struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Deposit              float64                `protobuf:"fixed64,3,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	Prize                float64                `protobuf:"fixed64,4,opt,name=prize,proto3" json:"prize,omitempty"`
+	Users                []string               `protobuf:"bytes,5,rep,name=users,proto3" json:"users,omitempty"`
+	Winner               string                 `protobuf:"bytes,6,opt,name=winner,proto3" json:"winner,omitempty"`
+	Status               string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Format               string                 `protobuf:"bytes,8,opt,name=format,proto3" json:"format,omitempty"`
+	Rounds               int32                  `protobuf:"varint,9,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	Tiebreakers          []string               `protobuf:"bytes,10,rep,name=tiebreakers,proto3" json:"tiebreakers,omitempty"`
+	WinnerStrategy       string                 `protobuf:"bytes,11,opt,name=winnerStrategy,proto3" json:"winnerStrategy,omitempty"`
+	ServerSeedHash       string                 `protobuf:"bytes,12,opt,name=serverSeedHash,proto3" json:"serverSeedHash,omitempty"`
+	PayoutType           string                 `protobuf:"bytes,13,opt,name=payoutType,proto3" json:"payoutType,omitempty"`
+	Payouts              []float64              `protobuf:"fixed64,14,rep,packed,name=payouts,proto3" json:"payouts,omitempty"`
+	Placements           []*Placement           `protobuf:"bytes,15,rep,name=placements,proto3" json:"placements,omitempty"`
+	RakeType             string                 `protobuf:"bytes,16,opt,name=rakeType,proto3" json:"rakeType,omitempty"`
+	Rake                 float64                `protobuf:"fixed64,17,opt,name=rake,proto3" json:"rake,omitempty"`
+	GrossEntries         float64                `protobuf:"fixed64,18,opt,name=grossEntries,proto3" json:"grossEntries,omitempty"`
+	RakeCollected        float64                `protobuf:"fixed64,19,opt,name=rakeCollected,proto3" json:"rakeCollected,omitempty"`
+	RegistrationOpensAt  *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=registrationOpensAt,proto3" json:"registrationOpensAt,omitempty"`
+	RegistrationClosesAt *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=registrationClosesAt,proto3" json:"registrationClosesAt,omitempty"`
+	StartTime            *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	FinishDeadline       *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=finishDeadline,proto3" json:"finishDeadline,omitempty"`
+	MinPlayers           int32                  `protobuf:"varint,24,opt,name=minPlayers,proto3" json:"minPlayers,omitempty"`
+	MaxPlayers           int32                  `protobuf:"varint,25,opt,name=maxPlayers,proto3" json:"maxPlayers,omitempty"`
+	WithdrawalPenalty    float64                `protobuf:"fixed64,26,opt,name=withdrawalPenalty,proto3" json:"withdrawalPenalty,omitempty"`
+	DepositMoney         *Money                 `protobuf:"bytes,27,opt,name=depositMoney,proto3" json:"depositMoney,omitempty"`
+	PrizeMoney           *Money                 `protobuf:"bytes,28,opt,name=prizeMoney,proto3" json:"prizeMoney,omitempty"`
+	GrossEntriesMoney    *Money                 `protobuf:"bytes,29,opt,name=grossEntriesMoney,proto3" json:"grossEntriesMoney,omitempty"`
+	RakeCollectedMoney   *Money                 `protobuf:"bytes,30,opt,name=rakeCollectedMoney,proto3" json:"rakeCollectedMoney,omitempty"`
+	Currency             string                 `protobuf:"bytes,31,opt,name=currency,proto3" json:"currency,omitempty"`
+}
+
+func (x *Tournament) Reset() {
+	*x = Tournament{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tournament) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tournament) ProtoMessage() {}
+
+func (x *Tournament) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tournament.ProtoReflect.Descriptor instead.
+func (*Tournament) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Tournament) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Tournament) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tournament) GetDeposit() float64 {
+	if x != nil {
+		return x.Deposit
+	}
+	return 0
+}
+
+func (x *Tournament) GetPrize() float64 {
+	if x != nil {
+		return x.Prize
+	}
+	return 0
+}
+
+func (x *Tournament) GetUsers() []string {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *Tournament) GetWinner() string {
+	if x != nil {
+		return x.Winner
+	}
+	return ""
+}
+
+func (x *Tournament) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Tournament) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *Tournament) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *Tournament) GetTiebreakers() []string {
+	if x != nil {
+		return x.Tiebreakers
+	}
+	return nil
+}
+
+func (x *Tournament) GetWinnerStrategy() string {
+	if x != nil {
+		return x.WinnerStrategy
+	}
+	return ""
+}
+
+func (x *Tournament) GetServerSeedHash() string {
+	if x != nil {
+		return x.ServerSeedHash
+	}
+	return ""
+}
+
+func (x *Tournament) GetPayoutType() string {
+	if x != nil {
+		return x.PayoutType
+	}
+	return ""
+}
+
+func (x *Tournament) GetPayouts() []float64 {
+	if x != nil {
+		return x.Payouts
+	}
+	return nil
+}
+
+func (x *Tournament) GetPlacements() []*Placement {
+	if x != nil {
+		return x.Placements
+	}
+	return nil
+}
+
+func (x *Tournament) GetRakeType() string {
+	if x != nil {
+		return x.RakeType
+	}
+	return ""
+}
+
+func (x *Tournament) GetRake() float64 {
+	if x != nil {
+		return x.Rake
+	}
+	return 0
+}
+
+func (x *Tournament) GetGrossEntries() float64 {
+	if x != nil {
+		return x.GrossEntries
+	}
+	return 0
+}
+
+func (x *Tournament) GetRakeCollected() float64 {
+	if x != nil {
+		return x.RakeCollected
+	}
+	return 0
+}
+
+func (x *Tournament) GetRegistrationOpensAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RegistrationOpensAt
+	}
+	return nil
+}
+
+func (x *Tournament) GetRegistrationClosesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RegistrationClosesAt
+	}
+	return nil
+}
+
+func (x *Tournament) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Tournament) GetFinishDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishDeadline
+	}
+	return nil
+}
+
+func (x *Tournament) GetMinPlayers() int32 {
+	if x != nil {
+		return x.MinPlayers
+	}
+	return 0
+}
+
+func (x *Tournament) GetMaxPlayers() int32 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
+}
+
+func (x *Tournament) GetWithdrawalPenalty() float64 {
+	if x != nil {
+		return x.WithdrawalPenalty
+	}
+	return 0
+}
+
+func (x *Tournament) GetDepositMoney() *Money {
+	if x != nil {
+		return x.DepositMoney
+	}
+	return nil
+}
+
+func (x *Tournament) GetPrizeMoney() *Money {
+	if x != nil {
+		return x.PrizeMoney
+	}
+	return nil
+}
+
+func (x *Tournament) GetGrossEntriesMoney() *Money {
+	if x != nil {
+		return x.GrossEntriesMoney
+	}
+	return nil
+}
+
+func (x *Tournament) GetRakeCollectedMoney() *Money {
+	if x != nil {
+		return x.RakeCollectedMoney
+	}
+	return nil
+}
+
+func (x *Tournament) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type Placement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Place      int32   `protobuf:"varint,1,opt,name=place,proto3" json:"place,omitempty"`
+	UserID     string  `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Prize      float64 `protobuf:"fixed64,3,opt,name=prize,proto3" json:"prize,omitempty"`
+	PrizeMoney *Money  `protobuf:"bytes,4,opt,name=prizeMoney,proto3" json:"prizeMoney,omitempty"`
+}
+
+func (x *Placement) Reset() {
+	*x = Placement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Placement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Placement) ProtoMessage() {}
+
+func (x *Placement) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Placement.ProtoReflect.Descriptor instead.
+func (*Placement) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Placement) GetPlace() int32 {
+	if x != nil {
+		return x.Place
+	}
+	return 0
+}
+
+func (x *Placement) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *Placement) GetPrize() float64 {
+	if x != nil {
+		return x.Prize
+	}
+	return 0
+}
+
+func (x *Placement) GetPrizeMoney() *Money {
+	if x != nil {
+		return x.PrizeMoney
+	}
+	return nil
+}
+
+// JoinRequest without userID joins the caller. Only admin joins other users.
+type JoinRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TournamentID string  `protobuf:"bytes,1,opt,name=tournamentID,proto3" json:"tournamentID,omitempty"`
+	UserID       string  `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Stake        float64 `protobuf:"fixed64,3,opt,name=stake,proto3" json:"stake,omitempty"`
+	ClientSeed   string  `protobuf:"bytes,4,opt,name=clientSeed,proto3" json:"clientSeed,omitempty"`
+	Waitlist     bool    `protobuf:"varint,5,opt,name=waitlist,proto3" json:"waitlist,omitempty"`
+	StakeMoney   *Money  `protobuf:"bytes,6,opt,name=stakeMoney,proto3" json:"stakeMoney,omitempty"`
+}
+
+func (x *JoinRequest) Reset() {
+	*x = JoinRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRequest) ProtoMessage() {}
+
+func (x *JoinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRequest.ProtoReflect.Descriptor instead.
+func (*JoinRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *JoinRequest) GetTournamentID() string {
+	if x != nil {
+		return x.TournamentID
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetStake() float64 {
+	if x != nil {
+		return x.Stake
+	}
+	return 0
+}
+
+func (x *JoinRequest) GetClientSeed() string {
+	if x != nil {
+		return x.ClientSeed
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetWaitlist() bool {
+	if x != nil {
+		return x.Waitlist
+	}
+	return false
+}
+
+func (x *JoinRequest) GetStakeMoney() *Money {
+	if x != nil {
+		return x.StakeMoney
+	}
+	return nil
+}
+
+type JoinResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Waitlisted bool  `protobuf:"varint,1,opt,name=waitlisted,proto3" json:"waitlisted,omitempty"`
+	Position   int32 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (x *JoinResponse) Reset() {
+	*x = JoinResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinResponse) ProtoMessage() {}
+
+func (x *JoinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinResponse.ProtoReflect.Descriptor instead.
+func (*JoinResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *JoinResponse) GetWaitlisted() bool {
+	if x != nil {
+		return x.Waitlisted
+	}
+	return false
+}
+
+func (x *JoinResponse) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+// ParticipantRequest without userID is made for the caller, when caller leaves tournament.
+type ParticipantRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TournamentID string `protobuf:"bytes,1,opt,name=tournamentID,proto3" json:"tournamentID,omitempty"`
+	UserID       string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *ParticipantRequest) Reset() {
+	*x = ParticipantRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParticipantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantRequest) ProtoMessage() {}
+
+func (x *ParticipantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParticipantRequest.ProtoReflect.Descriptor instead.
+func (*ParticipantRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ParticipantRequest) GetTournamentID() string {
+	if x != nil {
+		return x.TournamentID
+	}
+	return ""
+}
+
+func (x *ParticipantRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type WaitlistEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     string  `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Stake      float64 `protobuf:"fixed64,2,opt,name=stake,proto3" json:"stake,omitempty"`
+	Position   int32   `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	StakeMoney *Money  `protobuf:"bytes,4,opt,name=stakeMoney,proto3" json:"stakeMoney,omitempty"`
+}
+
+func (x *WaitlistEntry) Reset() {
+	*x = WaitlistEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaitlistEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitlistEntry) ProtoMessage() {}
+
+func (x *WaitlistEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitlistEntry.ProtoReflect.Descriptor instead.
+func (*WaitlistEntry) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *WaitlistEntry) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetStake() float64 {
+	if x != nil {
+		return x.Stake
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetStakeMoney() *Money {
+	if x != nil {
+		return x.StakeMoney
+	}
+	return nil
+}
+
+type WaitlistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*WaitlistEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *WaitlistResponse) Reset() {
+	*x = WaitlistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaitlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitlistResponse) ProtoMessage() {}
+
+func (x *WaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitlistResponse.ProtoReflect.Descriptor instead.
+func (*WaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *WaitlistResponse) GetEntries() []*WaitlistEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type FinishRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WinnerID string   `protobuf:"bytes,2,opt,name=winnerID,proto3" json:"winnerID,omitempty"`
+	Ranking  []string `protobuf:"bytes,3,rep,name=ranking,proto3" json:"ranking,omitempty"`
+}
+
+func (x *FinishRequest) Reset() {
+	*x = FinishRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FinishRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishRequest) ProtoMessage() {}
+
+func (x *FinishRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishRequest.ProtoReflect.Descriptor instead.
+func (*FinishRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *FinishRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FinishRequest) GetWinnerID() string {
+	if x != nil {
+		return x.WinnerID
+	}
+	return ""
+}
+
+func (x *FinishRequest) GetRanking() []string {
+	if x != nil {
+		return x.Ranking
+	}
+	return nil
+}
+
+type ScoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TournamentID string  `protobuf:"bytes,1,opt,name=tournamentID,proto3" json:"tournamentID,omitempty"`
+	UserID       string  `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Score        float64 `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *ScoreRequest) Reset() {
+	*x = ScoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreRequest) ProtoMessage() {}
+
+func (x *ScoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreRequest.ProtoReflect.Descriptor instead.
+func (*ScoreRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ScoreRequest) GetTournamentID() string {
+	if x != nil {
+		return x.TournamentID
+	}
+	return ""
+}
+
+func (x *ScoreRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ScoreRequest) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type Match struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Round      int32  `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Position   int32  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	FirstUser  string `protobuf:"bytes,4,opt,name=firstUser,proto3" json:"firstUser,omitempty"`
+	SecondUser string `protobuf:"bytes,5,opt,name=secondUser,proto3" json:"secondUser,omitempty"`
+	Winner     string `protobuf:"bytes,6,opt,name=winner,proto3" json:"winner,omitempty"`
+	Draw       bool   `protobuf:"varint,7,opt,name=draw,proto3" json:"draw,omitempty"`
+}
+
+func (x *Match) Reset() {
+	*x = Match{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Match) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Match) ProtoMessage() {}
+
+func (x *Match) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Match.ProtoReflect.Descriptor instead.
+func (*Match) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Match) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Match) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *Match) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Match) GetFirstUser() string {
+	if x != nil {
+		return x.FirstUser
+	}
+	return ""
+}
+
+func (x *Match) GetSecondUser() string {
+	if x != nil {
+		return x.SecondUser
+	}
+	return ""
+}
+
+func (x *Match) GetWinner() string {
+	if x != nil {
+		return x.Winner
+	}
+	return ""
+}
+
+func (x *Match) GetDraw() bool {
+	if x != nil {
+		return x.Draw
+	}
+	return false
+}
+
+type MatchesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Matches []*Match `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+}
+
+func (x *MatchesResponse) Reset() {
+	*x = MatchesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchesResponse) ProtoMessage() {}
+
+func (x *MatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchesResponse.ProtoReflect.Descriptor instead.
+func (*MatchesResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *MatchesResponse) GetMatches() []*Match {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type MatchResultRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TournamentID string `protobuf:"bytes,1,opt,name=tournamentID,proto3" json:"tournamentID,omitempty"`
+	MatchID      string `protobuf:"bytes,2,opt,name=matchID,proto3" json:"matchID,omitempty"`
+	WinnerID     string `protobuf:"bytes,3,opt,name=winnerID,proto3" json:"winnerID,omitempty"`
+	Draw         bool   `protobuf:"varint,4,opt,name=draw,proto3" json:"draw,omitempty"`
+}
+
+func (x *MatchResultRequest) Reset() {
+	*x = MatchResultRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchResultRequest) ProtoMessage() {}
+
+func (x *MatchResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchResultRequest.ProtoReflect.Descriptor instead.
+func (*MatchResultRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *MatchResultRequest) GetTournamentID() string {
+	if x != nil {
+		return x.TournamentID
+	}
+	return ""
+}
+
+func (x *MatchResultRequest) GetMatchID() string {
+	if x != nil {
+		return x.MatchID
+	}
+	return ""
+}
+
+func (x *MatchResultRequest) GetWinnerID() string {
+	if x != nil {
+		return x.WinnerID
+	}
+	return ""
+}
+
+func (x *MatchResultRequest) GetDraw() bool {
+	if x != nil {
+		return x.Draw
+	}
+	return false
+}
+
+type Standing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     string  `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Played     int32   `protobuf:"varint,2,opt,name=played,proto3" json:"played,omitempty"`
+	Wins       int32   `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty"`
+	Draws      int32   `protobuf:"varint,4,opt,name=draws,proto3" json:"draws,omitempty"`
+	Losses     int32   `protobuf:"varint,5,opt,name=losses,proto3" json:"losses,omitempty"`
+	Points     float64 `protobuf:"fixed64,6,opt,name=points,proto3" json:"points,omitempty"`
+	Buchholz   float64 `protobuf:"fixed64,7,opt,name=buchholz,proto3" json:"buchholz,omitempty"`
+	HeadToHead float64 `protobuf:"fixed64,8,opt,name=headToHead,proto3" json:"headToHead,omitempty"`
+}
+
+func (x *Standing) Reset() {
+	*x = Standing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Standing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Standing) ProtoMessage() {}
+
+func (x *Standing) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Standing.ProtoReflect.Descriptor instead.
+func (*Standing) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Standing) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *Standing) GetPlayed() int32 {
+	if x != nil {
+		return x.Played
+	}
+	return 0
+}
+
+func (x *Standing) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *Standing) GetDraws() int32 {
+	if x != nil {
+		return x.Draws
+	}
+	return 0
+}
+
+func (x *Standing) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *Standing) GetPoints() float64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *Standing) GetBuchholz() float64 {
+	if x != nil {
+		return x.Buchholz
+	}
+	return 0
+}
+
+func (x *Standing) GetHeadToHead() float64 {
+	if x != nil {
+		return x.HeadToHead
+	}
+	return 0
+}
+
+type StandingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Standings []*Standing `protobuf:"bytes,1,rep,name=standings,proto3" json:"standings,omitempty"`
+}
+
+func (x *StandingsResponse) Reset() {
+	*x = StandingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StandingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StandingsResponse) ProtoMessage() {}
+
+func (x *StandingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StandingsResponse.ProtoReflect.Descriptor instead.
+func (*StandingsResponse) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *StandingsResponse) GetStandings() []*Standing {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+type DrawEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ClientSeed string `protobuf:"bytes,2,opt,name=clientSeed,proto3" json:"clientSeed,omitempty"`
+}
+
+func (x *DrawEntry) Reset() {
+	*x = DrawEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawEntry) ProtoMessage() {}
+
+func (x *DrawEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawEntry.ProtoReflect.Descriptor instead.
+func (*DrawEntry) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DrawEntry) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *DrawEntry) GetClientSeed() string {
+	if x != nil {
+		return x.ClientSeed
+	}
+	return ""
+}
+
+type DrawProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TournamentID   string       `protobuf:"bytes,1,opt,name=tournamentID,proto3" json:"tournamentID,omitempty"`
+	Algorithm      string       `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	ServerSeedHash string       `protobuf:"bytes,3,opt,name=serverSeedHash,proto3" json:"serverSeedHash,omitempty"`
+	ServerSeed     string       `protobuf:"bytes,4,opt,name=serverSeed,proto3" json:"serverSeed,omitempty"`
+	Entries        []*DrawEntry `protobuf:"bytes,5,rep,name=entries,proto3" json:"entries,omitempty"`
+	WinnerIndex    int32        `protobuf:"varint,6,opt,name=winnerIndex,proto3" json:"winnerIndex,omitempty"`
+	Winner         string       `protobuf:"bytes,7,opt,name=winner,proto3" json:"winner,omitempty"`
+}
+
+func (x *DrawProof) Reset() {
+	*x = DrawProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrawProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawProof) ProtoMessage() {}
+
+func (x *DrawProof) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawProof.ProtoReflect.Descriptor instead.
+func (*DrawProof) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DrawProof) GetTournamentID() string {
+	if x != nil {
+		return x.TournamentID
+	}
+	return ""
+}
+
+func (x *DrawProof) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *DrawProof) GetServerSeedHash() string {
+	if x != nil {
+		return x.ServerSeedHash
+	}
+	return ""
+}
+
+func (x *DrawProof) GetServerSeed() string {
+	if x != nil {
+		return x.ServerSeed
+	}
+	return ""
+}
+
+func (x *DrawProof) GetEntries() []*DrawEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *DrawProof) GetWinnerIndex() int32 {
+	if x != nil {
+		return x.WinnerIndex
+	}
+	return 0
+}
+
+func (x *DrawProof) GetWinner() string {
+	if x != nil {
+		return x.Winner
+	}
+	return ""
+}
+
+// ReconcileRequest asks to correct the discrepancies that can be corrected, besides reporting them.
+type ReconcileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Correct bool `protobuf:"varint,1,opt,name=correct,proto3" json:"correct,omitempty"`
+}
+
+func (x *ReconcileRequest) Reset() {
+	*x = ReconcileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconcileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileRequest) ProtoMessage() {}
+
+func (x *ReconcileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileRequest) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ReconcileRequest) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+// Discrepancy is a recorded value that differs from the expected one, derived from the ledger, holds or participants.
+type Discrepancy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind         string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	UserID       string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	TournamentID string `protobuf:"bytes,3,opt,name=tournamentID,proto3" json:"tournamentID,omitempty"`
+	Currency     string `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Recorded     *Money `protobuf:"bytes,5,opt,name=recorded,proto3" json:"recorded,omitempty"`
+	Expected     *Money `protobuf:"bytes,6,opt,name=expected,proto3" json:"expected,omitempty"`
+	Corrected    bool   `protobuf:"varint,7,opt,name=corrected,proto3" json:"corrected,omitempty"`
+}
+
+func (x *Discrepancy) Reset() {
+	*x = Discrepancy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Discrepancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Discrepancy) ProtoMessage() {}
+
+func (x *Discrepancy) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Discrepancy.ProtoReflect.Descriptor instead.
+func (*Discrepancy) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Discrepancy) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Discrepancy) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *Discrepancy) GetTournamentID() string {
+	if x != nil {
+		return x.TournamentID
+	}
+	return ""
+}
+
+func (x *Discrepancy) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Discrepancy) GetRecorded() *Money {
+	if x != nil {
+		return x.Recorded
+	}
+	return nil
+}
+
+func (x *Discrepancy) GetExpected() *Money {
+	if x != nil {
+		return x.Expected
+	}
+	return nil
+}
+
+func (x *Discrepancy) GetCorrected() bool {
+	if x != nil {
+		return x.Corrected
+	}
+	return false
+}
+
+type ReconciliationReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CheckedAt     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=checkedAt,proto3" json:"checkedAt,omitempty"`
+	Discrepancies []*Discrepancy         `protobuf:"bytes,2,rep,name=discrepancies,proto3" json:"discrepancies,omitempty"`
+}
+
+func (x *ReconciliationReport) Reset() {
+	*x = ReconciliationReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tournament_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconciliationReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationReport) ProtoMessage() {}
+
+func (x *ReconciliationReport) ProtoReflect() protoreflect.Message {
+	mi := &file_tournament_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationReport.ProtoReflect.Descriptor instead.
+func (*ReconciliationReport) Descriptor() ([]byte, []int) {
+	return file_tournament_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ReconciliationReport) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+func (x *ReconciliationReport) GetDiscrepancies() []*Discrepancy {
+	if x != nil {
+		return x.Discrepancies
+	}
+	return nil
+}
+
+var File_tournament_proto protoreflect.FileDescriptor
+
+var file_tournament_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x07, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x1a, 0x1b, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x33, 0x0a, 0x05, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6e, 0x6f,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x22, 0x7b,
+	0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x26, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a,
+	0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x22, 0xb2, 0x02, 0x0a, 0x04,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x32, 0x0a, 0x0c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x08, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x72, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x15, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x15, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04,
+	0x52, 0x6f, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x52, 0x6f, 0x6c, 0x65,
+	0x22, 0x3a, 0x0a, 0x0c, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x1d, 0x0a, 0x0b,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x8e, 0x01, 0x0a, 0x16,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x12, 0x30,
+	0x0a, 0x0b, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x0b, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x4e, 0x0a, 0x14,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xc5, 0x01, 0x0a,
+	0x15, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x46, 0x0a, 0x10,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x10, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x41, 0x74, 0x22, 0x34, 0x0a, 0x0e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x73, 0x0a, 0x15, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x6f,
+	0x6c, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22,
+	0x3c, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x32, 0x0a,
+	0x14, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x4e, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x22, 0xed, 0x01, 0x0a, 0x17, 0x55, 0x73, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74,
+	0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x22, 0xdb, 0x02, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x12, 0x3c, 0x0a, 0x11, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x11, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22,
+	0x78, 0x0a, 0x18, 0x55, 0x73, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78,
+	0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6e,
+	0x65, 0x78, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0xeb, 0x01, 0x0a, 0x05, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x26, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x68, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x68, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0d, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x0b, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0x99, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x26, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x68, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x7a, 0x0a, 0x0e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x40, 0x0a,
+	0x0d, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0d, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22,
+	0x5e, 0x0a, 0x12, 0x53, 0x65, 0x6c, 0x66, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x30, 0x0a,
+	0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x22,
+	0x9b, 0x06, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x69, 0x65,
+	0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x74, 0x69, 0x65, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x77,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x01, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x61, 0x6b, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x61, 0x6b, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6b,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x61, 0x6b, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x72,
+	0x61, 0x66, 0x74, 0x12, 0x4c, 0x0a, 0x13, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x65, 0x6e, 0x73, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x65, 0x6e, 0x73, 0x41,
+	0x74, 0x12, 0x4e, 0x0a, 0x14, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x41, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x14, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x41,
+	0x74, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0e, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0e, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12,
+	0x2c, 0x0a, 0x11, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x50, 0x65, 0x6e,
+	0x61, 0x6c, 0x74, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x32, 0x0a,
+	0x0c, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x2a, 0x0a,
+	0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x23, 0x0a, 0x11, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xb8,
+	0x09, 0x0a, 0x0a, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x72, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x7a,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x69, 0x65, 0x62, 0x72,
+	0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x69,
+	0x65, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x77, 0x69, 0x6e,
+	0x6e, 0x65, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x65, 0x64, 0x48,
+	0x61, 0x73, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x53, 0x65, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x79,
+	0x6f, 0x75, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x61, 0x79, 0x6f, 0x75, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79,
+	0x6f, 0x75, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x01, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x72, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x61, 0x6b, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x61, 0x6b, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6b, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x04, 0x72, 0x61, 0x6b, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x73, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x67,
+	0x72, 0x6f, 0x73, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72,
+	0x61, 0x6b, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0d, 0x72, 0x61, 0x6b, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x12, 0x4c, 0x0a, 0x13, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4f, 0x70, 0x65, 0x6e, 0x73, 0x41, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x65, 0x6e, 0x73, 0x41, 0x74, 0x12,
+	0x4e, 0x0a, 0x14, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x73, 0x41, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x14, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x41, 0x74, 0x12,
+	0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x16, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0e, 0x66, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6d, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x18, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6d, 0x61, 0x78, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x2c, 0x0a,
+	0x11, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x50, 0x65, 0x6e, 0x61, 0x6c,
+	0x74, 0x79, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x61, 0x6c, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x0c, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x18, 0x1b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x12,
+	0x2e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x7a, 0x65, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x18, 0x1c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x7a, 0x65, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x12,
+	0x3c, 0x0a, 0x11, 0x67, 0x72, 0x6f, 0x73, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x68, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x11, 0x67, 0x72, 0x6f, 0x73,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x12, 0x3e, 0x0a,
+	0x12, 0x72, 0x61, 0x6b, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x68, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x12, 0x72, 0x61, 0x6b, 0x65, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x7f, 0x0a, 0x09, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x2e, 0x0a, 0x0a, 0x70, 0x72,
+	0x69, 0x7a, 0x65, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0a,
+	0x70, 0x72, 0x69, 0x7a, 0x65, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x22, 0xcb, 0x01, 0x0a, 0x0b, 0x4a,
+	0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x77, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x77, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x6b,
+	0x65, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x68,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0a, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x22, 0x4a, 0x0a, 0x0c, 0x4a, 0x6f, 0x69, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x61, 0x69, 0x74,
+	0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x77, 0x61,
+	0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x50, 0x0a, 0x12, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x89, 0x01, 0x0a, 0x0d, 0x57, 0x61, 0x69, 0x74, 0x6c,
+	0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x22, 0x44, 0x0a, 0x10, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x72, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x55, 0x0a, 0x0d, 0x46, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x69, 0x6e,
+	0x6e, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x69, 0x6e,
+	0x6e, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x22,
+	0x60, 0x0a, 0x0c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x22, 0xb3, 0x01, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a,
+	0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x77,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x69, 0x6e,
+	0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x72, 0x61, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x04, 0x64, 0x72, 0x61, 0x77, 0x22, 0x3b, 0x0a, 0x0f, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x68, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x12, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x74,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x69, 0x6e,
+	0x6e, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x69, 0x6e,
+	0x6e, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x72, 0x61, 0x77, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x72, 0x61, 0x77, 0x22, 0xd0, 0x01, 0x0a, 0x08, 0x53, 0x74,
+	0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x72,
+	0x61, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x72, 0x61, 0x77, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x63, 0x68, 0x68, 0x6f, 0x6c, 0x7a, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x62, 0x75, 0x63, 0x68, 0x68, 0x6f, 0x6c, 0x7a, 0x12, 0x1e, 0x0a, 0x0a,
+	0x68, 0x65, 0x61, 0x64, 0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x22, 0x44, 0x0a, 0x11,
+	0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2f, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x53,
+	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x22, 0x43, 0x0a, 0x09, 0x44, 0x72, 0x61, 0x77, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x53, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x65, 0x64, 0x22, 0xfd, 0x01, 0x0a, 0x09, 0x44, 0x72, 0x61, 0x77,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x53, 0x65, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x65, 0x64, 0x12,
+	0x2c, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x22, 0x2c, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x63, 0x74, 0x22, 0xef, 0x01, 0x0a, 0x0b, 0x44, 0x69, 0x73, 0x63, 0x72, 0x65,
+	0x70, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x12, 0x2a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x12, 0x2a, 0x0a,
+	0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x72,
+	0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x8c, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x38, 0x0a, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3a, 0x0a, 0x0d, 0x64, 0x69,
+	0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x44, 0x69, 0x73, 0x63,
+	0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70,
+	0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x32, 0xce, 0x12, 0x0a, 0x11, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x08,
+	0x53, 0x61, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0d, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x15, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x72, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x34, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x49, 0x44, 0x12,
+	0x14, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x49, 0x44, 0x12, 0x14, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0c, 0x53, 0x75, 0x6d, 0x54,
+	0x6f, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x6f, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x72, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x72, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0e, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x2e, 0x68, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x48, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1e, 0x2e, 0x68, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x72, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1e,
+	0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x14, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x12, 0x1d, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1d, 0x2e, 0x68, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f,
+	0x6c, 0x65, 0x12, 0x17, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e,
+	0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x18, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x68, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x09,
+	0x47, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x14, 0x2e, 0x68, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0b, 0x53, 0x65,
+	0x6c, 0x66, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x1b, 0x2e, 0x68, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x6c, 0x66, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x59, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x44,
+	0x12, 0x1a, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x68,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0e, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e,
+	0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x68, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0f, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x72, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4a,
+	0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x68, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e,
+	0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x44, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72,
+	0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x68,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x48, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72,
+	0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x11,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1a, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x15, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72,
+	0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x68, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x44, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x1a,
+	0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x68, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1a, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72,
+	0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4a, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1b, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0c,
+	0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x77, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1a, 0x2e, 0x68,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x72, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x00, 0x12, 0x47,
+	0x0a, 0x09, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x12, 0x19, 0x2e, 0x68, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x00, 0x42, 0x0f, 0x5a, 0x0d, 0x2f, 0x68, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x72, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_tournament_proto_rawDescOnce sync.Once
+	file_tournament_proto_rawDescData = file_tournament_proto_rawDesc
+)
+
+func file_tournament_proto_rawDescGZIP() []byte {
+	file_tournament_proto_rawDescOnce.Do(func() {
+		file_tournament_proto_rawDescData = protoimpl.X.CompressGZIP(file_tournament_proto_rawDescData)
+	})
+	return file_tournament_proto_rawDescData
+}
+
+var file_tournament_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
+var file_tournament_proto_goTypes = []interface{}{
+	(*Money)(nil),                    // 0: handler.Money
+	(*Balance)(nil),                  // 1: handler.Balance
+	(*User)(nil),                     // 2: handler.User
+	(*SaveResponse)(nil),             // 3: handler.SaveResponse
+	(*UserRequest)(nil),              // 4: handler.UserRequest
+	(*RequestToUpdateBalance)(nil),   // 5: handler.RequestToUpdateBalance
+	(*AuthorizationRequest)(nil),     // 6: handler.AuthorizationRequest
+	(*AuthorizationResponse)(nil),    // 7: handler.AuthorizationResponse
+	(*RefreshRequest)(nil),           // 8: handler.RefreshRequest
+	(*ChangePasswordRequest)(nil),    // 9: handler.ChangePasswordRequest
+	(*SetRoleRequest)(nil),           // 10: handler.SetRoleRequest
+	(*PasswordResetRequest)(nil),     // 11: handler.PasswordResetRequest
+	(*ResetPasswordRequest)(nil),     // 12: handler.ResetPasswordRequest
+	(*UserTransactionsRequest)(nil),  // 13: handler.UserTransactionsRequest
+	(*UserTransaction)(nil),          // 14: handler.UserTransaction
+	(*UserTransactionsResponse)(nil), // 15: handler.UserTransactionsResponse
+	(*Limit)(nil),                    // 16: handler.Limit
+	(*SetLimitRequest)(nil),          // 17: handler.SetLimitRequest
+	(*LimitsResponse)(nil),           // 18: handler.LimitsResponse
+	(*SelfExcludeRequest)(nil),       // 19: handler.SelfExcludeRequest
+	(*CreateTournamentRequest)(nil),  // 20: handler.CreateTournamentRequest
+	(*CreateTournamentResponse)(nil), // 21: handler.CreateTournamentResponse
+	(*TournamentRequest)(nil),        // 22: handler.TournamentRequest
+	(*Tournament)(nil),               // 23: handler.Tournament
+	(*Placement)(nil),                // 24: handler.Placement
+	(*JoinRequest)(nil),              // 25: handler.JoinRequest
+	(*JoinResponse)(nil),             // 26: handler.JoinResponse
+	(*ParticipantRequest)(nil),       // 27: handler.ParticipantRequest
+	(*WaitlistEntry)(nil),            // 28: handler.WaitlistEntry
+	(*WaitlistResponse)(nil),         // 29: handler.WaitlistResponse
+	(*FinishRequest)(nil),            // 30: handler.FinishRequest
+	(*ScoreRequest)(nil),             // 31: handler.ScoreRequest
+	(*Match)(nil),                    // 32: handler.Match
+	(*MatchesResponse)(nil),          // 33: handler.MatchesResponse
+	(*MatchResultRequest)(nil),       // 34: handler.MatchResultRequest
+	(*Standing)(nil),                 // 35: handler.Standing
+	(*StandingsResponse)(nil),        // 36: handler.StandingsResponse
+	(*DrawEntry)(nil),                // 37: handler.DrawEntry
+	(*DrawProof)(nil),                // 38: handler.DrawProof
+	(*ReconcileRequest)(nil),         // 39: handler.ReconcileRequest
+	(*Discrepancy)(nil),              // 40: handler.Discrepancy
+	(*ReconciliationReport)(nil),     // 41: handler.ReconciliationReport
+	(*timestamppb.Timestamp)(nil),    // 42: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),            // 43: google.protobuf.Empty
+}
+var file_tournament_proto_depIdxs = []int32{
+	0,  // 0: handler.Balance.amount:type_name -> handler.Money
+	0,  // 1: handler.Balance.available:type_name -> handler.Money
+	0,  // 2: handler.User.BalanceMoney:type_name -> handler.Money
+	1,  // 3: handler.User.Balances:type_name -> handler.Balance
+	0,  // 4: handler.User.AvailableBalanceMoney:type_name -> handler.Money
+	0,  // 5: handler.RequestToUpdateBalance.addendMoney:type_name -> handler.Money
+	42, // 6: handler.AuthorizationResponse.sessionExpiresAt:type_name -> google.protobuf.Timestamp
+	42, // 7: handler.UserTransactionsRequest.from:type_name -> google.protobuf.Timestamp
+	42, // 8: handler.UserTransactionsRequest.to:type_name -> google.protobuf.Timestamp
+	42, // 9: handler.UserTransaction.createdAt:type_name -> google.protobuf.Timestamp
+	0,  // 10: handler.UserTransaction.amountMoney:type_name -> handler.Money
+	0,  // 11: handler.UserTransaction.balanceAfterMoney:type_name -> handler.Money
+	14, // 12: handler.UserTransactionsResponse.transactions:type_name -> handler.UserTransaction
+	0,  // 13: handler.Limit.amount:type_name -> handler.Money
+	0,  // 14: handler.Limit.pendingAmount:type_name -> handler.Money
+	42, // 15: handler.Limit.pendingFrom:type_name -> google.protobuf.Timestamp
+	0,  // 16: handler.SetLimitRequest.amount:type_name -> handler.Money
+	16, // 17: handler.LimitsResponse.limits:type_name -> handler.Limit
+	42, // 18: handler.LimitsResponse.excludedUntil:type_name -> google.protobuf.Timestamp
+	42, // 19: handler.SelfExcludeRequest.until:type_name -> google.protobuf.Timestamp
+	42, // 20: handler.CreateTournamentRequest.registrationOpensAt:type_name -> google.protobuf.Timestamp
+	42, // 21: handler.CreateTournamentRequest.registrationClosesAt:type_name -> google.protobuf.Timestamp
+	42, // 22: handler.CreateTournamentRequest.startTime:type_name -> google.protobuf.Timestamp
+	42, // 23: handler.CreateTournamentRequest.finishDeadline:type_name -> google.protobuf.Timestamp
+	0,  // 24: handler.CreateTournamentRequest.depositMoney:type_name -> handler.Money
+	24, // 25: handler.Tournament.placements:type_name -> handler.Placement
+	42, // 26: handler.Tournament.registrationOpensAt:type_name -> google.protobuf.Timestamp
+	42, // 27: handler.Tournament.registrationClosesAt:type_name -> google.protobuf.Timestamp
+	42, // 28: handler.Tournament.startTime:type_name -> google.protobuf.Timestamp
+	42, // 29: handler.Tournament.finishDeadline:type_name -> google.protobuf.Timestamp
+	0,  // 30: handler.Tournament.depositMoney:type_name -> handler.Money
+	0,  // 31: handler.Tournament.prizeMoney:type_name -> handler.Money
+	0,  // 32: handler.Tournament.grossEntriesMoney:type_name -> handler.Money
+	0,  // 33: handler.Tournament.rakeCollectedMoney:type_name -> handler.Money
+	0,  // 34: handler.Placement.prizeMoney:type_name -> handler.Money
+	0,  // 35: handler.JoinRequest.stakeMoney:type_name -> handler.Money
+	0,  // 36: handler.WaitlistEntry.stakeMoney:type_name -> handler.Money
+	28, // 37: handler.WaitlistResponse.entries:type_name -> handler.WaitlistEntry
+	32, // 38: handler.MatchesResponse.matches:type_name -> handler.Match
+	35, // 39: handler.StandingsResponse.standings:type_name -> handler.Standing
+	37, // 40: handler.DrawProof.entries:type_name -> handler.DrawEntry
+	0,  // 41: handler.Discrepancy.recorded:type_name -> handler.Money
+	0,  // 42: handler.Discrepancy.expected:type_name -> handler.Money
+	42, // 43: handler.ReconciliationReport.checkedAt:type_name -> google.protobuf.Timestamp
+	40, // 44: handler.ReconciliationReport.discrepancies:type_name -> handler.Discrepancy
+	2,  // 45: handler.TournamentService.SaveUser:input_type -> handler.User
+	4,  // 46: handler.TournamentService.GetUserByID:input_type -> handler.UserRequest
+	4,  // 47: handler.TournamentService.DeleteUserByID:input_type -> handler.UserRequest
+	5,  // 48: handler.TournamentService.SumToBalance:input_type -> handler.RequestToUpdateBalance
+	6,  // 49: handler.TournamentService.UserAuthorization:input_type -> handler.AuthorizationRequest
+	8,  // 50: handler.TournamentService.RefreshSession:input_type -> handler.RefreshRequest
+	43, // 51: handler.TournamentService.Logout:input_type -> google.protobuf.Empty
+	43, // 52: handler.TournamentService.Authenticate:input_type -> google.protobuf.Empty
+	9,  // 53: handler.TournamentService.ChangePassword:input_type -> handler.ChangePasswordRequest
+	11, // 54: handler.TournamentService.RequestPasswordReset:input_type -> handler.PasswordResetRequest
+	12, // 55: handler.TournamentService.ResetPassword:input_type -> handler.ResetPasswordRequest
+	10, // 56: handler.TournamentService.SetUserRole:input_type -> handler.SetRoleRequest
+	13, // 57: handler.TournamentService.ListUserTransactions:input_type -> handler.UserTransactionsRequest
+	17, // 58: handler.TournamentService.SetLimit:input_type -> handler.SetLimitRequest
+	4,  // 59: handler.TournamentService.GetLimits:input_type -> handler.UserRequest
+	19, // 60: handler.TournamentService.SelfExclude:input_type -> handler.SelfExcludeRequest
+	20, // 61: handler.TournamentService.CreateTournament:input_type -> handler.CreateTournamentRequest
+	22, // 62: handler.TournamentService.GetTournamentByID:input_type -> handler.TournamentRequest
+	25, // 63: handler.TournamentService.JoinTournament:input_type -> handler.JoinRequest
+	27, // 64: handler.TournamentService.LeaveTournament:input_type -> handler.ParticipantRequest
+	27, // 65: handler.TournamentService.RemoveParticipant:input_type -> handler.ParticipantRequest
+	22, // 66: handler.TournamentService.GetWaitlist:input_type -> handler.TournamentRequest
+	30, // 67: handler.TournamentService.FinishTournament:input_type -> handler.FinishRequest
+	22, // 68: handler.TournamentService.CancelTournament:input_type -> handler.TournamentRequest
+	22, // 69: handler.TournamentService.OpenRegistration:input_type -> handler.TournamentRequest
+	22, // 70: handler.TournamentService.CloseRegistration:input_type -> handler.TournamentRequest
+	31, // 71: handler.TournamentService.ReportScore:input_type -> handler.ScoreRequest
+	22, // 72: handler.TournamentService.StartTournament:input_type -> handler.TournamentRequest
+	22, // 73: handler.TournamentService.GetMatches:input_type -> handler.TournamentRequest
+	22, // 74: handler.TournamentService.GetStandings:input_type -> handler.TournamentRequest
+	34, // 75: handler.TournamentService.ReportMatchResult:input_type -> handler.MatchResultRequest
+	22, // 76: handler.TournamentService.GetDrawProof:input_type -> handler.TournamentRequest
+	39, // 77: handler.TournamentService.Reconcile:input_type -> handler.ReconcileRequest
+	3,  // 78: handler.TournamentService.SaveUser:output_type -> handler.SaveResponse
+	2,  // 79: handler.TournamentService.GetUserByID:output_type -> handler.User
+	43, // 80: handler.TournamentService.DeleteUserByID:output_type -> google.protobuf.Empty
+	43, // 81: handler.TournamentService.SumToBalance:output_type -> google.protobuf.Empty
+	7,  // 82: handler.TournamentService.UserAuthorization:output_type -> handler.AuthorizationResponse
+	7,  // 83: handler.TournamentService.RefreshSession:output_type -> handler.AuthorizationResponse
+	43, // 84: handler.TournamentService.Logout:output_type -> google.protobuf.Empty
+	7,  // 85: handler.TournamentService.Authenticate:output_type -> handler.AuthorizationResponse
+	43, // 86: handler.TournamentService.ChangePassword:output_type -> google.protobuf.Empty
+	43, // 87: handler.TournamentService.RequestPasswordReset:output_type -> google.protobuf.Empty
+	43, // 88: handler.TournamentService.ResetPassword:output_type -> google.protobuf.Empty
+	43, // 89: handler.TournamentService.SetUserRole:output_type -> google.protobuf.Empty
+	15, // 90: handler.TournamentService.ListUserTransactions:output_type -> handler.UserTransactionsResponse
+	16, // 91: handler.TournamentService.SetLimit:output_type -> handler.Limit
+	18, // 92: handler.TournamentService.GetLimits:output_type -> handler.LimitsResponse
+	43, // 93: handler.TournamentService.SelfExclude:output_type -> google.protobuf.Empty
+	21, // 94: handler.TournamentService.CreateTournament:output_type -> handler.CreateTournamentResponse
+	23, // 95: handler.TournamentService.GetTournamentByID:output_type -> handler.Tournament
+	26, // 96: handler.TournamentService.JoinTournament:output_type -> handler.JoinResponse
+	43, // 97: handler.TournamentService.LeaveTournament:output_type -> google.protobuf.Empty
+	43, // 98: handler.TournamentService.RemoveParticipant:output_type -> google.protobuf.Empty
+	29, // 99: handler.TournamentService.GetWaitlist:output_type -> handler.WaitlistResponse
+	43, // 100: handler.TournamentService.FinishTournament:output_type -> google.protobuf.Empty
+	43, // 101: handler.TournamentService.CancelTournament:output_type -> google.protobuf.Empty
+	43, // 102: handler.TournamentService.OpenRegistration:output_type -> google.protobuf.Empty
+	43, // 103: handler.TournamentService.CloseRegistration:output_type -> google.protobuf.Empty
+	43, // 104: handler.TournamentService.ReportScore:output_type -> google.protobuf.Empty
+	43, // 105: handler.TournamentService.StartTournament:output_type -> google.protobuf.Empty
+	33, // 106: handler.TournamentService.GetMatches:output_type -> handler.MatchesResponse
+	36, // 107: handler.TournamentService.GetStandings:output_type -> handler.StandingsResponse
+	43, // 108: handler.TournamentService.ReportMatchResult:output_type -> google.protobuf.Empty
+	38, // 109: handler.TournamentService.GetDrawProof:output_type -> handler.DrawProof
+	41, // 110: handler.TournamentService.Reconcile:output_type -> handler.ReconciliationReport
+	78, // [78:111] is the sub-list for method output_type
+	45, // [45:78] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
+}
+
+func init() { file_tournament_proto_init() }
+func file_tournament_proto_init() {
+	if File_tournament_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_tournament_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Money); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Balance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestToUpdateBalance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangePasswordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetRoleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
 				return nil
 			}
 		}
-		file_tournament_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthorizationRequest); i {
+		file_tournament_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PasswordResetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -866,8 +4114,8 @@ func file_tournament_proto_init() {
 				return nil
 			}
 		}
-		file_tournament_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthorizationResponse); i {
+		file_tournament_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetPasswordRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -878,7 +4126,91 @@ func file_tournament_proto_init() {
 				return nil
 			}
 		}
-		file_tournament_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_tournament_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserTransactionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserTransaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserTransactionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Limit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLimitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LimitsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelfExcludeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateTournamentRequest); i {
 			case 0:
 				return &v.state
@@ -890,7 +4222,7 @@ func file_tournament_proto_init() {
 				return nil
 			}
 		}
-		file_tournament_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_tournament_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateTournamentResponse); i {
 			case 0:
 				return &v.state
@@ -902,7 +4234,7 @@ func file_tournament_proto_init() {
 				return nil
 			}
 		}
-		file_tournament_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_tournament_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TournamentRequest); i {
 			case 0:
 				return &v.state
@@ -914,7 +4246,7 @@ func file_tournament_proto_init() {
 				return nil
 			}
 		}
-		file_tournament_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_tournament_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Tournament); i {
 			case 0:
 				return &v.state
@@ -926,7 +4258,19 @@ func file_tournament_proto_init() {
 				return nil
 			}
 		}
-		file_tournament_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_tournament_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Placement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*JoinRequest); i {
 			case 0:
 				return &v.state
@@ -938,6 +4282,198 @@ func file_tournament_proto_init() {
 				return nil
 			}
 		}
+		file_tournament_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParticipantRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaitlistEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaitlistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinishRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Match); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchResultRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Standing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StandingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrawProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconcileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Discrepancy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tournament_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconciliationReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -945,7 +4481,7 @@ func file_tournament_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_tournament_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
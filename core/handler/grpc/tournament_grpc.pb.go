@@ -24,11 +24,37 @@ type TournamentServiceClient interface {
 	DeleteUserByID(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SumToBalance(ctx context.Context, in *RequestToUpdateBalance, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UserAuthorization(ctx context.Context, in *AuthorizationRequest, opts ...grpc.CallOption) (*AuthorizationResponse, error)
+	// RefreshSession rotates refresh token and extends its session.
+	RefreshSession(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*AuthorizationResponse, error)
+	// Logout revokes session of the caller's token.
+	Logout(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Authenticate returns the caller of the token, once the session of the token is active.
+	Authenticate(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AuthorizationResponse, error)
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RequestPasswordReset(ctx context.Context, in *PasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetUserRole(ctx context.Context, in *SetRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListUserTransactions(ctx context.Context, in *UserTransactionsRequest, opts ...grpc.CallOption) (*UserTransactionsResponse, error)
+	SetLimit(ctx context.Context, in *SetLimitRequest, opts ...grpc.CallOption) (*Limit, error)
+	GetLimits(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*LimitsResponse, error)
+	SelfExclude(ctx context.Context, in *SelfExcludeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CreateTournament(ctx context.Context, in *CreateTournamentRequest, opts ...grpc.CallOption) (*CreateTournamentResponse, error)
 	GetTournamentByID(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*Tournament, error)
-	JoinTournament(ctx context.Context, in *JoinRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	FinishTournament(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	JoinTournament(ctx context.Context, in *JoinRequest, opts ...grpc.CallOption) (*JoinResponse, error)
+	LeaveTournament(ctx context.Context, in *ParticipantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveParticipant(ctx context.Context, in *ParticipantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetWaitlist(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*WaitlistResponse, error)
+	FinishTournament(ctx context.Context, in *FinishRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CancelTournament(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	OpenRegistration(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CloseRegistration(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ReportScore(ctx context.Context, in *ScoreRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	StartTournament(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetMatches(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*MatchesResponse, error)
+	GetStandings(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*StandingsResponse, error)
+	ReportMatchResult(ctx context.Context, in *MatchResultRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetDrawProof(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*DrawProof, error)
+	Reconcile(ctx context.Context, in *ReconcileRequest, opts ...grpc.CallOption) (*ReconciliationReport, error)
 }
 
 type tournamentServiceClient struct {
@@ -84,6 +110,105 @@ func (c *tournamentServiceClient) UserAuthorization(ctx context.Context, in *Aut
 	return out, nil
 }
 
+func (c *tournamentServiceClient) RefreshSession(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*AuthorizationResponse, error) {
+	out := new(AuthorizationResponse)
+	err := c.cc.Invoke(ctx, "/handler.TournamentService/RefreshSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) Logout(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/handler.TournamentService/Logout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) Authenticate(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AuthorizationResponse, error) {
+	out := new(AuthorizationResponse)
+	err := c.cc.Invoke(ctx, "/handler.TournamentService/Authenticate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/handler.TournamentService/ChangePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) RequestPasswordReset(ctx context.Context, in *PasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/handler.TournamentService/RequestPasswordReset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/handler.TournamentService/ResetPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) SetUserRole(ctx context.Context, in *SetRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/handler.TournamentService/SetUserRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) ListUserTransactions(ctx context.Context, in *UserTransactionsRequest, opts ...grpc.CallOption) (*UserTransactionsResponse, error) {
+	out := new(UserTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/handler.TournamentService/ListUserTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) SetLimit(ctx context.Context, in *SetLimitRequest, opts ...grpc.CallOption) (*Limit, error) {
+	out := new(Limit)
+	err := c.cc.Invoke(ctx, "/handler.TournamentService/SetLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) GetLimits(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*LimitsResponse, error) {
+	out := new(LimitsResponse)
+	err := c.cc.Invoke(ctx, "/handler.TournamentService/GetLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) SelfExclude(ctx context.Context, in *SelfExcludeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/handler.TournamentService/SelfExclude", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tournamentServiceClient) CreateTournament(ctx context.Context, in *CreateTournamentRequest, opts ...grpc.CallOption) (*CreateTournamentResponse, error) {
 	out := new(CreateTournamentResponse)
 	err := c.cc.Invoke(ctx, "/handler.TournamentService/CreateTournament", in, out, opts...)
@@ -102,8 +227,8 @@ func (c *tournamentServiceClient) GetTournamentByID(ctx context.Context, in *Tou
 	return out, nil
 }
 
-func (c *tournamentServiceClient) JoinTournament(ctx context.Context, in *JoinRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *tournamentServiceClient) JoinTournament(ctx context.Context, in *JoinRequest, opts ...grpc.CallOption) (*JoinResponse, error) {
+	out := new(JoinResponse)
 	err := c.cc.Invoke(ctx, "/handler.TournamentService/JoinTournament", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -111,7 +236,34 @@ func (c *tournamentServiceClient) JoinTournament(ctx context.Context, in *JoinRe
 	return out, nil
 }
 
-func (c *tournamentServiceClient) FinishTournament(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *tournamentServiceClient) LeaveTournament(ctx context.Context, in *ParticipantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/handler.TournamentService/LeaveTournament", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) RemoveParticipant(ctx context.Context, in *ParticipantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/handler.TournamentService/RemoveParticipant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) GetWaitlist(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*WaitlistResponse, error) {
+	out := new(WaitlistResponse)
+	err := c.cc.Invoke(ctx, "/handler.TournamentService/GetWaitlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) FinishTournament(ctx context.Context, in *FinishRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/handler.TournamentService/FinishTournament", in, out, opts...)
 	if err != nil {
@@ -129,6 +281,87 @@ func (c *tournamentServiceClient) CancelTournament(ctx context.Context, in *Tour
 	return out, nil
 }
 
+func (c *tournamentServiceClient) OpenRegistration(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/handler.TournamentService/OpenRegistration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) CloseRegistration(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/handler.TournamentService/CloseRegistration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) ReportScore(ctx context.Context, in *ScoreRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/handler.TournamentService/ReportScore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) StartTournament(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/handler.TournamentService/StartTournament", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) GetMatches(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*MatchesResponse, error) {
+	out := new(MatchesResponse)
+	err := c.cc.Invoke(ctx, "/handler.TournamentService/GetMatches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) GetStandings(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*StandingsResponse, error) {
+	out := new(StandingsResponse)
+	err := c.cc.Invoke(ctx, "/handler.TournamentService/GetStandings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) ReportMatchResult(ctx context.Context, in *MatchResultRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/handler.TournamentService/ReportMatchResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) GetDrawProof(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*DrawProof, error) {
+	out := new(DrawProof)
+	err := c.cc.Invoke(ctx, "/handler.TournamentService/GetDrawProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentServiceClient) Reconcile(ctx context.Context, in *ReconcileRequest, opts ...grpc.CallOption) (*ReconciliationReport, error) {
+	out := new(ReconciliationReport)
+	err := c.cc.Invoke(ctx, "/handler.TournamentService/Reconcile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TournamentServiceServer is the server API for TournamentService service.
 // All implementations must embed UnimplementedTournamentServiceServer
 // for forward compatibility
@@ -138,11 +371,37 @@ type TournamentServiceServer interface {
 	DeleteUserByID(context.Context, *UserRequest) (*emptypb.Empty, error)
 	SumToBalance(context.Context, *RequestToUpdateBalance) (*emptypb.Empty, error)
 	UserAuthorization(context.Context, *AuthorizationRequest) (*AuthorizationResponse, error)
+	// RefreshSession rotates refresh token and extends its session.
+	RefreshSession(context.Context, *RefreshRequest) (*AuthorizationResponse, error)
+	// Logout revokes session of the caller's token.
+	Logout(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Authenticate returns the caller of the token, once the session of the token is active.
+	Authenticate(context.Context, *emptypb.Empty) (*AuthorizationResponse, error)
+	ChangePassword(context.Context, *ChangePasswordRequest) (*emptypb.Empty, error)
+	RequestPasswordReset(context.Context, *PasswordResetRequest) (*emptypb.Empty, error)
+	ResetPassword(context.Context, *ResetPasswordRequest) (*emptypb.Empty, error)
+	SetUserRole(context.Context, *SetRoleRequest) (*emptypb.Empty, error)
+	ListUserTransactions(context.Context, *UserTransactionsRequest) (*UserTransactionsResponse, error)
+	SetLimit(context.Context, *SetLimitRequest) (*Limit, error)
+	GetLimits(context.Context, *UserRequest) (*LimitsResponse, error)
+	SelfExclude(context.Context, *SelfExcludeRequest) (*emptypb.Empty, error)
 	CreateTournament(context.Context, *CreateTournamentRequest) (*CreateTournamentResponse, error)
 	GetTournamentByID(context.Context, *TournamentRequest) (*Tournament, error)
-	JoinTournament(context.Context, *JoinRequest) (*emptypb.Empty, error)
-	FinishTournament(context.Context, *TournamentRequest) (*emptypb.Empty, error)
+	JoinTournament(context.Context, *JoinRequest) (*JoinResponse, error)
+	LeaveTournament(context.Context, *ParticipantRequest) (*emptypb.Empty, error)
+	RemoveParticipant(context.Context, *ParticipantRequest) (*emptypb.Empty, error)
+	GetWaitlist(context.Context, *TournamentRequest) (*WaitlistResponse, error)
+	FinishTournament(context.Context, *FinishRequest) (*emptypb.Empty, error)
 	CancelTournament(context.Context, *TournamentRequest) (*emptypb.Empty, error)
+	OpenRegistration(context.Context, *TournamentRequest) (*emptypb.Empty, error)
+	CloseRegistration(context.Context, *TournamentRequest) (*emptypb.Empty, error)
+	ReportScore(context.Context, *ScoreRequest) (*emptypb.Empty, error)
+	StartTournament(context.Context, *TournamentRequest) (*emptypb.Empty, error)
+	GetMatches(context.Context, *TournamentRequest) (*MatchesResponse, error)
+	GetStandings(context.Context, *TournamentRequest) (*StandingsResponse, error)
+	ReportMatchResult(context.Context, *MatchResultRequest) (*emptypb.Empty, error)
+	GetDrawProof(context.Context, *TournamentRequest) (*DrawProof, error)
+	Reconcile(context.Context, *ReconcileRequest) (*ReconciliationReport, error)
 	mustEmbedUnimplementedTournamentServiceServer()
 }
 
@@ -165,21 +424,90 @@ func (UnimplementedTournamentServiceServer) SumToBalance(context.Context, *Reque
 func (UnimplementedTournamentServiceServer) UserAuthorization(context.Context, *AuthorizationRequest) (*AuthorizationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserAuthorization not implemented")
 }
+func (UnimplementedTournamentServiceServer) RefreshSession(context.Context, *RefreshRequest) (*AuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshSession not implemented")
+}
+func (UnimplementedTournamentServiceServer) Logout(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedTournamentServiceServer) Authenticate(context.Context, *emptypb.Empty) (*AuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authenticate not implemented")
+}
+func (UnimplementedTournamentServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedTournamentServiceServer) RequestPasswordReset(context.Context, *PasswordResetRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedTournamentServiceServer) ResetPassword(context.Context, *ResetPasswordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedTournamentServiceServer) SetUserRole(context.Context, *SetRoleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserRole not implemented")
+}
+func (UnimplementedTournamentServiceServer) ListUserTransactions(context.Context, *UserTransactionsRequest) (*UserTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserTransactions not implemented")
+}
+func (UnimplementedTournamentServiceServer) SetLimit(context.Context, *SetLimitRequest) (*Limit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLimit not implemented")
+}
+func (UnimplementedTournamentServiceServer) GetLimits(context.Context, *UserRequest) (*LimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLimits not implemented")
+}
+func (UnimplementedTournamentServiceServer) SelfExclude(context.Context, *SelfExcludeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelfExclude not implemented")
+}
 func (UnimplementedTournamentServiceServer) CreateTournament(context.Context, *CreateTournamentRequest) (*CreateTournamentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTournament not implemented")
 }
 func (UnimplementedTournamentServiceServer) GetTournamentByID(context.Context, *TournamentRequest) (*Tournament, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTournamentByID not implemented")
 }
-func (UnimplementedTournamentServiceServer) JoinTournament(context.Context, *JoinRequest) (*emptypb.Empty, error) {
+func (UnimplementedTournamentServiceServer) JoinTournament(context.Context, *JoinRequest) (*JoinResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method JoinTournament not implemented")
 }
-func (UnimplementedTournamentServiceServer) FinishTournament(context.Context, *TournamentRequest) (*emptypb.Empty, error) {
+func (UnimplementedTournamentServiceServer) LeaveTournament(context.Context, *ParticipantRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveTournament not implemented")
+}
+func (UnimplementedTournamentServiceServer) RemoveParticipant(context.Context, *ParticipantRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveParticipant not implemented")
+}
+func (UnimplementedTournamentServiceServer) GetWaitlist(context.Context, *TournamentRequest) (*WaitlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWaitlist not implemented")
+}
+func (UnimplementedTournamentServiceServer) FinishTournament(context.Context, *FinishRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FinishTournament not implemented")
 }
 func (UnimplementedTournamentServiceServer) CancelTournament(context.Context, *TournamentRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelTournament not implemented")
 }
+func (UnimplementedTournamentServiceServer) OpenRegistration(context.Context, *TournamentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenRegistration not implemented")
+}
+func (UnimplementedTournamentServiceServer) CloseRegistration(context.Context, *TournamentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseRegistration not implemented")
+}
+func (UnimplementedTournamentServiceServer) ReportScore(context.Context, *ScoreRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportScore not implemented")
+}
+func (UnimplementedTournamentServiceServer) StartTournament(context.Context, *TournamentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartTournament not implemented")
+}
+func (UnimplementedTournamentServiceServer) GetMatches(context.Context, *TournamentRequest) (*MatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMatches not implemented")
+}
+func (UnimplementedTournamentServiceServer) GetStandings(context.Context, *TournamentRequest) (*StandingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStandings not implemented")
+}
+func (UnimplementedTournamentServiceServer) ReportMatchResult(context.Context, *MatchResultRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportMatchResult not implemented")
+}
+func (UnimplementedTournamentServiceServer) GetDrawProof(context.Context, *TournamentRequest) (*DrawProof, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDrawProof not implemented")
+}
+func (UnimplementedTournamentServiceServer) Reconcile(context.Context, *ReconcileRequest) (*ReconciliationReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reconcile not implemented")
+}
 func (UnimplementedTournamentServiceServer) mustEmbedUnimplementedTournamentServiceServer() {}
 
 // UnsafeTournamentServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -283,142 +611,648 @@ func _TournamentService_UserAuthorization_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TournamentService_CreateTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTournamentRequest)
+func _TournamentService_RefreshSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TournamentServiceServer).CreateTournament(ctx, in)
+		return srv.(TournamentServiceServer).RefreshSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/handler.TournamentService/CreateTournament",
+		FullMethod: "/handler.TournamentService/RefreshSession",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TournamentServiceServer).CreateTournament(ctx, req.(*CreateTournamentRequest))
+		return srv.(TournamentServiceServer).RefreshSession(ctx, req.(*RefreshRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TournamentService_GetTournamentByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TournamentRequest)
+func _TournamentService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TournamentServiceServer).GetTournamentByID(ctx, in)
+		return srv.(TournamentServiceServer).Logout(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/handler.TournamentService/GetTournamentByID",
+		FullMethod: "/handler.TournamentService/Logout",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TournamentServiceServer).GetTournamentByID(ctx, req.(*TournamentRequest))
+		return srv.(TournamentServiceServer).Logout(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TournamentService_JoinTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JoinRequest)
+func _TournamentService_Authenticate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TournamentServiceServer).JoinTournament(ctx, in)
+		return srv.(TournamentServiceServer).Authenticate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/handler.TournamentService/JoinTournament",
+		FullMethod: "/handler.TournamentService/Authenticate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TournamentServiceServer).JoinTournament(ctx, req.(*JoinRequest))
+		return srv.(TournamentServiceServer).Authenticate(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TournamentService_FinishTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TournamentRequest)
+func _TournamentService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TournamentServiceServer).FinishTournament(ctx, in)
+		return srv.(TournamentServiceServer).ChangePassword(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/handler.TournamentService/FinishTournament",
+		FullMethod: "/handler.TournamentService/ChangePassword",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TournamentServiceServer).FinishTournament(ctx, req.(*TournamentRequest))
+		return srv.(TournamentServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TournamentService_CancelTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TournamentRequest)
+func _TournamentService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasswordResetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TournamentServiceServer).CancelTournament(ctx, in)
+		return srv.(TournamentServiceServer).RequestPasswordReset(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/handler.TournamentService/CancelTournament",
+		FullMethod: "/handler.TournamentService/RequestPasswordReset",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TournamentServiceServer).CancelTournament(ctx, req.(*TournamentRequest))
+		return srv.(TournamentServiceServer).RequestPasswordReset(ctx, req.(*PasswordResetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// TournamentService_ServiceDesc is the grpc.ServiceDesc for TournamentService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var TournamentService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "handler.TournamentService",
-	HandlerType: (*TournamentServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SaveUser",
-			Handler:    _TournamentService_SaveUser_Handler,
-		},
-		{
-			MethodName: "GetUserByID",
-			Handler:    _TournamentService_GetUserByID_Handler,
-		},
-		{
-			MethodName: "DeleteUserByID",
-			Handler:    _TournamentService_DeleteUserByID_Handler,
-		},
-		{
-			MethodName: "SumToBalance",
-			Handler:    _TournamentService_SumToBalance_Handler,
-		},
-		{
-			MethodName: "UserAuthorization",
-			Handler:    _TournamentService_UserAuthorization_Handler,
-		},
-		{
-			MethodName: "CreateTournament",
-			Handler:    _TournamentService_CreateTournament_Handler,
-		},
-		{
-			MethodName: "GetTournamentByID",
-			Handler:    _TournamentService_GetTournamentByID_Handler,
-		},
-		{
-			MethodName: "JoinTournament",
-			Handler:    _TournamentService_JoinTournament_Handler,
-		},
-		{
-			MethodName: "FinishTournament",
-			Handler:    _TournamentService_FinishTournament_Handler,
-		},
-		{
-			MethodName: "CancelTournament",
-			Handler:    _TournamentService_CancelTournament_Handler,
+func _TournamentService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.TournamentService/ResetPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_SetUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).SetUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.TournamentService/SetUserRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).SetUserRole(ctx, req.(*SetRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_ListUserTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).ListUserTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.TournamentService/ListUserTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).ListUserTransactions(ctx, req.(*UserTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_SetLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).SetLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.TournamentService/SetLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).SetLimit(ctx, req.(*SetLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_GetLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).GetLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.TournamentService/GetLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).GetLimits(ctx, req.(*UserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_SelfExclude_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelfExcludeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).SelfExclude(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.TournamentService/SelfExclude",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).SelfExclude(ctx, req.(*SelfExcludeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_CreateTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).CreateTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.TournamentService/CreateTournament",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).CreateTournament(ctx, req.(*CreateTournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_GetTournamentByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).GetTournamentByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.TournamentService/GetTournamentByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).GetTournamentByID(ctx, req.(*TournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_JoinTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).JoinTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.TournamentService/JoinTournament",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).JoinTournament(ctx, req.(*JoinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_LeaveTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParticipantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).LeaveTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.TournamentService/LeaveTournament",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).LeaveTournament(ctx, req.(*ParticipantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_RemoveParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParticipantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).RemoveParticipant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.TournamentService/RemoveParticipant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).RemoveParticipant(ctx, req.(*ParticipantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_GetWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).GetWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.TournamentService/GetWaitlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).GetWaitlist(ctx, req.(*TournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_FinishTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).FinishTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.TournamentService/FinishTournament",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).FinishTournament(ctx, req.(*FinishRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_CancelTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).CancelTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.TournamentService/CancelTournament",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).CancelTournament(ctx, req.(*TournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_OpenRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).OpenRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.TournamentService/OpenRegistration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).OpenRegistration(ctx, req.(*TournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_CloseRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).CloseRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.TournamentService/CloseRegistration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).CloseRegistration(ctx, req.(*TournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_ReportScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).ReportScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.TournamentService/ReportScore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).ReportScore(ctx, req.(*ScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_StartTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).StartTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.TournamentService/StartTournament",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).StartTournament(ctx, req.(*TournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_GetMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).GetMatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.TournamentService/GetMatches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).GetMatches(ctx, req.(*TournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_GetStandings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).GetStandings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.TournamentService/GetStandings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).GetStandings(ctx, req.(*TournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_ReportMatchResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).ReportMatchResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.TournamentService/ReportMatchResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).ReportMatchResult(ctx, req.(*MatchResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_GetDrawProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).GetDrawProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.TournamentService/GetDrawProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).GetDrawProof(ctx, req.(*TournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentService_Reconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentServiceServer).Reconcile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.TournamentService/Reconcile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentServiceServer).Reconcile(ctx, req.(*ReconcileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TournamentService_ServiceDesc is the grpc.ServiceDesc for TournamentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TournamentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "handler.TournamentService",
+	HandlerType: (*TournamentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SaveUser",
+			Handler:    _TournamentService_SaveUser_Handler,
+		},
+		{
+			MethodName: "GetUserByID",
+			Handler:    _TournamentService_GetUserByID_Handler,
+		},
+		{
+			MethodName: "DeleteUserByID",
+			Handler:    _TournamentService_DeleteUserByID_Handler,
+		},
+		{
+			MethodName: "SumToBalance",
+			Handler:    _TournamentService_SumToBalance_Handler,
+		},
+		{
+			MethodName: "UserAuthorization",
+			Handler:    _TournamentService_UserAuthorization_Handler,
+		},
+		{
+			MethodName: "RefreshSession",
+			Handler:    _TournamentService_RefreshSession_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _TournamentService_Logout_Handler,
+		},
+		{
+			MethodName: "Authenticate",
+			Handler:    _TournamentService_Authenticate_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _TournamentService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _TournamentService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _TournamentService_ResetPassword_Handler,
+		},
+		{
+			MethodName: "SetUserRole",
+			Handler:    _TournamentService_SetUserRole_Handler,
+		},
+		{
+			MethodName: "ListUserTransactions",
+			Handler:    _TournamentService_ListUserTransactions_Handler,
+		},
+		{
+			MethodName: "SetLimit",
+			Handler:    _TournamentService_SetLimit_Handler,
+		},
+		{
+			MethodName: "GetLimits",
+			Handler:    _TournamentService_GetLimits_Handler,
+		},
+		{
+			MethodName: "SelfExclude",
+			Handler:    _TournamentService_SelfExclude_Handler,
+		},
+		{
+			MethodName: "CreateTournament",
+			Handler:    _TournamentService_CreateTournament_Handler,
+		},
+		{
+			MethodName: "GetTournamentByID",
+			Handler:    _TournamentService_GetTournamentByID_Handler,
+		},
+		{
+			MethodName: "JoinTournament",
+			Handler:    _TournamentService_JoinTournament_Handler,
+		},
+		{
+			MethodName: "LeaveTournament",
+			Handler:    _TournamentService_LeaveTournament_Handler,
+		},
+		{
+			MethodName: "RemoveParticipant",
+			Handler:    _TournamentService_RemoveParticipant_Handler,
+		},
+		{
+			MethodName: "GetWaitlist",
+			Handler:    _TournamentService_GetWaitlist_Handler,
+		},
+		{
+			MethodName: "FinishTournament",
+			Handler:    _TournamentService_FinishTournament_Handler,
+		},
+		{
+			MethodName: "CancelTournament",
+			Handler:    _TournamentService_CancelTournament_Handler,
+		},
+		{
+			MethodName: "OpenRegistration",
+			Handler:    _TournamentService_OpenRegistration_Handler,
+		},
+		{
+			MethodName: "CloseRegistration",
+			Handler:    _TournamentService_CloseRegistration_Handler,
+		},
+		{
+			MethodName: "ReportScore",
+			Handler:    _TournamentService_ReportScore_Handler,
+		},
+		{
+			MethodName: "StartTournament",
+			Handler:    _TournamentService_StartTournament_Handler,
+		},
+		{
+			MethodName: "GetMatches",
+			Handler:    _TournamentService_GetMatches_Handler,
+		},
+		{
+			MethodName: "GetStandings",
+			Handler:    _TournamentService_GetStandings_Handler,
+		},
+		{
+			MethodName: "ReportMatchResult",
+			Handler:    _TournamentService_ReportMatchResult_Handler,
+		},
+		{
+			MethodName: "GetDrawProof",
+			Handler:    _TournamentService_GetDrawProof_Handler,
+		},
+		{
+			MethodName: "Reconcile",
+			Handler:    _TournamentService_Reconcile_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
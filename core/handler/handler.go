@@ -10,11 +10,13 @@ type ServiceHandler struct {
 
 	userController       controller.UserController
 	tournamentController controller.TournamentController
+	reconciliation       controller.ReconciliationController
 }
 
-func NewServiceHandler(user controller.UserController, tournament controller.TournamentController) *ServiceHandler {
+func NewServiceHandler(user controller.UserController, tournament controller.TournamentController, reconciliation controller.ReconciliationController) *ServiceHandler {
 	return &ServiceHandler{
 		userController:       user,
 		tournamentController: tournament,
+		reconciliation:       reconciliation,
 	}
 }
@@ -0,0 +1,24 @@
+package handler
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// idempotencyKeyMetadata is the gRPC metadata, which carries idempotency key of request.
+const idempotencyKeyMetadata = "idempotency-key"
+
+// idempotencyKeyFromContext returns idempotency key of incoming request or empty string, when client didn't send it.
+func idempotencyKeyFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	if keys := md.Get(idempotencyKeyMetadata); len(keys) > 0 {
+		return keys[0]
+	}
+
+	return ""
+}
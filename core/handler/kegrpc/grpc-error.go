@@ -17,6 +17,12 @@ var keToGrpcDict = map[kerror.StatusCode]codes.Code{
 	kerror.NotFound:                    codes.NotFound,
 	kerror.TournamentDoesntExists:      codes.NotFound,
 	kerror.UserDoesntExists:            codes.NotFound,
+	kerror.TournamentIsFull:            codes.ResourceExhausted,
+	kerror.AlreadyJoined:               codes.AlreadyExists,
+	kerror.LimitExceeded:               codes.PermissionDenied,
+	kerror.IncorrectPassword:           codes.Unauthenticated,
+	kerror.Unauthenticated:             codes.Unauthenticated,
+	kerror.Forbidden:                   codes.PermissionDenied,
 	kerror.SQLConstraintError:          codes.FailedPrecondition,
 	kerror.SQLQueryError:               codes.Internal,
 	kerror.SQLPrepareStatementError:    codes.Internal,
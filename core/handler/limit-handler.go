@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/kerror"
+	ttgrpc "github.com/kimbellG/tournament/core/handler/grpc"
+	"github.com/kimbellG/tournament/core/models"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (sc *ServiceHandler) SetLimit(ctx context.Context, r *ttgrpc.SetLimitRequest) (*ttgrpc.Limit, error) {
+	id, err := uuid.Parse(r.GetUserID())
+	if err != nil {
+		return nil, kerror.Newf(kerror.InvalidID, "parsing user id: %w", err)
+	}
+
+	if err := authorizeOwner(ctx, id); err != nil {
+		return nil, kerror.Errorf(err, "authorize caller")
+	}
+
+	limit, err := sc.userController.SetLimit(ctx, &models.Limit{
+		UserID:   id,
+		Kind:     models.LimitKind(titleCase(r.GetKind())),
+		Period:   models.LimitPeriod(titleCase(r.GetPeriod())),
+		Currency: currencyFromProto(r.GetCurrency()),
+		Amount:   moneyFromProto(r.GetAmount(), 0),
+	})
+	if err != nil {
+		return nil, kerror.Errorf(err, "controller")
+	}
+
+	return limitToProto(limit), nil
+}
+
+// titleCase lets clients name kinds and periods of limits regardless of case.
+func titleCase(name string) string {
+	if name == "" {
+		return name
+	}
+
+	return strings.ToUpper(name[:1]) + strings.ToLower(name[1:])
+}
+
+func limitToProto(limit *models.Limit) *ttgrpc.Limit {
+	protoLimit := &ttgrpc.Limit{
+		Kind:        string(limit.Kind),
+		Period:      string(limit.Period),
+		Currency:    string(limit.Currency),
+		Amount:      moneyToProto(limit.Amount),
+		PendingFrom: timeToProto(limit.PendingFrom),
+	}
+
+	if !limit.PendingFrom.IsZero() {
+		protoLimit.PendingAmount = moneyToProto(limit.PendingAmount)
+	}
+
+	return protoLimit
+}
+
+func (sc *ServiceHandler) GetLimits(ctx context.Context, r *ttgrpc.UserRequest) (*ttgrpc.LimitsResponse, error) {
+	id, err := userIDFromProto(r)
+	if err != nil {
+		return nil, kerror.Errorf(err, "marshaling id from request")
+	}
+
+	if err := authorizeOwner(ctx, id); err != nil {
+		return nil, kerror.Errorf(err, "authorize caller")
+	}
+
+	limits, err := sc.userController.GetLimits(ctx, id)
+	if err != nil {
+		return nil, kerror.Errorf(err, "controller")
+	}
+
+	protoLimits := make([]*ttgrpc.Limit, 0, len(limits.Limits))
+	for i := range limits.Limits {
+		protoLimits = append(protoLimits, limitToProto(&limits.Limits[i]))
+	}
+
+	return &ttgrpc.LimitsResponse{
+		Limits:        protoLimits,
+		ExcludedUntil: timeToProto(limits.ExcludedUntil),
+	}, nil
+}
+
+func (sc *ServiceHandler) SelfExclude(ctx context.Context, r *ttgrpc.SelfExcludeRequest) (*emptypb.Empty, error) {
+	id, err := uuid.Parse(r.GetUserID())
+	if err != nil {
+		return nil, kerror.Newf(kerror.InvalidID, "parsing user id: %w", err)
+	}
+
+	if err := authorizeOwner(ctx, id); err != nil {
+		return nil, kerror.Errorf(err, "authorize caller")
+	}
+
+	if err := sc.userController.SelfExclude(ctx, id, timeFromProto(r.GetUntil())); err != nil {
+		return nil, kerror.Errorf(err, "controller")
+	}
+
+	return &emptypb.Empty{}, nil
+}
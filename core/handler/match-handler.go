@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/kerror"
+	ttgrpc "github.com/kimbellG/tournament/core/handler/grpc"
+	"github.com/kimbellG/tournament/core/models"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (sh *ServiceHandler) StartTournament(ctx context.Context, r *ttgrpc.TournamentRequest) (*emptypb.Empty, error) {
+	id, err := uuid.Parse(r.GetId())
+	if err != nil {
+		return nil, kerror.Newf(kerror.InvalidID, "parsing tournament id: %w", err)
+	}
+
+	if err := sh.tournamentController.Start(ctx, id); err != nil {
+		return nil, kerror.Errorf(err, "controller")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (sh *ServiceHandler) GetMatches(ctx context.Context, r *ttgrpc.TournamentRequest) (*ttgrpc.MatchesResponse, error) {
+	id, err := uuid.Parse(r.GetId())
+	if err != nil {
+		return nil, kerror.Newf(kerror.InvalidID, "parsing tournament id: %w", err)
+	}
+
+	matches, err := sh.tournamentController.GetMatches(ctx, id)
+	if err != nil {
+		return nil, kerror.Errorf(err, "controller")
+	}
+
+	return &ttgrpc.MatchesResponse{
+		Matches: matchesToProto(matches),
+	}, nil
+}
+
+func matchesToProto(matches []models.Match) []*ttgrpc.Match {
+	protoMatches := make([]*ttgrpc.Match, 0, len(matches))
+	for _, match := range matches {
+		protoMatches = append(protoMatches, &ttgrpc.Match{
+			Id:         match.ID.String(),
+			Round:      int32(match.Round),
+			Position:   int32(match.Position),
+			FirstUser:  match.FirstUser.String(),
+			SecondUser: match.SecondUser.String(),
+			Winner:     match.Winner.String(),
+			Draw:       match.Draw,
+		})
+	}
+
+	return protoMatches
+}
+
+func (sh *ServiceHandler) GetStandings(ctx context.Context, r *ttgrpc.TournamentRequest) (*ttgrpc.StandingsResponse, error) {
+	id, err := uuid.Parse(r.GetId())
+	if err != nil {
+		return nil, kerror.Newf(kerror.InvalidID, "parsing tournament id: %w", err)
+	}
+
+	standings, err := sh.tournamentController.GetStandings(ctx, id)
+	if err != nil {
+		return nil, kerror.Errorf(err, "controller")
+	}
+
+	return &ttgrpc.StandingsResponse{
+		Standings: standingsToProto(standings),
+	}, nil
+}
+
+func standingsToProto(standings []models.Standing) []*ttgrpc.Standing {
+	protoStandings := make([]*ttgrpc.Standing, 0, len(standings))
+	for _, standing := range standings {
+		protoStandings = append(protoStandings, &ttgrpc.Standing{
+			UserID:     standing.UserID.String(),
+			Played:     int32(standing.Played),
+			Wins:       int32(standing.Wins),
+			Draws:      int32(standing.Draws),
+			Losses:     int32(standing.Losses),
+			Points:     standing.Points,
+			Buchholz:   standing.Buchholz,
+			HeadToHead: standing.HeadToHead,
+		})
+	}
+
+	return protoStandings
+}
+
+func (sh *ServiceHandler) ReportMatchResult(ctx context.Context, r *ttgrpc.MatchResultRequest) (*emptypb.Empty, error) {
+	tournament, err := uuid.Parse(r.GetTournamentID())
+	if err != nil {
+		return nil, kerror.Newf(kerror.InvalidID, "parsing tournament id: %w", err)
+	}
+
+	match, err := uuid.Parse(r.GetMatchID())
+	if err != nil {
+		return nil, kerror.Newf(kerror.InvalidID, "parsing match id: %w", err)
+	}
+
+	var winner uuid.UUID
+	if !r.GetDraw() {
+		winner, err = uuid.Parse(r.GetWinnerID())
+		if err != nil {
+			return nil, kerror.Newf(kerror.InvalidID, "parsing winner id: %w", err)
+		}
+	}
+
+	if err := sh.tournamentController.ReportMatchResult(ctx, tournament, match, winner, r.GetDraw()); err != nil {
+		return nil, kerror.Errorf(err, "controller")
+	}
+
+	return &emptypb.Empty{}, nil
+}
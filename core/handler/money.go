@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"math"
+	"strings"
+
+	ttgrpc "github.com/kimbellG/tournament/core/handler/grpc"
+	"github.com/kimbellG/tournament/core/models"
+)
+
+const (
+	centsInUnit = 100
+	nanosInCent = 10000000
+)
+
+// moneyFromProto prefers exact amount and falls back to the double field of clients that don't send it.
+func moneyFromProto(money *ttgrpc.Money, legacy float64) models.Money {
+	if money == nil {
+		return models.MoneyFromFloat(legacy)
+	}
+
+	cents := float64(money.GetNanos()) / nanosInCent
+	return models.Cents(money.GetUnits()*centsInUnit + int64(math.Round(cents)))
+}
+
+func moneyToProto(money models.Money) *ttgrpc.Money {
+	return &ttgrpc.Money{
+		Units: money.Cents() / centsInUnit,
+		Nanos: int32(money.Cents()%centsInUnit) * nanosInCent,
+	}
+}
+
+// currencyFromProto reads currency code regardless of case. Clients that don't name currency use the default one.
+func currencyFromProto(code string) models.Currency {
+	if code == "" {
+		return models.DefaultCurrency
+	}
+
+	return models.Currency(strings.ToUpper(code))
+}
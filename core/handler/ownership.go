@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/core/interceptor"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/sirupsen/logrus"
+)
+
+// authorizeOwner lets callers act only on their own account. Admins act on any account.
+// Denied attempts are logged.
+func authorizeOwner(ctx context.Context, userID uuid.UUID) error {
+	claims, ok := interceptor.ClaimsFromContext(ctx)
+	if !ok {
+		return kerror.Newf(kerror.Unauthenticated, "caller isn't authenticated")
+	}
+
+	if claims.Role == models.AdminRole || claims.ID == userID.String() {
+		return nil
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"caller": claims.ID,
+		"role":   claims.Role,
+		"user":   userID,
+	}).Warn("denied access to account of another user")
+
+	return kerror.Newf(kerror.Forbidden, "user(%s) isn't allowed to act on account of user(%v)", claims.ID, userID)
+}
+
+// userIDOrCaller parses id of user the request is made for. Request without it is made for the caller.
+func userIDOrCaller(ctx context.Context, id string) (uuid.UUID, error) {
+	if id == "" {
+		if claims, ok := interceptor.ClaimsFromContext(ctx); ok {
+			id = claims.ID
+		}
+	}
+
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, kerror.Newf(kerror.InvalidID, "parse id: %v", err)
+	}
+
+	return parsed, nil
+}
@@ -0,0 +1,66 @@
+package handler
+
+import (
+	ttgrpc "github.com/kimbellG/tournament/core/handler/grpc"
+	"github.com/kimbellG/tournament/core/interceptor"
+	"github.com/kimbellG/tournament/core/models"
+)
+
+var (
+	allRoles      = []models.Role{models.AdminRole, models.OrganizerRole, models.PlayerRole}
+	playerRoles   = []models.Role{models.AdminRole, models.PlayerRole}
+	organizerRole = []models.Role{models.AdminRole, models.OrganizerRole}
+	adminRole     = []models.Role{models.AdminRole}
+)
+
+// AccessPolicy names the roles allowed to call methods of the service. It's the same policy the gateway
+// applies to its routes, so callers of the service directly aren't allowed more.
+func AccessPolicy() *interceptor.Policy {
+	return &interceptor.Policy{
+		Public: map[string]bool{
+			method("SaveUser"):             true,
+			method("UserAuthorization"):    true,
+			method("RefreshSession"):       true,
+			method("RequestPasswordReset"): true,
+			method("ResetPassword"):        true,
+		},
+		Roles: map[string][]models.Role{
+			method("Logout"):               allRoles,
+			method("Authenticate"):         allRoles,
+			method("GetUserByID"):          allRoles,
+			method("ChangePassword"):       allRoles,
+			method("DeleteUserByID"):       playerRoles,
+			method("SumToBalance"):         playerRoles,
+			method("ListUserTransactions"): playerRoles,
+			method("SetLimit"):             playerRoles,
+			method("GetLimits"):            playerRoles,
+			method("SelfExclude"):          playerRoles,
+			method("SetUserRole"):          adminRole,
+
+			method("CreateTournament"):  organizerRole,
+			method("GetTournamentByID"): allRoles,
+			method("JoinTournament"):    playerRoles,
+			method("LeaveTournament"):   playerRoles,
+			method("RemoveParticipant"): organizerRole,
+			method("GetWaitlist"):       allRoles,
+			method("FinishTournament"):  organizerRole,
+			method("CancelTournament"):  organizerRole,
+			method("OpenRegistration"):  organizerRole,
+			method("CloseRegistration"): organizerRole,
+			method("ReportScore"):       organizerRole,
+
+			method("StartTournament"):   organizerRole,
+			method("GetMatches"):        allRoles,
+			method("GetStandings"):      allRoles,
+			method("ReportMatchResult"): organizerRole,
+
+			method("GetDrawProof"): allRoles,
+
+			method("Reconcile"): adminRole,
+		},
+	}
+}
+
+func method(name string) string {
+	return "/" + ttgrpc.TournamentService_ServiceDesc.ServiceName + "/" + name
+}
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/kerror"
+	ttgrpc "github.com/kimbellG/tournament/core/handler/grpc"
+	"github.com/kimbellG/tournament/core/models"
+)
+
+func (sc *ServiceHandler) Reconcile(ctx context.Context, r *ttgrpc.ReconcileRequest) (*ttgrpc.ReconciliationReport, error) {
+	report, err := sc.reconciliation.Reconcile(ctx, r.GetCorrect())
+	if err != nil {
+		return nil, kerror.Errorf(err, "reconcile balances")
+	}
+
+	discrepancies := make([]*ttgrpc.Discrepancy, 0, len(report.Discrepancies))
+	for _, discrepancy := range report.Discrepancies {
+		discrepancies = append(discrepancies, discrepancyToProto(discrepancy))
+	}
+
+	return &ttgrpc.ReconciliationReport{
+		CheckedAt:     timeToProto(report.CheckedAt),
+		Discrepancies: discrepancies,
+	}, nil
+}
+
+func discrepancyToProto(discrepancy models.Discrepancy) *ttgrpc.Discrepancy {
+	var userID, tournamentID string
+	if discrepancy.UserID != uuid.Nil {
+		userID = discrepancy.UserID.String()
+	}
+	if discrepancy.TournamentID != uuid.Nil {
+		tournamentID = discrepancy.TournamentID.String()
+	}
+
+	return &ttgrpc.Discrepancy{
+		Kind:         string(discrepancy.Kind),
+		UserID:       userID,
+		TournamentID: tournamentID,
+		Currency:     string(discrepancy.Currency),
+		Recorded:     moneyToProto(discrepancy.Recorded),
+		Expected:     moneyToProto(discrepancy.Expected),
+		Corrected:    discrepancy.Corrected,
+	}
+}
@@ -2,12 +2,15 @@ package handler
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/core/controller"
 	ttgrpc "github.com/kimbellG/tournament/core/handler/grpc"
 	"github.com/kimbellG/tournament/core/models"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (sh *ServiceHandler) CreateTournament(ctx context.Context, r *ttgrpc.CreateTournamentRequest) (*ttgrpc.CreateTournamentResponse, error) {
@@ -22,10 +25,73 @@ func (sh *ServiceHandler) CreateTournament(ctx context.Context, r *ttgrpc.Create
 }
 
 func tournamentFromProto(protoTournament *ttgrpc.CreateTournamentRequest) *models.Tournament {
-	return &models.Tournament{
-		Name:    protoTournament.GetName(),
-		Deposit: protoTournament.GetDeposit(),
+	status := models.RegistrationOpen
+	if protoTournament.GetDraft() {
+		status = models.Draft
 	}
+
+	tournament := &models.Tournament{
+		Status:         status,
+		Name:           protoTournament.GetName(),
+		Currency:       currencyFromProto(protoTournament.GetCurrency()),
+		Deposit:        moneyFromProto(protoTournament.GetDepositMoney(), protoTournament.GetDeposit()),
+		Format:         models.TournamentFormat(protoTournament.GetFormat()),
+		Rounds:         int(protoTournament.GetRounds()),
+		Tiebreakers:    tiebreakersFromProto(protoTournament.GetTiebreakers()),
+		WinnerStrategy: models.WinnerStrategy(protoTournament.GetWinnerStrategy()),
+		PayoutType:     models.PayoutType(protoTournament.GetPayoutType()),
+		RakeType:       models.RakeType(protoTournament.GetRakeType()),
+		Schedule: models.Schedule{
+			RegistrationOpensAt:  timeFromProto(protoTournament.GetRegistrationOpensAt()),
+			RegistrationClosesAt: timeFromProto(protoTournament.GetRegistrationClosesAt()),
+			StartTime:            timeFromProto(protoTournament.GetStartTime()),
+			FinishDeadline:       timeFromProto(protoTournament.GetFinishDeadline()),
+		},
+		MinPlayers:        int(protoTournament.GetMinPlayers()),
+		MaxPlayers:        int(protoTournament.GetMaxPlayers()),
+		WithdrawalPenalty: models.PercentFromFloat(protoTournament.GetWithdrawalPenalty()),
+	}
+
+	for _, share := range protoTournament.GetPayouts() {
+		if tournament.PayoutType == models.FixedPayout {
+			tournament.PayoutAmounts = append(tournament.PayoutAmounts, models.MoneyFromFloat(share))
+		} else {
+			tournament.Payouts = append(tournament.Payouts, models.PercentFromFloat(share))
+		}
+	}
+
+	if tournament.RakeType == models.FixedRake {
+		tournament.RakeAmount = models.MoneyFromFloat(protoTournament.GetRake())
+	} else {
+		tournament.Rake = models.PercentFromFloat(protoTournament.GetRake())
+	}
+
+	return tournament
+}
+
+func timeFromProto(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+
+	return ts.AsTime()
+}
+
+func timeToProto(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
+
+func tiebreakersFromProto(names []string) []models.Tiebreaker {
+	var tiebreakers []models.Tiebreaker
+	for _, name := range names {
+		tiebreakers = append(tiebreakers, models.Tiebreaker(name))
+	}
+
+	return tiebreakers
 }
 
 func (sh *ServiceHandler) GetTournamentByID(ctx context.Context, r *ttgrpc.TournamentRequest) (*ttgrpc.Tournament, error) {
@@ -44,16 +110,88 @@ func (sh *ServiceHandler) GetTournamentByID(ctx context.Context, r *ttgrpc.Tourn
 
 func tournamentToProto(tournament *models.Tournament) *ttgrpc.Tournament {
 	return &ttgrpc.Tournament{
-		Id:      tournament.ID.String(),
-		Name:    tournament.Name,
-		Deposit: tournament.Deposit,
-		Prize:   tournament.Prize,
-		Users:   uuidOfUsersToStringSlice(tournament.Users),
-		Winner:  tournament.Winner.String(),
-		Status:  string(tournament.Status),
+		Id:                   tournament.ID.String(),
+		Name:                 tournament.Name,
+		Currency:             string(tournament.Currency),
+		Deposit:              tournament.Deposit.Float64(),
+		DepositMoney:         moneyToProto(tournament.Deposit),
+		Prize:                tournament.Prize.Float64(),
+		PrizeMoney:           moneyToProto(tournament.Prize),
+		Users:                uuidOfUsersToStringSlice(tournament.Users),
+		Winner:               tournament.Winner.String(),
+		Status:               string(tournament.Status),
+		Format:               string(tournament.Format),
+		Rounds:               int32(tournament.Rounds),
+		Tiebreakers:          tiebreakersToProto(tournament.Tiebreakers),
+		WinnerStrategy:       string(tournament.WinnerStrategy),
+		ServerSeedHash:       tournament.ServerSeedHash,
+		PayoutType:           string(tournament.PayoutType),
+		Payouts:              payoutsToProto(tournament),
+		Placements:           placementsToProto(tournament.Placements),
+		RakeType:             string(tournament.RakeType),
+		Rake:                 rakeToProto(tournament),
+		GrossEntries:         tournament.GrossEntries().Float64(),
+		GrossEntriesMoney:    moneyToProto(tournament.GrossEntries()),
+		RakeCollected:        tournament.RakeCollected.Float64(),
+		RakeCollectedMoney:   moneyToProto(tournament.RakeCollected),
+		RegistrationOpensAt:  timeToProto(tournament.Schedule.RegistrationOpensAt),
+		RegistrationClosesAt: timeToProto(tournament.Schedule.RegistrationClosesAt),
+		StartTime:            timeToProto(tournament.Schedule.StartTime),
+		FinishDeadline:       timeToProto(tournament.Schedule.FinishDeadline),
+		MinPlayers:           int32(tournament.MinPlayers),
+		MaxPlayers:           int32(tournament.MaxPlayers),
+		WithdrawalPenalty:    tournament.WithdrawalPenalty.Float64(),
 	}
 }
 
+func payoutsToProto(tournament *models.Tournament) []float64 {
+	payouts := make([]float64, 0, tournament.Places())
+	if tournament.PayoutType == models.FixedPayout {
+		for _, prize := range tournament.PayoutAmounts {
+			payouts = append(payouts, prize.Float64())
+		}
+
+		return payouts
+	}
+
+	for _, share := range tournament.Payouts {
+		payouts = append(payouts, share.Float64())
+	}
+
+	return payouts
+}
+
+func rakeToProto(tournament *models.Tournament) float64 {
+	if tournament.RakeType == models.FixedRake {
+		return tournament.RakeAmount.Float64()
+	}
+
+	return tournament.Rake.Float64()
+}
+
+func placementsToProto(placements []models.Placement) []*ttgrpc.Placement {
+	var protoPlacements []*ttgrpc.Placement
+	for _, placement := range placements {
+		protoPlacements = append(protoPlacements, &ttgrpc.Placement{
+			Place:      int32(placement.Place),
+			UserID:     placement.UserID.String(),
+			Prize:      placement.Prize.Float64(),
+			PrizeMoney: moneyToProto(placement.Prize),
+		})
+	}
+
+	return protoPlacements
+}
+
+func tiebreakersToProto(tiebreakers []models.Tiebreaker) []string {
+	var names []string
+	for _, tb := range tiebreakers {
+		names = append(names, string(tb))
+	}
+
+	return names
+}
+
 func uuidOfUsersToStringSlice(users []models.User) []string {
 	var uuidStrings []string
 	for _, user := range users {
@@ -63,7 +201,62 @@ func uuidOfUsersToStringSlice(users []models.User) []string {
 	return uuidStrings
 }
 
-func (sh *ServiceHandler) JoinTournament(ctx context.Context, r *ttgrpc.JoinRequest) (*emptypb.Empty, error) {
+func (sh *ServiceHandler) JoinTournament(ctx context.Context, r *ttgrpc.JoinRequest) (*ttgrpc.JoinResponse, error) {
+	tournament, err := uuid.Parse(r.GetTournamentID())
+	if err != nil {
+		return nil, kerror.Newf(kerror.InvalidID, "parsing tournament id: %w", err)
+	}
+
+	user, err := userIDOrCaller(ctx, r.GetUserID())
+	if err != nil {
+		return nil, kerror.Errorf(err, "parsing user id")
+	}
+
+	if err := authorizeOwner(ctx, user); err != nil {
+		return nil, kerror.Errorf(err, "authorize caller")
+	}
+
+	input := &controller.JoinInput{
+		Stake:          moneyFromProto(r.GetStakeMoney(), r.GetStake()),
+		ClientSeed:     r.GetClientSeed(),
+		Waitlist:       r.GetWaitlist(),
+		IdempotencyKey: idempotencyKeyFromContext(ctx),
+	}
+
+	result, err := sh.tournamentController.Join(ctx, tournament, user, input)
+	if err != nil {
+		return nil, kerror.Errorf(err, "controller")
+	}
+
+	return &ttgrpc.JoinResponse{
+		Waitlisted: result.Waitlisted,
+		Position:   int32(result.Position),
+	}, nil
+}
+
+func (sh *ServiceHandler) LeaveTournament(ctx context.Context, r *ttgrpc.ParticipantRequest) (*emptypb.Empty, error) {
+	tournament, err := uuid.Parse(r.GetTournamentID())
+	if err != nil {
+		return nil, kerror.Newf(kerror.InvalidID, "parsing tournament id: %w", err)
+	}
+
+	user, err := userIDOrCaller(ctx, r.GetUserID())
+	if err != nil {
+		return nil, kerror.Errorf(err, "parsing user id")
+	}
+
+	if err := authorizeOwner(ctx, user); err != nil {
+		return nil, kerror.Errorf(err, "authorize caller")
+	}
+
+	if err := sh.tournamentController.Leave(ctx, tournament, user); err != nil {
+		return nil, kerror.Errorf(err, "controller")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (sh *ServiceHandler) RemoveParticipant(ctx context.Context, r *ttgrpc.ParticipantRequest) (*emptypb.Empty, error) {
 	tournament, err := uuid.Parse(r.GetTournamentID())
 	if err != nil {
 		return nil, kerror.Newf(kerror.InvalidID, "parsing tournament id: %w", err)
@@ -74,26 +267,74 @@ func (sh *ServiceHandler) JoinTournament(ctx context.Context, r *ttgrpc.JoinRequ
 		return nil, kerror.Newf(kerror.InvalidID, "parsing user id: %w", err)
 	}
 
-	if err := sh.tournamentController.Join(ctx, tournament, user); err != nil {
+	if err := sh.tournamentController.RemoveParticipant(ctx, tournament, user); err != nil {
 		return nil, kerror.Errorf(err, "controller")
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
-func (sh *ServiceHandler) FinishTournament(ctx context.Context, r *ttgrpc.TournamentRequest) (*emptypb.Empty, error) {
+func (sh *ServiceHandler) GetWaitlist(ctx context.Context, r *ttgrpc.TournamentRequest) (*ttgrpc.WaitlistResponse, error) {
+	id, err := uuid.Parse(r.GetId())
+	if err != nil {
+		return nil, kerror.Newf(kerror.InvalidID, "parsing tournament id: %w", err)
+	}
+
+	waitlist, err := sh.tournamentController.GetWaitlist(ctx, id)
+	if err != nil {
+		return nil, kerror.Errorf(err, "controller")
+	}
+
+	entries := make([]*ttgrpc.WaitlistEntry, 0, len(waitlist))
+	for _, entry := range waitlist {
+		entries = append(entries, &ttgrpc.WaitlistEntry{
+			UserID:     entry.UserID.String(),
+			Stake:      entry.Stake.Float64(),
+			StakeMoney: moneyToProto(entry.Stake),
+			Position:   int32(entry.Position),
+		})
+	}
+
+	return &ttgrpc.WaitlistResponse{Entries: entries}, nil
+}
+
+func (sh *ServiceHandler) FinishTournament(ctx context.Context, r *ttgrpc.FinishRequest) (*emptypb.Empty, error) {
 	id, err := uuid.Parse(r.GetId())
 	if err != nil {
 		return nil, kerror.Newf(kerror.InvalidID, "parsing tournament id: %w", err)
 	}
 
-	if err := sh.tournamentController.Finish(ctx, id); err != nil {
+	input, err := finishInputFromProto(r)
+	if err != nil {
+		return nil, kerror.Errorf(err, "marshaling finish input")
+	}
+
+	if err := sh.tournamentController.Finish(ctx, id, input); err != nil {
 		return nil, kerror.Errorf(err, "controller")
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
+func finishInputFromProto(r *ttgrpc.FinishRequest) (*controller.FinishInput, error) {
+	input := &controller.FinishInput{}
+
+	ranking := r.GetRanking()
+	if len(ranking) == 0 && r.GetWinnerID() != "" {
+		ranking = []string{r.GetWinnerID()}
+	}
+
+	for _, userID := range ranking {
+		id, err := uuid.Parse(userID)
+		if err != nil {
+			return nil, kerror.Newf(kerror.InvalidID, "parsing id of ranked user: %w", err)
+		}
+		input.Ranking = append(input.Ranking, id)
+	}
+
+	return input, nil
+}
+
 func (sh *ServiceHandler) CancelTournament(ctx context.Context, r *ttgrpc.TournamentRequest) (*emptypb.Empty, error) {
 	id, err := uuid.Parse(r.GetId())
 	if err != nil {
@@ -106,3 +347,81 @@ func (sh *ServiceHandler) CancelTournament(ctx context.Context, r *ttgrpc.Tourna
 
 	return &emptypb.Empty{}, nil
 }
+
+func (sh *ServiceHandler) OpenRegistration(ctx context.Context, r *ttgrpc.TournamentRequest) (*emptypb.Empty, error) {
+	id, err := uuid.Parse(r.GetId())
+	if err != nil {
+		return nil, kerror.Newf(kerror.InvalidID, "parsing tournament id: %w", err)
+	}
+
+	if err := sh.tournamentController.OpenRegistration(ctx, id); err != nil {
+		return nil, kerror.Errorf(err, "controller")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (sh *ServiceHandler) CloseRegistration(ctx context.Context, r *ttgrpc.TournamentRequest) (*emptypb.Empty, error) {
+	id, err := uuid.Parse(r.GetId())
+	if err != nil {
+		return nil, kerror.Newf(kerror.InvalidID, "parsing tournament id: %w", err)
+	}
+
+	if err := sh.tournamentController.CloseRegistration(ctx, id); err != nil {
+		return nil, kerror.Errorf(err, "controller")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (sh *ServiceHandler) ReportScore(ctx context.Context, r *ttgrpc.ScoreRequest) (*emptypb.Empty, error) {
+	tournament, err := uuid.Parse(r.GetTournamentID())
+	if err != nil {
+		return nil, kerror.Newf(kerror.InvalidID, "parsing tournament id: %w", err)
+	}
+
+	user, err := uuid.Parse(r.GetUserID())
+	if err != nil {
+		return nil, kerror.Newf(kerror.InvalidID, "parsing user id: %w", err)
+	}
+
+	if err := sh.tournamentController.ReportScore(ctx, tournament, user, r.GetScore()); err != nil {
+		return nil, kerror.Errorf(err, "controller")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (sh *ServiceHandler) GetDrawProof(ctx context.Context, r *ttgrpc.TournamentRequest) (*ttgrpc.DrawProof, error) {
+	id, err := uuid.Parse(r.GetId())
+	if err != nil {
+		return nil, kerror.Newf(kerror.InvalidID, "parsing tournament id: %w", err)
+	}
+
+	proof, err := sh.tournamentController.GetDrawProof(ctx, id)
+	if err != nil {
+		return nil, kerror.Errorf(err, "controller")
+	}
+
+	return drawProofToProto(proof), nil
+}
+
+func drawProofToProto(proof *models.DrawProof) *ttgrpc.DrawProof {
+	entries := make([]*ttgrpc.DrawEntry, 0, len(proof.Entries))
+	for _, entry := range proof.Entries {
+		entries = append(entries, &ttgrpc.DrawEntry{
+			UserID:     entry.UserID.String(),
+			ClientSeed: entry.ClientSeed,
+		})
+	}
+
+	return &ttgrpc.DrawProof{
+		TournamentID:   proof.TournamentID.String(),
+		Algorithm:      proof.Algorithm,
+		ServerSeedHash: proof.ServerSeedHash,
+		ServerSeed:     proof.ServerSeed,
+		Entries:        entries,
+		WinnerIndex:    int32(proof.WinnerIndex),
+		Winner:         proof.Winner.String(),
+	}
+}
@@ -2,10 +2,12 @@ package handler
 
 import (
 	"context"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/kimbellG/kerror"
 	ttgrpc "github.com/kimbellG/tournament/core/handler/grpc"
+	"github.com/kimbellG/tournament/core/interceptor"
 	"github.com/kimbellG/tournament/core/models"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
@@ -24,11 +26,29 @@ func (sc *ServiceHandler) SaveUser(ctx context.Context, user *ttgrpc.User) (*ttg
 	}, nil
 }
 
+// userFromProto takes balances from Balances, clients that don't send them name only the default one.
 func userFromProto(gUser *ttgrpc.User) *models.User {
-	return &models.User{
-		Name:    gUser.GetName(),
-		Balance: gUser.GetBalance(),
+	user := &models.User{
+		Name:     gUser.GetName(),
+		Password: gUser.GetPassword(),
+		Email:    gUser.GetEmail(),
 	}
+
+	if len(gUser.GetBalances()) == 0 {
+		user.Balances = []models.Balance{{
+			Currency: models.DefaultCurrency,
+			Amount:   moneyFromProto(gUser.GetBalanceMoney(), gUser.GetBalance()),
+		}}
+	}
+
+	for _, balance := range gUser.GetBalances() {
+		user.Balances = append(user.Balances, models.Balance{
+			Currency: currencyFromProto(balance.GetCurrency()),
+			Amount:   moneyFromProto(balance.GetAmount(), 0),
+		})
+	}
+
+	return user
 }
 
 func (sc *ServiceHandler) GetUserByID(ctx context.Context, r *ttgrpc.UserRequest) (*ttgrpc.User, error) {
@@ -37,6 +57,10 @@ func (sc *ServiceHandler) GetUserByID(ctx context.Context, r *ttgrpc.UserRequest
 		return nil, kerror.Errorf(err, "marshaling id from request")
 	}
 
+	if err := authorizeOwner(ctx, id); err != nil {
+		return nil, kerror.Errorf(err, "authorize caller")
+	}
+
 	user, err := sc.userController.GetByID(ctx, id)
 	if err != nil {
 		return nil, kerror.Errorf(err, "get user from controller")
@@ -55,10 +79,25 @@ func userIDFromProto(r *ttgrpc.UserRequest) (uuid.UUID, error) {
 }
 
 func userToProto(user *models.User) *ttgrpc.User {
+	balances := make([]*ttgrpc.Balance, 0, len(user.Balances))
+	for _, balance := range user.Balances {
+		balances = append(balances, &ttgrpc.Balance{
+			Currency:  string(balance.Currency),
+			Amount:    moneyToProto(balance.Amount),
+			Available: moneyToProto(balance.Available()),
+		})
+	}
+
 	return &ttgrpc.User{
-		ID:      user.ID.String(),
-		Name:    user.Name,
-		Balance: user.Balance,
+		ID:           user.ID.String(),
+		Name:         user.Name,
+		Email:        user.Email,
+		Role:         string(user.Role),
+		Balance:      user.BalanceIn(models.DefaultCurrency).Float64(),
+		BalanceMoney: moneyToProto(user.BalanceIn(models.DefaultCurrency)),
+		Balances:     balances,
+
+		AvailableBalanceMoney: moneyToProto(user.AvailableIn(models.DefaultCurrency)),
 	}
 }
 
@@ -68,6 +107,10 @@ func (sc *ServiceHandler) DeleteUserByID(ctx context.Context, r *ttgrpc.UserRequ
 		return &emptypb.Empty{}, kerror.Newf(kerror.InvalidID, "marshaling from user request: %w", err)
 	}
 
+	if err := authorizeOwner(ctx, id); err != nil {
+		return &emptypb.Empty{}, kerror.Errorf(err, "authorize caller")
+	}
+
 	if err := sc.userController.DeleteByID(ctx, id); err != nil {
 		return &emptypb.Empty{}, kerror.Errorf(err, "delete user from controller")
 	}
@@ -81,7 +124,12 @@ func (sc *ServiceHandler) SumToBalance(ctx context.Context, r *ttgrpc.RequestToU
 		return &emptypb.Empty{}, kerror.Newf(kerror.InvalidID, "parsing id from request: %w", err)
 	}
 
-	if err := sc.userController.UpdateBalance(ctx, id, r.GetAddend()); err != nil {
+	if err := authorizeOwner(ctx, id); err != nil {
+		return &emptypb.Empty{}, kerror.Errorf(err, "authorize caller")
+	}
+
+	addend := moneyFromProto(r.GetAddendMoney(), r.GetAddend())
+	if err := sc.userController.UpdateBalance(ctx, id, currencyFromProto(r.GetCurrency()), addend, idempotencyKeyFromContext(ctx)); err != nil {
 		return &emptypb.Empty{}, kerror.Errorf(err, "controller")
 	}
 
@@ -89,12 +137,207 @@ func (sc *ServiceHandler) SumToBalance(ctx context.Context, r *ttgrpc.RequestToU
 }
 
 func (sc *ServiceHandler) UserAuthorization(ctx context.Context, r *ttgrpc.AuthorizationRequest) (*ttgrpc.AuthorizationResponse, error) {
-	user, err := sc.userController.Authorization(ctx, r.Username, r.Password)
+	grant, err := sc.userController.Authorization(ctx, r.Username, r.Password)
 	if err != nil {
 		return &ttgrpc.AuthorizationResponse{}, kerror.Errorf(err, "controller")
 	}
 
+	return grantToProto(grant), nil
+}
+
+func (sc *ServiceHandler) RefreshSession(ctx context.Context, r *ttgrpc.RefreshRequest) (*ttgrpc.AuthorizationResponse, error) {
+	grant, err := sc.userController.RefreshSession(ctx, r.GetRefreshToken())
+	if err != nil {
+		return &ttgrpc.AuthorizationResponse{}, kerror.Errorf(err, "controller")
+	}
+
+	return grantToProto(grant), nil
+}
+
+func grantToProto(grant *models.Grant) *ttgrpc.AuthorizationResponse {
 	return &ttgrpc.AuthorizationResponse{
-		Id: user.ID.String(),
+		Id:               grant.Session.UserID.String(),
+		Role:             string(grant.Role),
+		SessionID:        grant.Session.ID.String(),
+		RefreshToken:     grant.RefreshToken,
+		SessionExpiresAt: timeToProto(grant.Session.ExpiresAt),
+	}
+}
+
+func (sc *ServiceHandler) Logout(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	sessionID, err := callerSession(ctx)
+	if err != nil {
+		return &emptypb.Empty{}, kerror.Errorf(err, "session of caller")
+	}
+
+	if err := sc.userController.Logout(ctx, sessionID); err != nil {
+		return &emptypb.Empty{}, kerror.Errorf(err, "controller")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// callerSession returns id of the session the caller's token was issued within.
+func callerSession(ctx context.Context) (uuid.UUID, error) {
+	claims, ok := interceptor.ClaimsFromContext(ctx)
+	if !ok {
+		return uuid.UUID{}, kerror.Newf(kerror.Unauthenticated, "caller isn't authenticated")
+	}
+
+	sessionID, err := uuid.Parse(claims.Id)
+	if err != nil {
+		return uuid.UUID{}, kerror.Newf(kerror.Unauthenticated, "parsing session id: %v", err)
+	}
+
+	return sessionID, nil
+}
+
+// Authenticate is called by the gateway to check that session of the token hasn't been revoked.
+// The check itself is done by the interceptor, so it only answers who the caller is.
+func (sc *ServiceHandler) Authenticate(ctx context.Context, _ *emptypb.Empty) (*ttgrpc.AuthorizationResponse, error) {
+	claims, ok := interceptor.ClaimsFromContext(ctx)
+	if !ok {
+		return &ttgrpc.AuthorizationResponse{}, kerror.Newf(kerror.Unauthenticated, "caller isn't authenticated")
+	}
+
+	return &ttgrpc.AuthorizationResponse{
+		Id:        claims.ID,
+		Role:      string(claims.Role),
+		SessionID: claims.Id,
+	}, nil
+}
+
+// SessionActive lets the interceptor check sessions of callers.
+func (sc *ServiceHandler) SessionActive(ctx context.Context, id uuid.UUID) (bool, error) {
+	active, err := sc.userController.SessionActive(ctx, id)
+	if err != nil {
+		return false, kerror.Errorf(err, "controller")
+	}
+
+	return active, nil
+}
+
+func (sc *ServiceHandler) ChangePassword(ctx context.Context, r *ttgrpc.ChangePasswordRequest) (*emptypb.Empty, error) {
+	id, err := uuid.Parse(r.GetUserID())
+	if err != nil {
+		return &emptypb.Empty{}, kerror.Newf(kerror.InvalidID, "parsing user id: %w", err)
+	}
+
+	if err := authorizeOwner(ctx, id); err != nil {
+		return &emptypb.Empty{}, kerror.Errorf(err, "authorize caller")
+	}
+
+	sessionID, err := callerSession(ctx)
+	if err != nil {
+		return &emptypb.Empty{}, kerror.Errorf(err, "session of caller")
+	}
+
+	if err := sc.userController.ChangePassword(ctx, id, sessionID, r.GetOldPassword(), r.GetNewPassword()); err != nil {
+		return &emptypb.Empty{}, kerror.Errorf(err, "controller")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (sc *ServiceHandler) RequestPasswordReset(ctx context.Context, r *ttgrpc.PasswordResetRequest) (*emptypb.Empty, error) {
+	if err := sc.userController.RequestPasswordReset(ctx, r.GetUsername()); err != nil {
+		return &emptypb.Empty{}, kerror.Errorf(err, "controller")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (sc *ServiceHandler) ResetPassword(ctx context.Context, r *ttgrpc.ResetPasswordRequest) (*emptypb.Empty, error) {
+	if err := sc.userController.ResetPassword(ctx, r.GetToken(), r.GetNewPassword()); err != nil {
+		return &emptypb.Empty{}, kerror.Errorf(err, "controller")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (sc *ServiceHandler) SetUserRole(ctx context.Context, r *ttgrpc.SetRoleRequest) (*emptypb.Empty, error) {
+	id, err := uuid.Parse(r.GetUserID())
+	if err != nil {
+		return &emptypb.Empty{}, kerror.Newf(kerror.InvalidID, "parsing user id: %w", err)
+	}
+
+	if err := sc.userController.SetRole(ctx, id, models.Role(titleCase(r.GetRole()))); err != nil {
+		return &emptypb.Empty{}, kerror.Errorf(err, "controller")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (sc *ServiceHandler) ListUserTransactions(ctx context.Context, r *ttgrpc.UserTransactionsRequest) (*ttgrpc.UserTransactionsResponse, error) {
+	id, err := uuid.Parse(r.GetUserID())
+	if err != nil {
+		return nil, kerror.Newf(kerror.InvalidID, "parsing user id: %w", err)
+	}
+
+	if err := authorizeOwner(ctx, id); err != nil {
+		return nil, kerror.Errorf(err, "authorize caller")
+	}
+
+	filter := &models.TransactionFilter{
+		Types:  transactionTypesFromProto(r.GetTypes()),
+		From:   timeFromProto(r.GetFrom()),
+		To:     timeFromProto(r.GetTo()),
+		Limit:  int(r.GetLimit()),
+		Offset: int(r.GetOffset()),
+	}
+	if r.GetCurrency() != "" {
+		filter.Currency = currencyFromProto(r.GetCurrency())
+	}
+
+	transactions, nextOffset, err := sc.userController.ListTransactions(ctx, id, filter)
+	if err != nil {
+		return nil, kerror.Errorf(err, "controller")
+	}
+
+	protoTransactions := make([]*ttgrpc.UserTransaction, 0, len(transactions))
+	for _, transaction := range transactions {
+		protoTransactions = append(protoTransactions, userTransactionToProto(transaction))
+	}
+
+	return &ttgrpc.UserTransactionsResponse{
+		Transactions: protoTransactions,
+		NextOffset:   int32(nextOffset),
 	}, nil
 }
+
+// transactionTypesFromProto matches names of transaction types regardless of case.
+// Unknown names are passed as is to be rejected by controller.
+func transactionTypesFromProto(names []string) []models.TransactionType {
+	var types []models.TransactionType
+	for _, name := range names {
+		transactionType := models.TransactionType(name)
+		for _, known := range models.TransactionTypes {
+			if strings.EqualFold(name, string(known)) {
+				transactionType = known
+			}
+		}
+
+		types = append(types, transactionType)
+	}
+
+	return types
+}
+
+func userTransactionToProto(transaction models.UserTransaction) *ttgrpc.UserTransaction {
+	var tournamentID string
+	if transaction.TournamentID != uuid.Nil {
+		tournamentID = transaction.TournamentID.String()
+	}
+
+	return &ttgrpc.UserTransaction{
+		Id:                transaction.ID.String(),
+		Type:              string(transaction.Type),
+		Currency:          string(transaction.Currency),
+		TournamentID:      tournamentID,
+		Amount:            transaction.Amount.Float64(),
+		AmountMoney:       moneyToProto(transaction.Amount),
+		BalanceAfter:      transaction.BalanceAfter.Float64(),
+		BalanceAfterMoney: moneyToProto(transaction.BalanceAfter),
+		CreatedAt:         timeToProto(transaction.CreatedAt),
+	}
+}
@@ -0,0 +1,124 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/core/models"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// authorizationMetadata is the gRPC metadata, which carries token of user as "Bearer <token>".
+const authorizationMetadata = "authorization"
+
+// Claims are the claims of tokens the gateway issues to users within their sessions. Id of the token
+// is the id of the session.
+type Claims struct {
+	ID   string
+	Role models.Role
+	jwt.StandardClaims
+}
+
+// Policy names the roles allowed to call methods of the service by their full names.
+// Public methods are called without token, methods missing from Roles can't be called at all.
+type Policy struct {
+	Public map[string]bool
+	Roles  map[string][]models.Role
+}
+
+func (p *Policy) Allows(method string, role models.Role) bool {
+	for _, allowed := range p.Roles[method] {
+		if allowed == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Sessions tells whether session tokens are issued within is still active.
+type Sessions interface {
+	SessionActive(ctx context.Context, id uuid.UUID) (bool, error)
+}
+
+// claimsKey is the key of claims of caller in the context of call.
+type claimsKey struct{}
+
+// ClaimsFromContext returns claims of caller. Calls of public methods have no claims.
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*Claims)
+	return claims, ok
+}
+
+// Authorize lets through calls, which policy allows to the role in the token of caller signed with secret.
+// Token of expired or revoked session is rejected. Claims of caller are passed to handler in the context.
+func Authorize(policy *Policy, secret string, sessions Sessions) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if policy.Public[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		claims, err := claimsFromContext(ctx, secret)
+		if err != nil {
+			return nil, kerror.Errorf(err, "authenticate caller")
+		}
+
+		if err := checkSession(ctx, sessions, claims); err != nil {
+			return nil, kerror.Errorf(err, "check session of caller")
+		}
+
+		if !policy.Allows(info.FullMethod, claims.Role) {
+			return nil, kerror.Newf(kerror.Forbidden, "%s user(%s) isn't allowed to call %s", claims.Role, claims.ID, info.FullMethod)
+		}
+
+		return handler(context.WithValue(ctx, claimsKey{}, claims), req)
+	}
+}
+
+func claimsFromContext(ctx context.Context, secret string) (*Claims, error) {
+	if secret == "" {
+		return nil, kerror.Newf(kerror.Unauthenticated, "secret of tokens isn't configured")
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(authorizationMetadata)
+	if len(values) == 0 {
+		return nil, kerror.Newf(kerror.Unauthenticated, "token is missing")
+	}
+
+	tkString := strings.TrimPrefix(values[0], "Bearer ")
+	claims := &Claims{}
+	if _, err := jwt.ParseWithClaims(tkString, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, kerror.Newf(kerror.Unauthenticated, "unexpected signing method %v", token.Header["alg"])
+		}
+
+		return []byte(secret), nil
+	}); err != nil {
+		return nil, kerror.Newf(kerror.Unauthenticated, "invalid token: %v", err)
+	}
+
+	return claims, nil
+}
+
+func checkSession(ctx context.Context, sessions Sessions, claims *Claims) error {
+	id, err := uuid.Parse(claims.Id)
+	if err != nil {
+		return kerror.Newf(kerror.Unauthenticated, "token of user(%s) has no session: %v", claims.ID, err)
+	}
+
+	active, err := sessions.SessionActive(ctx, id)
+	if err != nil {
+		return kerror.Errorf(err, "get session")
+	}
+
+	if !active {
+		return kerror.Newf(kerror.Unauthenticated, "session(%v) of user(%s) is expired or revoked", id, claims.ID)
+	}
+
+	return nil
+}
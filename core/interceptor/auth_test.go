@@ -0,0 +1,118 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const secret = "secret"
+
+var (
+	activeSession  = uuid.New()
+	revokedSession = uuid.New()
+)
+
+type fakeSessions map[uuid.UUID]bool
+
+func (s fakeSessions) SessionActive(ctx context.Context, id uuid.UUID) (bool, error) {
+	return s[id], nil
+}
+
+var sessions = fakeSessions{activeSession: true, revokedSession: false}
+
+func token(t *testing.T, role models.Role, key string) string {
+	return sessionToken(t, role, key, activeSession.String())
+}
+
+func sessionToken(t *testing.T, role models.Role, key, sessionID string) string {
+	tk, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &Claims{
+		ID:   "user",
+		Role: role,
+		StandardClaims: jwt.StandardClaims{
+			Id:        sessionID,
+			ExpiresAt: time.Now().Add(time.Hour).Unix(),
+		},
+	}).SignedString([]byte(key))
+	if err != nil {
+		t.Fatalf("Failed to sign token: %v", err)
+	}
+
+	return tk
+}
+
+func TestAuthorize(t *testing.T) {
+	policy := &Policy{
+		Public: map[string]bool{"/public": true},
+		Roles:  map[string][]models.Role{"/finish": {models.AdminRole, models.OrganizerRole}},
+	}
+	authorize := Authorize(policy, secret, sessions)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "called", nil
+	}
+
+	tt := []struct {
+		name   string
+		method string
+		token  string
+		code   kerror.StatusCode
+		called bool
+	}{
+		{name: "public method without token", method: "/public", called: true},
+		{name: "allowed role", method: "/finish", token: token(t, models.OrganizerRole, secret), called: true},
+		{name: "missing token", method: "/finish", code: kerror.Unauthenticated},
+		{name: "foreign signature", method: "/finish", token: token(t, models.AdminRole, "other"), code: kerror.Unauthenticated},
+		{name: "revoked session", method: "/finish", token: sessionToken(t, models.AdminRole, secret, revokedSession.String()), code: kerror.Unauthenticated},
+		{name: "unknown session", method: "/finish", token: sessionToken(t, models.AdminRole, secret, uuid.New().String()), code: kerror.Unauthenticated},
+		{name: "token without session", method: "/finish", token: sessionToken(t, models.AdminRole, secret, ""), code: kerror.Unauthenticated},
+		{name: "disallowed role", method: "/finish", token: token(t, models.PlayerRole, secret), code: kerror.Forbidden},
+		{name: "method missing from policy", method: "/unknown", token: token(t, models.AdminRole, secret), code: kerror.Forbidden},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.token != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(authorizationMetadata, "Bearer "+tc.token))
+			}
+
+			resp, err := authorize(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tc.method}, handler)
+			if tc.called {
+				assert.NoError(t, err)
+				assert.Equal(t, "called", resp)
+				return
+			}
+
+			var kerr kerror.Error
+			if assert.True(t, errors.As(err, &kerr), "error should have status code: %v", err) {
+				assert.Equal(t, tc.code, kerr.StatusCode())
+			}
+			assert.Nil(t, resp)
+		})
+	}
+}
+
+func TestAuthorizePassesClaims(t *testing.T) {
+	policy := &Policy{Roles: map[string][]models.Role{"/join": {models.PlayerRole}}}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationMetadata, "Bearer "+token(t, models.PlayerRole, secret)))
+
+	_, err := Authorize(policy, secret, sessions)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/join"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		claims, ok := ClaimsFromContext(ctx)
+		if assert.True(t, ok, "handler should get claims of caller") {
+			assert.Equal(t, "user", claims.ID)
+			assert.Equal(t, models.PlayerRole, claims.Role)
+		}
+
+		return nil, nil
+	})
+	assert.NoError(t, err)
+}
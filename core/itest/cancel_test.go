@@ -18,23 +18,23 @@ func TestCancelTournament(t *testing.T) {
 
 	activeTournament := createTournament(t, db, &models.Tournament{
 		Name:    "tournament to cancel",
-		Deposit: 1000,
-		Prize:   10000,
-		Status:  models.Active,
+		Deposit: money(1000),
+		Prize:   money(10000),
+		Status:  models.RegistrationOpen,
 	})
 
 	notActiveTournament := createTournament(t, db, &models.Tournament{
 		Name:    "not active tournament",
-		Deposit: 500,
-		Prize:   1000,
-		Status:  models.Cancel,
+		Deposit: money(500),
+		Prize:   money(1000),
+		Status:  models.Cancelled,
 	})
 
 	var users []*models.User
 	for i := 0; i < 4; i++ {
 		user := createUser(t, db, &models.User{
-			Name:    fmt.Sprintf("cancel user %d", i),
-			Balance: 500,
+			Name:     fmt.Sprintf("cancel user %d", i),
+			Balances: usd(500),
 		})
 		users = append(users, user)
 
@@ -75,12 +75,12 @@ func TestCancelTournament(t *testing.T) {
 			}
 
 			for _, user := range tc.users {
-				var actualBalance float64
-				if err := db.QueryRow("SELECT balance FROM Users WHERE id = $1", user.ID).Scan(&actualBalance); err != nil {
+				var actualBalance models.Money
+				if err := db.QueryRow(userBalanceQuery, user.ID).Scan(&actualBalance); err != nil {
 					t.Fatalf("Failed to select joiner's balance. joiner: %v; tournament: %v", user.Name, tc.tournament.Name)
 				}
 
-				assert.Equalf(t, user.Balance+tc.tournament.Deposit, actualBalance, "actual balance should be old balance(%v) + tournament deposit(%v)", user.Balance, tc.tournament.Deposit)
+				assert.Equalf(t, user.BalanceIn(models.DefaultCurrency).Add(tc.tournament.Deposit), actualBalance, "actual balance should be old balance(%v) + tournament deposit(%v)", user.BalanceIn(models.DefaultCurrency), tc.tournament.Deposit)
 			}
 
 			var status models.TournamentStatus
@@ -88,7 +88,7 @@ func TestCancelTournament(t *testing.T) {
 				t.Fatalf("Failed to select status of tournament(%v): %v", tc.tournament.Status, err)
 			}
 
-			assert.Equal(t, models.Cancel, status, "tournament status should be cancel")
+			assert.Equal(t, models.Cancelled, status, "tournament status should be cancel")
 		})
 	}
 }
@@ -0,0 +1,83 @@
+// +build integration
+
+package itest
+
+import (
+	"context"
+	"testing"
+
+	tgrpc "github.com/kimbellG/tournament/core/handler/grpc"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestTournamentCurrency(t *testing.T) {
+	client := tgrpc.NewTournamentServiceClient(conn)
+
+	created, err := client.CreateTournament(context.Background(), &tgrpc.CreateTournamentRequest{
+		Name:     "chips tournament",
+		Deposit:  100,
+		Currency: "CHIPS",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create tournament: %v", err)
+	}
+
+	_, err = client.CreateTournament(context.Background(), &tgrpc.CreateTournamentRequest{
+		Name:     "invalid currency tournament",
+		Deposit:  100,
+		Currency: "c41ps",
+	})
+	assertGrpcError(t, codes.InvalidArgument, err)
+
+	saved, err := client.SaveUser(context.Background(), &tgrpc.User{
+		Name:     "chips user",
+		Balances: []*tgrpc.Balance{{Currency: "USD"}, {Currency: "CHIPS"}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to save user: %v", err)
+	}
+
+	for _, funding := range []*tgrpc.RequestToUpdateBalance{
+		{ID: saved.GetId(), AddendMoney: &tgrpc.Money{Units: 500}, Currency: "USD"},
+		{ID: saved.GetId(), AddendMoney: &tgrpc.Money{Units: 300}, Currency: "CHIPS"},
+		{ID: saved.GetId(), Addend: 25, Currency: "eur"},
+	} {
+		if _, err := client.SumToBalance(context.Background(), funding); err != nil {
+			t.Fatalf("Failed to fund user: %v", err)
+		}
+	}
+
+	if _, err := client.JoinTournament(context.Background(), &tgrpc.JoinRequest{
+		TournamentID: created.GetId(),
+		UserID:       saved.GetId(),
+	}); err != nil {
+		t.Fatalf("Failed to join tournament: %v", err)
+	}
+
+	user, err := client.GetUserByID(context.Background(), &tgrpc.UserRequest{ID: saved.GetId()})
+	if err != nil {
+		t.Fatalf("Failed to get user: %v", err)
+	}
+
+	available := make(map[string]float64)
+	for _, balance := range user.GetBalances() {
+		available[balance.GetCurrency()] = float64(balance.GetAvailable().GetUnits())
+	}
+	assert.Equal(t, map[string]float64{"USD": 500, "CHIPS": 200, "EUR": 25}, available, "only balance in currency of tournament should be held")
+	assert.Equal(t, float64(500), user.GetBalance(), "legacy balance should be in default currency")
+
+	tournament, err := client.GetTournamentByID(context.Background(), &tgrpc.TournamentRequest{Id: created.GetId()})
+	if err != nil {
+		t.Fatalf("Failed to get tournament: %v", err)
+	}
+	assert.Equal(t, "CHIPS", tournament.GetCurrency(), "tournament should keep its currency")
+
+	poor := createUser(t, db, &models.User{Name: "user without chips", Balances: usd(500)})
+	_, err = client.JoinTournament(context.Background(), &tgrpc.JoinRequest{
+		TournamentID: created.GetId(),
+		UserID:       poor.ID.String(),
+	})
+	assertGrpcError(t, codes.FailedPrecondition, err)
+}
@@ -0,0 +1,64 @@
+// +build integration
+
+package itest
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	tgrpc "github.com/kimbellG/tournament/core/handler/grpc"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProvablyFairDraw(t *testing.T) {
+	client := tgrpc.NewTournamentServiceClient(conn)
+
+	created, err := client.CreateTournament(context.Background(), &tgrpc.CreateTournamentRequest{
+		Name:           "provably fair tournament",
+		Deposit:        10,
+		WinnerStrategy: string(models.FairWinner),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create tournament: %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		user := createUser(t, db, &models.User{
+			Name:     fmt.Sprintf("fair draw user %d", i),
+			Balances: usd(10),
+		})
+
+		if _, err := client.JoinTournament(context.Background(), &tgrpc.JoinRequest{
+			TournamentID: created.GetId(),
+			UserID:       user.ID.String(),
+			ClientSeed:   fmt.Sprintf("client seed %d", i),
+		}); err != nil {
+			t.Fatalf("Failed to join tournament: %v", err)
+		}
+	}
+
+	committed, err := client.GetDrawProof(context.Background(), &tgrpc.TournamentRequest{Id: created.GetId()})
+	if err != nil {
+		t.Fatalf("Failed to get draw proof: %v", err)
+	}
+	assert.Empty(t, committed.GetServerSeed(), "server seed shouldn't be revealed before finish")
+	assert.NotEmpty(t, committed.GetServerSeedHash(), "server seed should be committed at creation")
+	assert.Equal(t, 3, len(committed.GetEntries()), "every participant should be in the draw")
+
+	if _, err := client.FinishTournament(context.Background(), &tgrpc.FinishRequest{Id: created.GetId()}); err != nil {
+		t.Fatalf("Failed to finish tournament: %v", err)
+	}
+
+	revealed, err := client.GetDrawProof(context.Background(), &tgrpc.TournamentRequest{Id: created.GetId()})
+	if err != nil {
+		t.Fatalf("Failed to get draw proof: %v", err)
+	}
+
+	hash := sha256.Sum256([]byte(revealed.GetServerSeed()))
+	assert.Equal(t, committed.GetServerSeedHash(), hex.EncodeToString(hash[:]), "revealed seed should match commitment")
+	assert.Equal(t, revealed.GetEntries()[revealed.GetWinnerIndex()].GetUserID(), revealed.GetWinner(), "winner index should point to winner")
+}
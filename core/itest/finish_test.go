@@ -18,23 +18,23 @@ func TestFinishTournament(t *testing.T) {
 
 	activeTournament := createTournament(t, db, &models.Tournament{
 		Name:    "finish tournament",
-		Deposit: 1000,
-		Prize:   10000,
-		Status:  models.Active,
+		Deposit: money(1000),
+		Prize:   money(10000),
+		Status:  models.RegistrationOpen,
 	})
 
 	notActiveTournament := createTournament(t, db, &models.Tournament{
 		Name:    "not active tournament",
-		Deposit: 500,
-		Prize:   1000,
-		Status:  models.Cancel,
+		Deposit: money(500),
+		Prize:   money(1000),
+		Status:  models.Cancelled,
 	})
 
 	var users []*models.User
 	for i := 0; i < 4; i++ {
 		user := createUser(t, db, &models.User{
-			Name:    fmt.Sprintf("finish user %d", i),
-			Balance: 500,
+			Name:     fmt.Sprintf("finish user %d", i),
+			Balances: usd(500),
 		})
 		users = append(users, user)
 
@@ -69,30 +69,139 @@ func TestFinishTournament(t *testing.T) {
 
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
-			if _, err := client.FinishTournament(context.Background(), &tgrpc.TournamentRequest{Id: tc.tournament.ID.String()}); err != nil {
+			if _, err := client.FinishTournament(context.Background(), &tgrpc.FinishRequest{Id: tc.tournament.ID.String()}); err != nil {
 				assertGrpcError(t, tc.code, err)
 				return
 			}
 
-			winner := &models.User{}
-			if err := db.QueryRow("SELECT users.id, users.name, users.balance FROM Tournaments INNER JOIN Users ON winner = users.id WHERE tournaments.id = $1",
-				tc.tournament.ID).Scan(&winner.ID, &winner.Name, &winner.Balance); err != nil {
+			winner := &models.User{Balances: usd(0)}
+			if err := db.QueryRow("SELECT users.id, users.name FROM Tournaments INNER JOIN Users ON winner = users.id WHERE tournaments.id = $1",
+				tc.tournament.ID).Scan(&winner.ID, &winner.Name); err != nil {
 				t.Fatalf("Failed select winner from tournament: %v", err)
 			}
 
+			if err := db.QueryRow(userBalanceQuery, winner.ID).Scan(&winner.Balances[0].Amount); err != nil {
+				t.Fatalf("Failed select balance of winner: %v", err)
+			}
+
 			oldUser, ok := isUserInTournament(winner.ID, tc.users)
 			if !assert.Truef(t, ok, "winner(%v) isn't tournament joiner(%v)", winner.Name, tc.tournament.Name) {
 				return
 			}
 
-			assert.Equalf(t, oldUser.Balance+tc.tournament.Prize, winner.Balance, "new balance winner should be old balance(%v) + prize(%v)", oldUser.Balance, tc.tournament.Prize)
+			assert.Equalf(t, oldUser.BalanceIn(models.DefaultCurrency).Add(tc.tournament.Prize), winner.BalanceIn(models.DefaultCurrency), "new balance winner should be old balance(%v) + prize(%v)", oldUser.BalanceIn(models.DefaultCurrency), tc.tournament.Prize)
 
 			var status models.TournamentStatus
 			if err := db.QueryRow("SELECT status FROM Tournaments WHERE id = $1", tc.tournament.ID).Scan(&status); err != nil {
 				t.Fatalf("Failed select status of tournament: %v", err)
 			}
-			assert.Equal(t, status, models.Finish, "status should be finish")
+			assert.Equal(t, status, models.Finished, "status should be finish")
 
 		})
 	}
 }
+
+func TestFinishTournamentWithManualWinner(t *testing.T) {
+	client := tgrpc.NewTournamentServiceClient(conn)
+
+	tournament := createTournament(t, db, &models.Tournament{
+		Name:    "manual finish tournament",
+		Deposit: money(100),
+		Prize:   money(200),
+		Status:  models.RegistrationOpen,
+	})
+	if _, err := db.Exec("UPDATE Tournaments SET winnerStrategy = $1 WHERE id = $2", models.ManualWinner, tournament.ID); err != nil {
+		t.Fatalf("Failed to set winner strategy: %v", err)
+	}
+
+	var users []*models.User
+	for i := 0; i < 2; i++ {
+		user := createUser(t, db, &models.User{Name: fmt.Sprintf("manual finish user %d", i)})
+		users = append(users, user)
+
+		if _, err := db.Exec("INSERT INTO UsersOfTournaments(tournamentID, userID) VALUES($1, $2)", tournament.ID, user.ID); err != nil {
+			t.Fatalf("Failed to join user to tournament: %v", err)
+		}
+	}
+
+	_, err := client.FinishTournament(context.Background(), &tgrpc.FinishRequest{Id: tournament.ID.String()})
+	assertGrpcError(t, codes.InvalidArgument, err)
+
+	if _, err := client.FinishTournament(context.Background(), &tgrpc.FinishRequest{
+		Id:       tournament.ID.String(),
+		WinnerID: users[1].ID.String(),
+	}); err != nil {
+		t.Fatalf("Failed to finish tournament: %v", err)
+	}
+
+	var winner string
+	if err := db.QueryRow("SELECT winner FROM Tournaments WHERE id = $1", tournament.ID).Scan(&winner); err != nil {
+		t.Fatalf("Failed to select winner of tournament: %v", err)
+	}
+	assert.Equal(t, users[1].ID.String(), winner, "picked user should win tournament")
+}
+
+func TestFinishTournamentWithPayouts(t *testing.T) {
+	client := tgrpc.NewTournamentServiceClient(conn)
+
+	created, err := client.CreateTournament(context.Background(), &tgrpc.CreateTournamentRequest{
+		Name:           "payout tournament",
+		Deposit:        100,
+		WinnerStrategy: string(models.ManualWinner),
+		PayoutType:     string(models.PercentagePayout),
+		Payouts:        []float64{50, 30, 20},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create tournament: %v", err)
+	}
+
+	_, err = client.CreateTournament(context.Background(), &tgrpc.CreateTournamentRequest{
+		Name:    "invalid payout tournament",
+		Payouts: []float64{50, 30},
+	})
+	assertGrpcError(t, codes.InvalidArgument, err)
+
+	if _, err := db.Exec("UPDATE Tournaments SET prize = 1000 WHERE id = $1", created.GetId()); err != nil {
+		t.Fatalf("Failed to set prize of tournament: %v", err)
+	}
+
+	var ranking []string
+	for i := 0; i < 4; i++ {
+		user := createUser(t, db, &models.User{Name: fmt.Sprintf("payout user %d", i)})
+		ranking = append(ranking, user.ID.String())
+
+		if _, err := db.Exec("INSERT INTO UsersOfTournaments(tournamentID, userID) VALUES($1, $2)", created.GetId(), user.ID); err != nil {
+			t.Fatalf("Failed to join user to tournament: %v", err)
+		}
+	}
+
+	if _, err := client.FinishTournament(context.Background(), &tgrpc.FinishRequest{
+		Id:      created.GetId(),
+		Ranking: ranking,
+	}); err != nil {
+		t.Fatalf("Failed to finish tournament: %v", err)
+	}
+
+	tournament, err := client.GetTournamentByID(context.Background(), &tgrpc.TournamentRequest{Id: created.GetId()})
+	if err != nil {
+		t.Fatalf("Failed to get tournament: %v", err)
+	}
+
+	assert.Equal(t, ranking[0], tournament.GetWinner(), "first place should be winner")
+	if !assert.Equal(t, 3, len(tournament.GetPlacements()), "three places should be paid") {
+		return
+	}
+
+	for i, want := range []float64{500, 300, 200} {
+		placement := tournament.GetPlacements()[i]
+		assert.Equal(t, int32(i+1), placement.GetPlace(), "places should be ordered")
+		assert.Equal(t, ranking[i], placement.GetUserID(), "place should belong to ranked user")
+		assert.Equal(t, want, placement.GetPrize(), "place should get its share of prize")
+
+		var balance float64
+		if err := db.QueryRow(userBalanceQuery, ranking[i]).Scan(&balance); err != nil {
+			t.Fatalf("Failed to select balance of user: %v", err)
+		}
+		assert.Equal(t, want, balance, "prize should be added to balance")
+	}
+}
@@ -18,15 +18,15 @@ func TestGetTournamentByID(t *testing.T) {
 
 	tournament := createTournament(t, db, &models.Tournament{
 		Name:    "ok",
-		Deposit: 1203,
-		Status:  models.Active,
+		Deposit: money(1203),
+		Status:  models.RegistrationOpen,
 	})
 
 	joiners := []models.User{}
 	for i := 0; i < 4; i++ {
 		user := createUser(t, db, &models.User{
-			Name:    fmt.Sprintf("userForGet%d", i),
-			Balance: 0,
+			Name:     fmt.Sprintf("userForGet%d", i),
+			Balances: usd(0),
 		})
 
 		joiners = append(joiners, *user)
@@ -0,0 +1,73 @@
+// +build integration
+
+package itest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	tgrpc "github.com/kimbellG/tournament/core/handler/grpc"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestHoldStakes(t *testing.T) {
+	client := tgrpc.NewTournamentServiceClient(conn)
+
+	created, err := client.CreateTournament(context.Background(), &tgrpc.CreateTournamentRequest{
+		Name:    "hold tournament",
+		Deposit: 100,
+		Format:  string(models.RoundRobin),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create tournament: %v", err)
+	}
+
+	var users []*models.User
+	for i := 0; i < 2; i++ {
+		user := createUser(t, db, &models.User{Name: fmt.Sprintf("hold user %d", i), Balances: usd(150)})
+		users = append(users, user)
+
+		if _, err := client.JoinTournament(context.Background(), &tgrpc.JoinRequest{
+			TournamentID: created.GetId(),
+			UserID:       user.ID.String(),
+		}); err != nil {
+			t.Fatalf("Failed to join tournament: %v", err)
+		}
+	}
+
+	user, err := client.GetUserByID(context.Background(), &tgrpc.UserRequest{ID: users[0].ID.String()})
+	if err != nil {
+		t.Fatalf("Failed to get user: %v", err)
+	}
+	assert.Equal(t, float64(150), user.GetBalance(), "held stake should stay on balance")
+	assert.Equal(t, int64(50), user.GetAvailableBalanceMoney().GetUnits(), "held stake shouldn't be available")
+
+	_, err = client.SumToBalance(context.Background(), &tgrpc.RequestToUpdateBalance{ID: users[0].ID.String(), Addend: -100})
+	assertGrpcError(t, codes.FailedPrecondition, err)
+
+	if _, err := client.StartTournament(context.Background(), &tgrpc.TournamentRequest{Id: created.GetId()}); err != nil {
+		t.Fatalf("Failed to start tournament: %v", err)
+	}
+
+	for _, user := range users {
+		var balance, available models.Money
+		if err := db.QueryRow(userBalanceQuery, user.ID).Scan(&balance); err != nil {
+			t.Fatalf("Failed to select balance of user: %v", err)
+		}
+		if err := db.QueryRow(userAvailableQuery, user.ID).Scan(&available); err != nil {
+			t.Fatalf("Failed to select available balance of user: %v", err)
+		}
+
+		assert.Equal(t, money(50), balance, "stake should be charged on start")
+		assert.Equal(t, balance, available, "charged stake shouldn't be held anymore")
+	}
+
+	tournament, err := client.GetTournamentByID(context.Background(), &tgrpc.TournamentRequest{Id: created.GetId()})
+	if err != nil {
+		t.Fatalf("Failed to get tournament: %v", err)
+	}
+	assert.Equal(t, float64(200), tournament.GetPrize(), "held stakes should be captured into prize")
+}
@@ -0,0 +1,81 @@
+// +build integration
+
+package itest
+
+import (
+	"context"
+	"testing"
+
+	tgrpc "github.com/kimbellG/tournament/core/handler/grpc"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+func withIdempotencyKey(key string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), "idempotency-key", key)
+}
+
+func TestIdempotencyKeys(t *testing.T) {
+	client := tgrpc.NewTournamentServiceClient(conn)
+
+	created, err := client.CreateTournament(context.Background(), &tgrpc.CreateTournamentRequest{
+		Name:    "idempotent tournament",
+		Deposit: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create tournament: %v", err)
+	}
+
+	user := createUser(t, db, &models.User{Name: "idempotent user", Balances: usd(500)})
+	other := createUser(t, db, &models.User{Name: "other idempotent user", Balances: usd(500)})
+
+	fund := func(user *models.User, key string, addend float64) error {
+		_, err := client.SumToBalance(withIdempotencyKey(key), &tgrpc.RequestToUpdateBalance{ID: user.ID.String(), Addend: addend})
+		return err
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := fund(user, "fund-1", 50); err != nil {
+			t.Fatalf("Failed to fund user: %v", err)
+		}
+	}
+	assertGrpcError(t, codes.InvalidArgument, fund(user, "fund-1", 60))
+
+	if err := fund(other, "fund-1", 50); err != nil {
+		t.Fatalf("Key of another user should be independent: %v", err)
+	}
+
+	join := func(user *models.User, key string) (*tgrpc.JoinResponse, error) {
+		return client.JoinTournament(withIdempotencyKey(key), &tgrpc.JoinRequest{
+			TournamentID: created.GetId(),
+			UserID:       user.ID.String(),
+		})
+	}
+
+	first, err := join(user, "join-1")
+	if err != nil {
+		t.Fatalf("Failed to join tournament: %v", err)
+	}
+
+	replayed, err := join(user, "join-1")
+	if err != nil {
+		t.Fatalf("Replay of join should return the first result: %v", err)
+	}
+	assert.Equal(t, first.GetWaitlisted(), replayed.GetWaitlisted(), "replay should return the first result")
+
+	_, err = join(user, "join-2")
+	assertGrpcError(t, codes.AlreadyExists, err)
+
+	var balance, available models.Money
+	if err := db.QueryRow(userBalanceQuery, user.ID).Scan(&balance); err != nil {
+		t.Fatalf("Failed to select balance of user: %v", err)
+	}
+	assert.Equal(t, money(550), balance, "retries shouldn't fund user again")
+
+	if err := db.QueryRow(userAvailableQuery, user.ID).Scan(&available); err != nil {
+		t.Fatalf("Failed to select available balance of user: %v", err)
+	}
+	assert.Equal(t, money(450), available, "stake should be held once")
+}
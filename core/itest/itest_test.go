@@ -3,17 +3,22 @@
 package itest
 
 import (
+	"context"
 	"database/sql"
 	"log"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/golang-jwt/jwt"
 	"github.com/google/uuid"
+	"github.com/kimbellG/tournament/core/interceptor"
 	"github.com/kimbellG/tournament/core/models"
 	tournament "github.com/kimbellG/tournament/core/service"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	_ "github.com/jackc/pgx/v4/stdlib"
@@ -41,7 +46,18 @@ func initTest() (*grpc.ClientConn, *sql.DB) {
 		log.Fatalf("Failed to initialize database: %v\n", err)
 	}
 
-	conn, err := grpc.Dial(os.Getenv("SERVICE_ADDRESS"), grpc.WithInsecure())
+	var adminID uuid.UUID
+	if err := db.QueryRow("INSERT INTO Users(name, password, role) VALUES ($1, 'password', 'Admin') RETURNING id",
+		"itest admin "+uuid.New().String()).Scan(&adminID); err != nil {
+		log.Fatalf("Failed to create admin: %v\n", err)
+	}
+
+	adminToken, err := signToken(db, adminID, models.AdminRole)
+	if err != nil {
+		log.Fatalf("Failed to sign token of admin: %v\n", err)
+	}
+
+	conn, err := grpc.Dial(os.Getenv("SERVICE_ADDRESS"), grpc.WithInsecure(), grpc.WithUnaryInterceptor(withToken(adminToken)))
 	if err != nil {
 		log.Fatalf("Failed to initializate grpc connection: %v\n", err)
 	}
@@ -49,6 +65,63 @@ func initTest() (*grpc.ClientConn, *sql.DB) {
 	return conn, db
 }
 
+// signToken opens session of user and signs token within it for user with role the way the gateway does on log in.
+func signToken(db *sql.DB, id uuid.UUID, role models.Role) (string, error) {
+	var sessionID uuid.UUID
+	if err := db.QueryRow("INSERT INTO Sessions(userID, expiresAt) VALUES ($1, now() + interval '1 hour') RETURNING id", id).Scan(&sessionID); err != nil {
+		return "", err
+	}
+
+	return sessionToken(id.String(), role, sessionID.String())
+}
+
+// sessionToken signs token within the session the way the gateway does.
+func sessionToken(id string, role models.Role, sessionID string) (string, error) {
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, &interceptor.Claims{
+		ID:   id,
+		Role: role,
+		StandardClaims: jwt.StandardClaims{
+			Id:        sessionID,
+			ExpiresAt: time.Now().Add(time.Hour).Unix(),
+		},
+	}).SignedString([]byte(os.Getenv("TK_PASSWORD")))
+}
+
+// withToken sends token with calls, which don't carry their own one.
+func withToken(token string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if md, _ := metadata.FromOutgoingContext(ctx); len(md.Get("authorization")) == 0 {
+			ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// asUser makes calls with ctx on behalf of existing user with role instead of admin.
+func asUser(t *testing.T, ctx context.Context, id uuid.UUID, role models.Role) context.Context {
+	token, err := signToken(db, id, role)
+	if err != nil {
+		t.Fatalf("Failed to sign token: %v", err)
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+}
+
+// userBalanceQuery selects balance of user in the default currency. User without the balance has no money.
+const userBalanceQuery = "SELECT COALESCE(SUM(balance), 0) FROM UserBalances WHERE userID = $1 AND currency = 'USD'"
+
+// userAvailableQuery selects the part of balance of user in the default currency, which isn't held.
+const userAvailableQuery = "SELECT COALESCE(SUM(balance - held), 0) FROM UserBalances WHERE userID = $1 AND currency = 'USD'"
+
+func money(amount float64) models.Money {
+	return models.MoneyFromFloat(amount)
+}
+
+func usd(amount float64) []models.Balance {
+	return []models.Balance{{Currency: models.DefaultCurrency, Amount: money(amount)}}
+}
+
 func createTournament(t *testing.T, db *sql.DB, tournament *models.Tournament) *models.Tournament {
 	if err := db.QueryRow("INSERT INTO Tournaments(name, deposit, prize, status) VALUES ($1, $2, $3, $4) RETURNING id",
 		tournament.Name,
@@ -63,8 +136,23 @@ func createTournament(t *testing.T, db *sql.DB, tournament *models.Tournament) *
 
 func createUser(t *testing.T, db *sql.DB, user *models.User) *models.User {
 	user.Password = "testpassword"
-	if err := db.QueryRow("INSERT INTO Users(name, balance, password) VALUES ($1, $2, $3) RETURNING id",
-		user.Name, user.Balance, user.Password).Scan(&user.ID); err != nil {
+	const query = `
+		WITH created AS (
+			INSERT INTO Users(name, password) VALUES ($1, $3) RETURNING id
+		), balance AS (
+			INSERT INTO UserBalances(userID, currency, balance) SELECT id, 'USD', $2 FROM created WHERE $2 <> 0
+		), opening AS (
+			INSERT INTO LedgerTransactions(type, currency) SELECT 'Adjustment', 'USD' WHERE $2 <> 0 RETURNING id
+		), entries AS (
+			INSERT INTO LedgerEntries(transactionID, accountType, accountID, amount)
+			SELECT opening.id, 'User', created.id, $2 FROM opening, created
+			UNION ALL
+			SELECT opening.id, 'External', NULL, -$2 FROM opening
+		)
+		SELECT id FROM created;
+	`
+
+	if err := db.QueryRow(query, user.Name, user.BalanceIn(models.DefaultCurrency), user.Password).Scan(&user.ID); err != nil {
 		t.Fatalf("Failed insert user in database(%v): %v", user.Name, err)
 	}
 
@@ -19,22 +19,22 @@ func TestJoinToTournament(t *testing.T) {
 
 	activeTournament := createTournament(t, db, &models.Tournament{
 		Name:    "join tournament",
-		Deposit: 1000,
-		Status:  models.Active,
+		Deposit: money(1000),
+		Status:  models.RegistrationOpen,
 	})
 	notActiveTournament := createTournament(t, db, &models.Tournament{
 		Name:    "not active tournament",
-		Deposit: 10,
-		Status:  models.Finish,
+		Deposit: money(10),
+		Status:  models.Finished,
 	})
 
 	okUser := createUser(t, db, &models.User{
-		Name:    "joinOK",
-		Balance: 1500,
+		Name:     "joinOK",
+		Balances: usd(1500),
 	})
 	withoutDepositUser := createUser(t, db, &models.User{
-		Name:    "without deposit user",
-		Balance: 500,
+		Name:     "without deposit user",
+		Balances: usd(500),
 	})
 
 	tt := []struct {
@@ -48,14 +48,18 @@ func TestJoinToTournament(t *testing.T) {
 			name:           "Success",
 			wantTournament: activeTournament,
 			wantUser:       okUser,
-			wantPrize:      1000,
 			code:           codes.OK,
 		},
+		{
+			name:           "already joined user",
+			wantTournament: activeTournament,
+			wantUser:       okUser,
+			code:           codes.AlreadyExists,
+		},
 		{
 			name:           "user without deposit",
 			wantTournament: activeTournament,
 			wantUser:       withoutDepositUser,
-			wantPrize:      1000,
 			code:           codes.FailedPrecondition,
 		},
 		{
@@ -74,22 +78,23 @@ func TestJoinToTournament(t *testing.T) {
 				return
 			}
 
-			var actualBalance float64
-			if err := db.QueryRow("SELECT balance FROM Users WHERE id = $1", tc.wantUser.ID).Scan(&actualBalance); err != nil {
+			var actualBalance, actualAvailable models.Money
+			if err := db.QueryRow(userBalanceQuery, tc.wantUser.ID).Scan(&actualBalance); err != nil {
 				t.Fatalf("Failed select balance of the want user from database: %v", err)
 			}
-			assert.Equalf(t, tc.wantUser.Balance-tc.wantTournament.Deposit, actualBalance,
-				"New balance should be old balance(%v) - deposit of tournament(%v)", tc.wantUser.Balance, tc.wantTournament.Deposit,
+			if err := db.QueryRow(userAvailableQuery, tc.wantUser.ID).Scan(&actualAvailable); err != nil {
+				t.Fatalf("Failed select available balance of the want user from database: %v", err)
+			}
+			assert.Equal(t, tc.wantUser.BalanceIn(models.DefaultCurrency), actualBalance, "Balance shouldn't be charged before start")
+			assert.Equalf(t, tc.wantUser.BalanceIn(models.DefaultCurrency).Sub(tc.wantTournament.Deposit), actualAvailable,
+				"New available balance should be old balance(%v) - deposit of tournament(%v)", tc.wantUser.BalanceIn(models.DefaultCurrency), tc.wantTournament.Deposit,
 			)
 
-			var actualPrize float64
+			var actualPrize models.Money
 			if err := db.QueryRow("SELECT prize FROM Tournaments WHERE id = $1", tc.wantTournament.ID).Scan(&actualPrize); err != nil {
 				t.Fatalf("Failed select prize from tournament: %v", err)
 			}
-			assert.Equalf(t, tc.wantPrize, actualPrize,
-				"New prize should be old prize + deposit(%v)",
-				tc.wantTournament.Deposit,
-			)
+			assert.Equal(t, tc.wantTournament.Prize, actualPrize, "Prize shouldn't get held deposit")
 
 			var joinerID uuid.UUID
 			if err := db.QueryRow("SELECT id FROM UsersOfTournaments WHERE tournamentID = $1 AND userID = $2", tc.wantTournament.ID, tc.wantUser.ID).Scan(&joinerID); err != nil {
@@ -0,0 +1,107 @@
+// +build integration
+
+package itest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	tgrpc "github.com/kimbellG/tournament/core/handler/grpc"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestLeaveTournament(t *testing.T) {
+	client := tgrpc.NewTournamentServiceClient(conn)
+
+	created, err := client.CreateTournament(context.Background(), &tgrpc.CreateTournamentRequest{
+		Name:              "leave tournament",
+		Deposit:           100,
+		RakeType:          string(models.PercentageRake),
+		Rake:              10,
+		WithdrawalPenalty: 20,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create tournament: %v", err)
+	}
+
+	_, err = client.CreateTournament(context.Background(), &tgrpc.CreateTournamentRequest{
+		Name:              "invalid penalty tournament",
+		Deposit:           100,
+		WithdrawalPenalty: 120,
+	})
+	assertGrpcError(t, codes.InvalidArgument, err)
+
+	houseBefore := houseBalance(t)
+
+	var users []*models.User
+	for i := 0; i < 3; i++ {
+		user := createUser(t, db, &models.User{Name: fmt.Sprintf("leave user %d", i), Balances: usd(500)})
+		users = append(users, user)
+	}
+
+	for _, user := range users[:2] {
+		if _, err := client.JoinTournament(context.Background(), &tgrpc.JoinRequest{
+			TournamentID: created.GetId(),
+			UserID:       user.ID.String(),
+		}); err != nil {
+			t.Fatalf("Failed to join tournament: %v", err)
+		}
+	}
+
+	leave := func(user *models.User) error {
+		_, err := client.LeaveTournament(context.Background(), &tgrpc.ParticipantRequest{
+			TournamentID: created.GetId(),
+			UserID:       user.ID.String(),
+		})
+		return err
+	}
+
+	if err := leave(users[0]); err != nil {
+		t.Fatalf("Failed to leave tournament: %v", err)
+	}
+	assertGrpcError(t, codes.NotFound, leave(users[0]))
+	assertGrpcError(t, codes.NotFound, leave(users[2]))
+
+	var balance float64
+	if err := db.QueryRow(userBalanceQuery, users[0].ID).Scan(&balance); err != nil {
+		t.Fatalf("Failed to select balance of user: %v", err)
+	}
+	assert.Equal(t, float64(480), balance, "stake without penalty should be refunded")
+
+	tournament, err := client.GetTournamentByID(context.Background(), &tgrpc.TournamentRequest{Id: created.GetId()})
+	if err != nil {
+		t.Fatalf("Failed to get tournament: %v", err)
+	}
+	assert.Equal(t, []string{users[1].ID.String()}, tournament.GetUsers(), "left user shouldn't be participant")
+	assert.Equal(t, float64(20), tournament.GetPrize(), "penalty should be charged into prize")
+	assert.Zero(t, tournament.GetRakeCollected(), "rake shouldn't be collected before start")
+	assert.Equal(t, houseBefore, houseBalance(t), "house shouldn't get rake before start")
+
+	if _, err := client.CancelTournament(context.Background(), &tgrpc.TournamentRequest{Id: created.GetId()}); err != nil {
+		t.Fatalf("Failed to cancel tournament: %v", err)
+	}
+	assertGrpcError(t, codes.InvalidArgument, leave(users[1]))
+
+	if err := db.QueryRow(userAvailableQuery, users[1].ID).Scan(&balance); err != nil {
+		t.Fatalf("Failed to select available balance of user: %v", err)
+	}
+	assert.Equal(t, float64(500), balance, "hold should be released on cancel")
+
+	var pool float64
+	if err := db.QueryRow(
+		"SELECT COALESCE(SUM(amount), 0) FROM LedgerEntries WHERE accountType = 'Tournament' AND accountID = $1", created.GetId(),
+	).Scan(&pool); err != nil {
+		t.Fatalf("Failed to select pool of tournament: %v", err)
+	}
+	assert.Zero(t, pool, "tournament account should be empty after cancel")
+
+	tournament, err = client.GetTournamentByID(context.Background(), &tgrpc.TournamentRequest{Id: created.GetId()})
+	if err != nil {
+		t.Fatalf("Failed to get tournament: %v", err)
+	}
+	assert.Zero(t, tournament.GetPrize(), "prize pool should be empty after cancel")
+	assert.Equal(t, houseBefore+20, houseBalance(t), "house should keep penalty of cancelled tournament")
+}
@@ -0,0 +1,94 @@
+// +build integration
+
+package itest
+
+import (
+	"context"
+	"testing"
+
+	tgrpc "github.com/kimbellG/tournament/core/handler/grpc"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestLedger(t *testing.T) {
+	client := tgrpc.NewTournamentServiceClient(conn)
+
+	created, err := client.CreateTournament(context.Background(), &tgrpc.CreateTournamentRequest{
+		Name:     "ledger tournament",
+		Deposit:  100,
+		RakeType: string(models.PercentageRake),
+		Rake:     10,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create tournament: %v", err)
+	}
+
+	_, err = client.SaveUser(context.Background(), &tgrpc.User{Name: "rich ledger user", Balance: 500})
+	assertGrpcError(t, codes.InvalidArgument, err)
+
+	var userIDs []string
+	for _, name := range []string{"ledger user 0", "ledger user 1"} {
+		saved, err := client.SaveUser(context.Background(), &tgrpc.User{Name: name})
+		if err != nil {
+			t.Fatalf("Failed to save user: %v", err)
+		}
+		userIDs = append(userIDs, saved.GetId())
+
+		for _, addend := range []float64{500, 50} {
+			if _, err := client.SumToBalance(context.Background(), &tgrpc.RequestToUpdateBalance{ID: saved.GetId(), Addend: addend}); err != nil {
+				t.Fatalf("Failed to fund user: %v", err)
+			}
+		}
+
+		if _, err := client.JoinTournament(context.Background(), &tgrpc.JoinRequest{
+			TournamentID: created.GetId(),
+			UserID:       saved.GetId(),
+		}); err != nil {
+			t.Fatalf("Failed to join tournament: %v", err)
+		}
+	}
+
+	if _, err := client.FinishTournament(context.Background(), &tgrpc.FinishRequest{Id: created.GetId()}); err != nil {
+		t.Fatalf("Failed to finish tournament: %v", err)
+	}
+
+	var unbalanced int
+	if err := db.QueryRow(`
+		SELECT COUNT(*) FROM (
+			SELECT transactionID FROM LedgerEntries GROUP BY transactionID HAVING SUM(amount) <> 0
+		) AS unbalanced`).Scan(&unbalanced); err != nil {
+		t.Fatalf("Failed to select unbalanced transactions: %v", err)
+	}
+	assert.Zero(t, unbalanced, "every ledger transaction should be balanced")
+
+	var pool float64
+	if err := db.QueryRow("SELECT COALESCE(SUM(amount), 0) FROM LedgerEntries WHERE accountType = 'Tournament' AND accountID = $1",
+		created.GetId()).Scan(&pool); err != nil {
+		t.Fatalf("Failed to select balance of prize pool: %v", err)
+	}
+	assert.Zero(t, pool, "paid out prize pool should be empty")
+
+	var total float64
+	for _, userID := range userIDs {
+		var balance, ledgerBalance float64
+		if err := db.QueryRow(`
+			SELECT balance, (
+				SELECT SUM(amount) FROM LedgerEntries INNER JOIN LedgerTransactions ON transactionID = LedgerTransactions.id
+				WHERE accountType = 'User' AND accountID = UserBalances.userID AND LedgerTransactions.currency = UserBalances.currency
+			)
+			FROM UserBalances WHERE userID = $1 AND currency = 'USD'`, userID).Scan(&balance, &ledgerBalance); err != nil {
+			t.Fatalf("Failed to select balance of user: %v", err)
+		}
+		assert.Equal(t, ledgerBalance, balance, "balance of user should match the ledger")
+		total += balance
+	}
+	assert.Equal(t, float64(1080), total, "users should lose only rake")
+
+	_, err = db.Exec("UPDATE LedgerEntries SET amount = amount * 2 WHERE accountType = 'User' AND accountID = $1", userIDs[0])
+	assert.Error(t, err, "ledger entries should be immutable")
+
+	_, err = db.Exec("UPDATE UserBalances SET balance = balance + 1 WHERE userID = $1", userIDs[0])
+	assert.Error(t, err, "balance shouldn't change without ledger entry")
+}
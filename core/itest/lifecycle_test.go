@@ -0,0 +1,73 @@
+// +build integration
+
+package itest
+
+import (
+	"context"
+	"testing"
+
+	tgrpc "github.com/kimbellG/tournament/core/handler/grpc"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestTournamentLifecycle(t *testing.T) {
+	client := tgrpc.NewTournamentServiceClient(conn)
+
+	created, err := client.CreateTournament(context.Background(), &tgrpc.CreateTournamentRequest{
+		Name:    "lifecycle tournament",
+		Deposit: 100,
+		Draft:   true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create tournament: %v", err)
+	}
+	request := &tgrpc.TournamentRequest{Id: created.GetId()}
+
+	user := createUser(t, db, &models.User{Name: "lifecycle user", Balances: usd(1000)})
+	join := func() error {
+		_, err := client.JoinTournament(context.Background(), &tgrpc.JoinRequest{
+			TournamentID: created.GetId(),
+			UserID:       user.ID.String(),
+		})
+		return err
+	}
+
+	assertStatus := func(want models.TournamentStatus) {
+		tournament, err := client.GetTournamentByID(context.Background(), request)
+		if err != nil {
+			t.Fatalf("Failed to get tournament: %v", err)
+		}
+		assert.Equal(t, string(want), tournament.GetStatus(), "unexpected status of tournament")
+	}
+
+	assertStatus(models.Draft)
+	assertGrpcError(t, codes.InvalidArgument, join())
+
+	_, err = client.CloseRegistration(context.Background(), request)
+	assertGrpcError(t, codes.InvalidArgument, err)
+
+	if _, err := client.OpenRegistration(context.Background(), request); err != nil {
+		t.Fatalf("Failed to open registration: %v", err)
+	}
+	assertStatus(models.RegistrationOpen)
+
+	if err := join(); err != nil {
+		t.Fatalf("Failed to join tournament with open registration: %v", err)
+	}
+
+	if _, err := client.CloseRegistration(context.Background(), request); err != nil {
+		t.Fatalf("Failed to close registration: %v", err)
+	}
+	assertStatus(models.RegistrationClosed)
+	assertGrpcError(t, codes.InvalidArgument, join())
+
+	if _, err := client.CancelTournament(context.Background(), request); err != nil {
+		t.Fatalf("Failed to cancel tournament: %v", err)
+	}
+	assertStatus(models.Cancelled)
+
+	_, err = client.OpenRegistration(context.Background(), request)
+	assertGrpcError(t, codes.InvalidArgument, err)
+}
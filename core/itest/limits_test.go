@@ -0,0 +1,89 @@
+// +build integration
+
+package itest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	tgrpc "github.com/kimbellG/tournament/core/handler/grpc"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestPlayerLimits(t *testing.T) {
+	client := tgrpc.NewTournamentServiceClient(conn)
+
+	created, err := client.CreateTournament(context.Background(), &tgrpc.CreateTournamentRequest{
+		Name:       "limited tournament",
+		Deposit:    100,
+		MinPlayers: 3,
+		MaxPlayers: 4,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create tournament: %v", err)
+	}
+
+	_, err = client.CreateTournament(context.Background(), &tgrpc.CreateTournamentRequest{
+		Name:       "invalid limits tournament",
+		Deposit:    100,
+		MinPlayers: 5,
+		MaxPlayers: 4,
+	})
+	assertGrpcError(t, codes.InvalidArgument, err)
+
+	var users []*models.User
+	for i := 0; i < 5; i++ {
+		users = append(users, createUser(t, db, &models.User{Name: fmt.Sprintf("limited user %d", i), Balances: usd(500)}))
+	}
+
+	join := func(tournamentID string, user *models.User) error {
+		_, err := client.JoinTournament(context.Background(), &tgrpc.JoinRequest{
+			TournamentID: tournamentID,
+			UserID:       user.ID.String(),
+		})
+		return err
+	}
+
+	for _, user := range users[:2] {
+		if err := join(created.GetId(), user); err != nil {
+			t.Fatalf("Failed to join tournament: %v", err)
+		}
+	}
+
+	if _, err := client.FinishTournament(context.Background(), &tgrpc.FinishRequest{Id: created.GetId()}); err != nil {
+		t.Fatalf("Failed to finish tournament: %v", err)
+	}
+
+	tournament, err := client.GetTournamentByID(context.Background(), &tgrpc.TournamentRequest{Id: created.GetId()})
+	if err != nil {
+		t.Fatalf("Failed to get tournament: %v", err)
+	}
+	assert.Equal(t, string(models.Cancelled), tournament.GetStatus(), "tournament below minimum should be cancelled")
+
+	for _, user := range users[:2] {
+		var balance models.Money
+		if err := db.QueryRow(userBalanceQuery, user.ID).Scan(&balance); err != nil {
+			t.Fatalf("Failed to select balance of user: %v", err)
+		}
+		assert.Equal(t, user.BalanceIn(models.DefaultCurrency), balance, "entry should be refunded")
+	}
+
+	full, err := client.CreateTournament(context.Background(), &tgrpc.CreateTournamentRequest{
+		Name:       "full tournament",
+		Deposit:    100,
+		MaxPlayers: 2,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create tournament: %v", err)
+	}
+
+	for _, user := range users[2:4] {
+		if err := join(full.GetId(), user); err != nil {
+			t.Fatalf("Failed to join tournament: %v", err)
+		}
+	}
+	assertGrpcError(t, codes.ResourceExhausted, join(full.GetId(), users[4]))
+}
@@ -0,0 +1,153 @@
+// +build integration
+
+package itest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	tgrpc "github.com/kimbellG/tournament/core/handler/grpc"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestSingleEliminationBracket(t *testing.T) {
+	client := tgrpc.NewTournamentServiceClient(conn)
+
+	tournament := createTournament(t, db, &models.Tournament{
+		Name:    "bracket tournament",
+		Deposit: money(100),
+		Prize:   money(400),
+		Status:  models.RegistrationOpen,
+	})
+
+	var users []*models.User
+	for i := 0; i < 4; i++ {
+		user := createUser(t, db, &models.User{
+			Name:     fmt.Sprintf("bracket user %d", i),
+			Balances: usd(0),
+		})
+		users = append(users, user)
+
+		if _, err := db.Exec("INSERT INTO UsersOfTournaments(tournamentID, userID) VALUES($1, $2)", tournament.ID, user.ID); err != nil {
+			t.Fatalf("Failed to join user to tournament: %v", err)
+		}
+	}
+
+	if _, err := client.StartTournament(context.Background(), &tgrpc.TournamentRequest{Id: tournament.ID.String()}); err != nil {
+		t.Fatalf("Failed to start tournament: %v", err)
+	}
+
+	_, err := client.StartTournament(context.Background(), &tgrpc.TournamentRequest{Id: tournament.ID.String()})
+	assertGrpcError(t, codes.InvalidArgument, err)
+
+	var winner string
+	for round := 1; round <= 2; round++ {
+		resp, err := client.GetMatches(context.Background(), &tgrpc.TournamentRequest{Id: tournament.ID.String()})
+		if err != nil {
+			t.Fatalf("Failed to get matches: %v", err)
+		}
+		assert.Equal(t, 3, len(resp.GetMatches()), "bracket of 4 players should have 3 matches")
+
+		for _, match := range resp.GetMatches() {
+			if int(match.GetRound()) != round {
+				continue
+			}
+
+			winner = match.GetFirstUser()
+			if _, err := client.ReportMatchResult(context.Background(), &tgrpc.MatchResultRequest{
+				TournamentID: tournament.ID.String(),
+				MatchID:      match.GetId(),
+				WinnerID:     winner,
+			}); err != nil {
+				t.Fatalf("Failed to report result of match(round: %v): %v", round, err)
+			}
+		}
+	}
+
+	var (
+		actualWinner string
+		status       models.TournamentStatus
+	)
+	if err := db.QueryRow("SELECT winner, status FROM Tournaments WHERE id = $1", tournament.ID).Scan(&actualWinner, &status); err != nil {
+		t.Fatalf("Failed to select result of tournament: %v", err)
+	}
+
+	assert.Equal(t, winner, actualWinner, "winner of final should be winner of tournament")
+	assert.Equal(t, models.Finished, status, "tournament should be finished after final")
+
+	var balance models.Money
+	if err := db.QueryRow(userBalanceQuery, actualWinner).Scan(&balance); err != nil {
+		t.Fatalf("Failed to select balance of winner: %v", err)
+	}
+	assert.Equal(t, tournament.Prize, balance, "winner should receive prize of tournament")
+}
+
+func TestRoundRobinStandings(t *testing.T) {
+	client := tgrpc.NewTournamentServiceClient(conn)
+
+	tournament := createTournament(t, db, &models.Tournament{
+		Name:    "round robin tournament",
+		Deposit: money(100),
+		Prize:   money(300),
+		Status:  models.RegistrationOpen,
+	})
+	if _, err := db.Exec("UPDATE Tournaments SET format = $1 WHERE id = $2", models.RoundRobin, tournament.ID); err != nil {
+		t.Fatalf("Failed to set format of tournament: %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		user := createUser(t, db, &models.User{
+			Name: fmt.Sprintf("round robin user %d", i),
+		})
+
+		if _, err := db.Exec("INSERT INTO UsersOfTournaments(tournamentID, userID) VALUES($1, $2)", tournament.ID, user.ID); err != nil {
+			t.Fatalf("Failed to join user to tournament: %v", err)
+		}
+	}
+
+	if _, err := client.StartTournament(context.Background(), &tgrpc.TournamentRequest{Id: tournament.ID.String()}); err != nil {
+		t.Fatalf("Failed to start tournament: %v", err)
+	}
+
+	_, err := client.FinishTournament(context.Background(), &tgrpc.FinishRequest{Id: tournament.ID.String()})
+	assertGrpcError(t, codes.InvalidArgument, err)
+
+	resp, err := client.GetMatches(context.Background(), &tgrpc.TournamentRequest{Id: tournament.ID.String()})
+	if err != nil {
+		t.Fatalf("Failed to get matches: %v", err)
+	}
+	assert.Equal(t, 3, len(resp.GetMatches()), "every pair of 3 players should meet")
+
+	for i, match := range resp.GetMatches() {
+		result := &tgrpc.MatchResultRequest{
+			TournamentID: tournament.ID.String(),
+			MatchID:      match.GetId(),
+			WinnerID:     match.GetFirstUser(),
+		}
+		if i == 0 {
+			result.WinnerID, result.Draw = "", true
+		}
+
+		if _, err := client.ReportMatchResult(context.Background(), result); err != nil {
+			t.Fatalf("Failed to report result of match: %v", err)
+		}
+	}
+
+	standings, err := client.GetStandings(context.Background(), &tgrpc.TournamentRequest{Id: tournament.ID.String()})
+	if err != nil {
+		t.Fatalf("Failed to get standings: %v", err)
+	}
+
+	if _, err := client.FinishTournament(context.Background(), &tgrpc.FinishRequest{Id: tournament.ID.String()}); err != nil {
+		t.Fatalf("Failed to finish tournament: %v", err)
+	}
+
+	var winner string
+	if err := db.QueryRow("SELECT winner FROM Tournaments WHERE id = $1", tournament.ID).Scan(&winner); err != nil {
+		t.Fatalf("Failed to select winner of tournament: %v", err)
+	}
+	assert.Equal(t, standings.GetStandings()[0].GetUserID(), winner, "leader of standings should win tournament")
+}
@@ -0,0 +1,62 @@
+// +build integration
+
+package itest
+
+import (
+	"context"
+	"testing"
+
+	tgrpc "github.com/kimbellG/tournament/core/handler/grpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExactMoney(t *testing.T) {
+	client := tgrpc.NewTournamentServiceClient(conn)
+
+	created, err := client.CreateTournament(context.Background(), &tgrpc.CreateTournamentRequest{
+		Name:         "exact money tournament",
+		DepositMoney: &tgrpc.Money{Units: 10, Nanos: 10000000},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create tournament: %v", err)
+	}
+
+	saved, err := client.SaveUser(context.Background(), &tgrpc.User{Name: "exact money user"})
+	if err != nil {
+		t.Fatalf("Failed to save user: %v", err)
+	}
+
+	if _, err := client.SumToBalance(context.Background(), &tgrpc.RequestToUpdateBalance{
+		ID:          saved.GetId(),
+		AddendMoney: &tgrpc.Money{Units: 20, Nanos: 500000000},
+	}); err != nil {
+		t.Fatalf("Failed to fund user: %v", err)
+	}
+
+	if _, err := client.SumToBalance(context.Background(), &tgrpc.RequestToUpdateBalance{ID: saved.GetId(), Addend: 0.1}); err != nil {
+		t.Fatalf("Failed to fund user by double: %v", err)
+	}
+
+	if _, err := client.JoinTournament(context.Background(), &tgrpc.JoinRequest{
+		TournamentID: created.GetId(),
+		UserID:       saved.GetId(),
+	}); err != nil {
+		t.Fatalf("Failed to join tournament: %v", err)
+	}
+
+	user, err := client.GetUserByID(context.Background(), &tgrpc.UserRequest{ID: saved.GetId()})
+	if err != nil {
+		t.Fatalf("Failed to get user: %v", err)
+	}
+	assert.Equal(t, int64(10), user.GetAvailableBalanceMoney().GetUnits(), "available balance should be exact")
+	assert.Equal(t, int32(590000000), user.GetAvailableBalanceMoney().GetNanos(), "available balance should be exact")
+	assert.Equal(t, 20.6, user.GetBalance(), "double balance should be kept for older clients")
+
+	tournament, err := client.GetTournamentByID(context.Background(), &tgrpc.TournamentRequest{Id: created.GetId()})
+	if err != nil {
+		t.Fatalf("Failed to get tournament: %v", err)
+	}
+	assert.Equal(t, int64(10), tournament.GetDepositMoney().GetUnits(), "deposit should be exact")
+	assert.Equal(t, int32(10000000), tournament.GetDepositMoney().GetNanos(), "deposit should be exact")
+	assert.Equal(t, 10.01, tournament.GetDeposit(), "double deposit should be kept for older clients")
+}
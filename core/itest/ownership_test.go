@@ -0,0 +1,59 @@
+// +build integration
+
+package itest
+
+import (
+	"context"
+	"testing"
+
+	tgrpc "github.com/kimbellG/tournament/core/handler/grpc"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestOwnership(t *testing.T) {
+	client := tgrpc.NewTournamentServiceClient(conn)
+	owner := createUser(t, db, &models.User{Name: "account owner", Balances: usd(100)})
+	stranger := createUser(t, db, &models.User{Name: "account stranger", Balances: usd(100)})
+	asOwner := asUser(t, context.Background(), owner.ID, models.PlayerRole)
+
+	if _, err := client.GetUserByID(asOwner, &tgrpc.UserRequest{ID: owner.ID.String()}); err != nil {
+		t.Errorf("Owner should get own account: %v", err)
+	}
+
+	_, err := client.GetUserByID(asOwner, &tgrpc.UserRequest{ID: stranger.ID.String()})
+	assertGrpcError(t, codes.PermissionDenied, err)
+
+	_, err = client.SumToBalance(asOwner, &tgrpc.RequestToUpdateBalance{ID: stranger.ID.String(), Addend: 10})
+	assertGrpcError(t, codes.PermissionDenied, err)
+
+	_, err = client.SumToBalance(asOwner, &tgrpc.RequestToUpdateBalance{ID: stranger.ID.String(), Addend: -10})
+	assertGrpcError(t, codes.PermissionDenied, err)
+
+	if _, err := client.GetUserByID(context.Background(), &tgrpc.UserRequest{ID: stranger.ID.String()}); err != nil {
+		t.Errorf("Admin should get account of any user: %v", err)
+	}
+}
+
+func TestJoinAsCaller(t *testing.T) {
+	client := tgrpc.NewTournamentServiceClient(conn)
+	player := createUser(t, db, &models.User{Name: "joining caller", Balances: usd(100)})
+	other := createUser(t, db, &models.User{Name: "joined by other", Balances: usd(100)})
+	tournament := createTournament(t, db, &models.Tournament{Name: "tournament of callers", Deposit: money(10), Status: models.RegistrationOpen})
+	asPlayer := asUser(t, context.Background(), player.ID, models.PlayerRole)
+
+	_, err := client.JoinTournament(asPlayer, &tgrpc.JoinRequest{TournamentID: tournament.ID.String(), UserID: other.ID.String()})
+	assertGrpcError(t, codes.PermissionDenied, err)
+
+	if _, err := client.JoinTournament(asPlayer, &tgrpc.JoinRequest{TournamentID: tournament.ID.String()}); err != nil {
+		t.Fatalf("Player should join as caller: %v", err)
+	}
+
+	var joined bool
+	if err := db.QueryRow("SELECT EXISTS(SELECT 1 FROM UsersOfTournaments WHERE tournamentID = $1 AND userID = $2)",
+		tournament.ID, player.ID).Scan(&joined); err != nil {
+		t.Fatalf("Failed to check participants: %v", err)
+	}
+	assert.True(t, joined, "caller should be joined")
+}
@@ -0,0 +1,79 @@
+// +build integration
+
+package itest
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	tgrpc "github.com/kimbellG/tournament/core/handler/grpc"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestPasswordReset(t *testing.T) {
+	client := tgrpc.NewTournamentServiceClient(conn)
+
+	created, err := client.SaveUser(context.Background(), &tgrpc.User{Name: "forgetful user", Email: "forgetful@example.com"})
+	if err != nil {
+		t.Fatalf("Failed to save user: %v", err)
+	}
+
+	countResets := func() int {
+		var count int
+		if err := db.QueryRow("SELECT COUNT(*) FROM PasswordResets WHERE userID = $1", created.GetId()).Scan(&count); err != nil {
+			t.Fatalf("Failed to count password resets: %v", err)
+		}
+
+		return count
+	}
+
+	if _, err := client.RequestPasswordReset(context.Background(), &tgrpc.PasswordResetRequest{Username: "forgetful user"}); err != nil {
+		t.Fatalf("Failed to request password reset: %v", err)
+	}
+	assert.Equal(t, 1, countResets(), "reset token should be issued")
+
+	_, err = client.RequestPasswordReset(context.Background(), &tgrpc.PasswordResetRequest{Username: "nobody"})
+	assert.NoError(t, err, "request for unknown user shouldn't reveal it")
+
+	// The issued token is only known to the notifier, so the test adds its own one.
+	const token = "known reset token"
+	if _, err := db.Exec("INSERT INTO PasswordResets(tokenHash, userID, expiresAt) VALUES ($1, $2, $3)",
+		fmt.Sprintf("%x", sha256.Sum256([]byte(token))), created.GetId(), time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("Failed to insert password reset: %v", err)
+	}
+
+	resetPassword := func(token, password string) error {
+		_, err := client.ResetPassword(context.Background(), &tgrpc.ResetPasswordRequest{Token: token, NewPassword: password})
+		return err
+	}
+
+	session := asUser(t, context.Background(), uuid.MustParse(created.GetId()), models.PlayerRole)
+	if _, err := client.Authenticate(session, &emptypb.Empty{}); err != nil {
+		t.Fatalf("Failed to authenticate within session: %v", err)
+	}
+
+	assertGrpcError(t, codes.InvalidArgument, resetPassword(token, "weak"))
+	assertGrpcError(t, codes.InvalidArgument, resetPassword("unknown token", "Recovered password 1"))
+
+	if err := resetPassword(token, "Recovered password 1"); err != nil {
+		t.Fatalf("Failed to reset password: %v", err)
+	}
+	assert.Equal(t, 0, countResets(), "reset should use up all tokens of user")
+	assertGrpcError(t, codes.InvalidArgument, resetPassword(token, "Recovered password 2"))
+
+	_, err = client.Authenticate(session, &emptypb.Empty{})
+	assertGrpcError(t, codes.Unauthenticated, err)
+
+	_, err = client.UserAuthorization(context.Background(), &tgrpc.AuthorizationRequest{
+		Username: "forgetful user",
+		Password: fmt.Sprintf("%x", sha256.Sum256([]byte("Recovered password 1"))),
+	})
+	assert.NoError(t, err, "user should log in with the new password")
+}
@@ -0,0 +1,59 @@
+// +build integration
+
+package itest
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	tgrpc "github.com/kimbellG/tournament/core/handler/grpc"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestChosenPassword(t *testing.T) {
+	client := tgrpc.NewTournamentServiceClient(conn)
+
+	_, err := client.SaveUser(context.Background(), &tgrpc.User{Name: "weak password user", Password: "short"})
+	assertGrpcError(t, codes.InvalidArgument, err)
+
+	created, err := client.SaveUser(context.Background(), &tgrpc.User{Name: "password user", Password: "Chosen password 1"})
+	if err != nil {
+		t.Fatalf("Failed to save user with chosen password: %v", err)
+	}
+	assert.Empty(t, created.GetPassword(), "chosen password shouldn't be returned")
+
+	logIn := func(password string) error {
+		_, err := client.UserAuthorization(context.Background(), &tgrpc.AuthorizationRequest{
+			Username: "password user",
+			Password: fmt.Sprintf("%x", sha256.Sum256([]byte(password))),
+		})
+		return err
+	}
+	changePassword := func(oldPassword, newPassword string) error {
+		_, err := client.ChangePassword(context.Background(), &tgrpc.ChangePasswordRequest{
+			UserID:      created.GetId(),
+			OldPassword: oldPassword,
+			NewPassword: newPassword,
+		})
+		return err
+	}
+
+	if err := logIn("Chosen password 1"); err != nil {
+		t.Fatalf("Failed to log in with chosen password: %v", err)
+	}
+
+	assertGrpcError(t, codes.Unauthenticated, changePassword("wrong password", "Changed password 2"))
+	assertGrpcError(t, codes.InvalidArgument, changePassword("Chosen password 1", "weak"))
+
+	if err := changePassword("Chosen password 1", "Changed password 2"); err != nil {
+		t.Fatalf("Failed to change password: %v", err)
+	}
+
+	assertGrpcError(t, codes.Unauthenticated, logIn("Chosen password 1"))
+	if err := logIn("Changed password 2"); err != nil {
+		t.Errorf("Failed to log in with changed password: %v", err)
+	}
+}
@@ -0,0 +1,83 @@
+// +build integration
+
+package itest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	tgrpc "github.com/kimbellG/tournament/core/handler/grpc"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func houseBalance(t *testing.T) float64 {
+	var balance float64
+	if err := db.QueryRow("SELECT COALESCE(SUM(balance), 0) FROM HouseAccount WHERE currency = 'USD'").Scan(&balance); err != nil {
+		t.Fatalf("Failed to select house balance: %v", err)
+	}
+
+	return balance
+}
+
+func TestTournamentRake(t *testing.T) {
+	client := tgrpc.NewTournamentServiceClient(conn)
+
+	created, err := client.CreateTournament(context.Background(), &tgrpc.CreateTournamentRequest{
+		Name:     "rake tournament",
+		Deposit:  100,
+		RakeType: string(models.PercentageRake),
+		Rake:     10,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create tournament: %v", err)
+	}
+
+	houseBefore := houseBalance(t)
+
+	var users []*models.User
+	for i := 0; i < 2; i++ {
+		user := createUser(t, db, &models.User{Name: fmt.Sprintf("rake user %d", i), Balances: usd(500)})
+		users = append(users, user)
+
+		if _, err := client.JoinTournament(context.Background(), &tgrpc.JoinRequest{
+			TournamentID: created.GetId(),
+			UserID:       user.ID.String(),
+		}); err != nil {
+			t.Fatalf("Failed to join tournament: %v", err)
+		}
+	}
+
+	tournament, err := client.GetTournamentByID(context.Background(), &tgrpc.TournamentRequest{Id: created.GetId()})
+	if err != nil {
+		t.Fatalf("Failed to get tournament: %v", err)
+	}
+
+	assert.Zero(t, tournament.GetRakeCollected(), "rake shouldn't be taken from held entries")
+	assert.Equal(t, houseBefore, houseBalance(t), "house shouldn't get rake before start")
+
+	if _, err := client.FinishTournament(context.Background(), &tgrpc.FinishRequest{Id: created.GetId()}); err != nil {
+		t.Fatalf("Failed to finish tournament: %v", err)
+	}
+
+	tournament, err = client.GetTournamentByID(context.Background(), &tgrpc.TournamentRequest{Id: created.GetId()})
+	if err != nil {
+		t.Fatalf("Failed to get tournament: %v", err)
+	}
+
+	assert.Equal(t, 200.0, tournament.GetGrossEntries(), "gross entries should include rake")
+	assert.Equal(t, 20.0, tournament.GetRakeCollected(), "rake should be taken from every entry")
+	assert.Equal(t, 180.0, tournament.GetPrize(), "prize should be net of rake")
+	assert.Equal(t, houseBefore+20, houseBalance(t), "rake should be credited to house")
+
+	var total float64
+	for _, user := range users {
+		var balance float64
+		if err := db.QueryRow(userBalanceQuery, user.ID).Scan(&balance); err != nil {
+			t.Fatalf("Failed to select balance of user: %v", err)
+		}
+		total += balance
+	}
+	assert.Equal(t, 980.0, total, "users should lose only rake")
+}
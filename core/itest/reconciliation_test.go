@@ -0,0 +1,81 @@
+// +build integration
+
+package itest
+
+import (
+	"context"
+	"testing"
+
+	tgrpc "github.com/kimbellG/tournament/core/handler/grpc"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconcile(t *testing.T) {
+	client := tgrpc.NewTournamentServiceClient(conn)
+
+	created, err := client.CreateTournament(context.Background(), &tgrpc.CreateTournamentRequest{
+		Name:    "reconciled tournament",
+		Deposit: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create tournament: %v", err)
+	}
+
+	user := createUser(t, db, &models.User{Name: "reconciled user", Balances: usd(300)})
+	if _, err := client.JoinTournament(context.Background(), &tgrpc.JoinRequest{
+		TournamentID: created.GetId(),
+		UserID:       user.ID.String(),
+	}); err != nil {
+		t.Fatalf("Failed to join tournament: %v", err)
+	}
+
+	if _, err := db.Exec("UPDATE Tournaments SET prize = prize + 10 WHERE id = $1", created.GetId()); err != nil {
+		t.Fatalf("Failed to break prize: %v", err)
+	}
+	if _, err := db.Exec("UPDATE UserBalances SET held = 0 WHERE userID = $1", user.ID); err != nil {
+		t.Fatalf("Failed to break held balance: %v", err)
+	}
+
+	kinds := func(report *tgrpc.ReconciliationReport) map[string]*tgrpc.Discrepancy {
+		found := map[string]*tgrpc.Discrepancy{}
+		for _, discrepancy := range report.GetDiscrepancies() {
+			if discrepancy.GetUserID() == user.ID.String() || discrepancy.GetTournamentID() == created.GetId() {
+				found[discrepancy.GetKind()] = discrepancy
+			}
+		}
+
+		return found
+	}
+
+	report, err := client.Reconcile(context.Background(), &tgrpc.ReconcileRequest{})
+	if err != nil {
+		t.Fatalf("Failed to reconcile: %v", err)
+	}
+
+	found := kinds(report)
+	if assert.Contains(t, found, string(models.PrizeDiscrepancy)) {
+		assert.Equal(t, int64(10), found[string(models.PrizeDiscrepancy)].GetRecorded().GetUnits(), "prize should be reported")
+		assert.Equal(t, int64(0), found[string(models.PrizeDiscrepancy)].GetExpected().GetUnits(), "held stake shouldn't be in prize")
+	}
+	if assert.Contains(t, found, string(models.HeldDiscrepancy)) {
+		assert.Equal(t, int64(100), found[string(models.HeldDiscrepancy)].GetExpected().GetUnits(), "hold should be counted")
+		assert.False(t, found[string(models.HeldDiscrepancy)].GetCorrected(), "report shouldn't correct anything")
+	}
+
+	if _, err := client.Reconcile(context.Background(), &tgrpc.ReconcileRequest{Correct: true}); err != nil {
+		t.Fatalf("Failed to correct discrepancies: %v", err)
+	}
+
+	report, err = client.Reconcile(context.Background(), &tgrpc.ReconcileRequest{})
+	if err != nil {
+		t.Fatalf("Failed to reconcile: %v", err)
+	}
+	assert.Empty(t, kinds(report), "discrepancies should be corrected")
+
+	var available models.Money
+	if err := db.QueryRow(userAvailableQuery, user.ID).Scan(&available); err != nil {
+		t.Fatalf("Failed to select available balance of user: %v", err)
+	}
+	assert.Equal(t, money(200), available, "stake should be held again")
+}
@@ -0,0 +1,87 @@
+// +build integration
+
+package itest
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	tgrpc "github.com/kimbellG/tournament/core/handler/grpc"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestRoles(t *testing.T) {
+	client := tgrpc.NewTournamentServiceClient(conn)
+	tournament := createTournament(t, db, &models.Tournament{Name: "guarded tournament", Deposit: money(10), Status: models.RegistrationOpen})
+	request := &tgrpc.TournamentRequest{Id: tournament.ID.String()}
+
+	player := asUser(t, context.Background(), createUser(t, db, &models.User{Name: "guarded player", Balances: usd(0)}).ID, models.PlayerRole)
+	organizer := asUser(t, context.Background(), createUser(t, db, &models.User{Name: "guarding organizer", Balances: usd(0)}).ID, models.OrganizerRole)
+
+	if _, err := client.GetTournamentByID(player, request); err != nil {
+		t.Errorf("Player should get tournament: %v", err)
+	}
+
+	_, err := client.CancelTournament(player, request)
+	assertGrpcError(t, codes.PermissionDenied, err)
+
+	if _, err := client.CancelTournament(organizer, request); err != nil {
+		t.Errorf("Organizer should cancel tournament: %v", err)
+	}
+
+	_, err = client.Reconcile(organizer, &tgrpc.ReconcileRequest{})
+	assertGrpcError(t, codes.PermissionDenied, err)
+}
+
+func TestCallWithoutToken(t *testing.T) {
+	anonymous, err := grpc.Dial(os.Getenv("SERVICE_ADDRESS"), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("Failed to initializate grpc connection: %v", err)
+	}
+	defer anonymous.Close()
+	client := tgrpc.NewTournamentServiceClient(anonymous)
+
+	_, err = client.GetUserByID(context.Background(), &tgrpc.UserRequest{ID: uuid.New().String()})
+	assertGrpcError(t, codes.Unauthenticated, err)
+
+	_, err = client.UserAuthorization(context.Background(), &tgrpc.AuthorizationRequest{Username: "nobody", Password: "password"})
+	assert.NotEqual(t, codes.Unauthenticated, status.Code(err), "log in shouldn't need token")
+}
+
+func TestSetUserRole(t *testing.T) {
+	client := tgrpc.NewTournamentServiceClient(conn)
+	user := createUser(t, db, &models.User{Name: "future organizer", Balances: usd(0)})
+	setRole := func(ctx context.Context, role string) error {
+		_, err := client.SetUserRole(ctx, &tgrpc.SetRoleRequest{UserID: user.ID.String(), Role: role})
+		return err
+	}
+
+	assertGrpcError(t, codes.PermissionDenied, setRole(asUser(t, context.Background(), user.ID, models.PlayerRole), "admin"))
+	assertGrpcError(t, codes.InvalidArgument, setRole(context.Background(), "king"))
+
+	session := asUser(t, context.Background(), user.ID, models.PlayerRole)
+	if err := setRole(context.Background(), "player"); err != nil {
+		t.Fatalf("Admin should set role: %v", err)
+	}
+	_, err := client.Authenticate(session, &emptypb.Empty{})
+	assert.NoError(t, err, "the same role shouldn't revoke sessions")
+
+	if err := setRole(context.Background(), "organizer"); err != nil {
+		t.Fatalf("Admin should set role: %v", err)
+	}
+
+	_, err = client.Authenticate(session, &emptypb.Empty{})
+	assertGrpcError(t, codes.Unauthenticated, err)
+
+	got, err := client.GetUserByID(context.Background(), &tgrpc.UserRequest{ID: user.ID.String()})
+	if assert.NoError(t, err) {
+		assert.Equal(t, string(models.OrganizerRole), got.GetRole())
+	}
+}
@@ -0,0 +1,115 @@
+// +build integration
+
+package itest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	tgrpc "github.com/kimbellG/tournament/core/handler/grpc"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestDepositLimits(t *testing.T) {
+	client := tgrpc.NewTournamentServiceClient(conn)
+	user := createUser(t, db, &models.User{Name: "deposit limited user", Balances: usd(0)})
+
+	setLimit := func(amount int64) *tgrpc.Limit {
+		limit, err := client.SetLimit(context.Background(), &tgrpc.SetLimitRequest{
+			UserID: user.ID.String(),
+			Kind:   "deposit",
+			Period: "day",
+			Amount: &tgrpc.Money{Units: amount},
+		})
+		if err != nil {
+			t.Fatalf("Failed to set limit: %v", err)
+		}
+
+		return limit
+	}
+	fund := func(addend float64) error {
+		_, err := client.SumToBalance(context.Background(), &tgrpc.RequestToUpdateBalance{ID: user.ID.String(), Addend: addend})
+		return err
+	}
+
+	setLimit(100)
+	if err := fund(60); err != nil {
+		t.Fatalf("Failed to fund user within limit: %v", err)
+	}
+	assertGrpcError(t, codes.PermissionDenied, fund(50))
+
+	raised := setLimit(500)
+	assert.Equal(t, int64(100), raised.GetAmount().GetUnits(), "raised limit shouldn't apply before cooling-off")
+	assert.Equal(t, int64(500), raised.GetPendingAmount().GetUnits(), "raised limit should be pending")
+	assertGrpcError(t, codes.PermissionDenied, fund(50))
+
+	lowered := setLimit(80)
+	assert.Equal(t, int64(80), lowered.GetAmount().GetUnits(), "lowered limit should apply immediately")
+	assert.Nil(t, lowered.GetPendingFrom(), "lowered limit should drop pending raise")
+
+	if err := fund(20); err != nil {
+		t.Fatalf("Failed to fund user within limit: %v", err)
+	}
+	assertGrpcError(t, codes.PermissionDenied, fund(1))
+
+	if err := fund(-30); err != nil {
+		t.Fatalf("Withdrawal shouldn't be limited: %v", err)
+	}
+}
+
+func TestEntryLimitsAndSelfExclusion(t *testing.T) {
+	client := tgrpc.NewTournamentServiceClient(conn)
+
+	created, err := client.CreateTournament(context.Background(), &tgrpc.CreateTournamentRequest{
+		Name:    "entry limited tournament",
+		Deposit: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create tournament: %v", err)
+	}
+
+	join := func(user *models.User) error {
+		_, err := client.JoinTournament(context.Background(), &tgrpc.JoinRequest{
+			TournamentID: created.GetId(),
+			UserID:       user.ID.String(),
+		})
+		return err
+	}
+
+	limited := createUser(t, db, &models.User{Name: "entry limited user", Balances: usd(500)})
+	if _, err := client.SetLimit(context.Background(), &tgrpc.SetLimitRequest{
+		UserID: limited.ID.String(),
+		Kind:   string(models.EntryLimit),
+		Period: string(models.Week),
+		Amount: &tgrpc.Money{Units: 50},
+	}); err != nil {
+		t.Fatalf("Failed to set limit: %v", err)
+	}
+	assertGrpcError(t, codes.PermissionDenied, join(limited))
+
+	excluded := createUser(t, db, &models.User{Name: "self-excluded user", Balances: usd(500)})
+	until := time.Now().Add(time.Hour)
+	if _, err := client.SelfExclude(context.Background(), &tgrpc.SelfExcludeRequest{
+		UserID: excluded.ID.String(),
+		Until:  timestamppb.New(until),
+	}); err != nil {
+		t.Fatalf("Failed to self-exclude user: %v", err)
+	}
+	assertGrpcError(t, codes.PermissionDenied, join(excluded))
+
+	_, err = client.SelfExclude(context.Background(), &tgrpc.SelfExcludeRequest{
+		UserID: excluded.ID.String(),
+		Until:  timestamppb.New(until.Add(-time.Minute)),
+	})
+	assertGrpcError(t, codes.InvalidArgument, err)
+
+	limits, err := client.GetLimits(context.Background(), &tgrpc.UserRequest{ID: excluded.ID.String()})
+	if err != nil {
+		t.Fatalf("Failed to get limits: %v", err)
+	}
+	assert.WithinDuration(t, until, limits.GetExcludedUntil().AsTime(), time.Millisecond, "exclusion shouldn't be shortened")
+}
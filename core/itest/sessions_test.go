@@ -0,0 +1,126 @@
+// +build integration
+
+package itest
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	tgrpc "github.com/kimbellG/tournament/core/handler/grpc"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestSessions(t *testing.T) {
+	client := tgrpc.NewTournamentServiceClient(conn)
+
+	created, err := client.SaveUser(context.Background(), &tgrpc.User{Name: "session user", Password: "Session password 1"})
+	if err != nil {
+		t.Fatalf("Failed to save user: %v", err)
+	}
+
+	password := "Session password 1"
+	logIn := func() *tgrpc.AuthorizationResponse {
+		resp, err := client.UserAuthorization(context.Background(), &tgrpc.AuthorizationRequest{
+			Username: "session user",
+			Password: fmt.Sprintf("%x", sha256.Sum256([]byte(password))),
+		})
+		if err != nil {
+			t.Fatalf("Failed to log in: %v", err)
+		}
+
+		return resp
+	}
+	within := func(session *tgrpc.AuthorizationResponse) context.Context {
+		token, err := sessionToken(session.GetId(), models.Role(session.GetRole()), session.GetSessionID())
+		if err != nil {
+			t.Fatalf("Failed to sign token: %v", err)
+		}
+
+		return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+	}
+	refresh := func(token string) (*tgrpc.AuthorizationResponse, error) {
+		return client.RefreshSession(context.Background(), &tgrpc.RefreshRequest{RefreshToken: token})
+	}
+
+	t.Run("refresh rotates token", func(t *testing.T) {
+		session := logIn()
+		assert.Equal(t, created.GetId(), session.GetId())
+		assert.NotEmpty(t, session.GetRefreshToken())
+
+		refreshed, err := refresh(session.GetRefreshToken())
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, session.GetSessionID(), refreshed.GetSessionID())
+		assert.NotEqual(t, session.GetRefreshToken(), refreshed.GetRefreshToken())
+
+		_, err = client.Authenticate(within(refreshed), &emptypb.Empty{})
+		assert.NoError(t, err)
+
+		_, err = refresh(session.GetRefreshToken())
+		assertGrpcError(t, codes.Unauthenticated, err)
+
+		_, err = client.Authenticate(within(refreshed), &emptypb.Empty{})
+		assertGrpcError(t, codes.Unauthenticated, err)
+		_, err = refresh(refreshed.GetRefreshToken())
+		assertGrpcError(t, codes.Unauthenticated, err)
+	})
+
+	t.Run("logout revokes session", func(t *testing.T) {
+		session := logIn()
+		other := logIn()
+
+		if _, err := client.Logout(within(session), &emptypb.Empty{}); err != nil {
+			t.Fatalf("Failed to log out: %v", err)
+		}
+
+		_, err := client.Authenticate(within(session), &emptypb.Empty{})
+		assertGrpcError(t, codes.Unauthenticated, err)
+		_, err = refresh(session.GetRefreshToken())
+		assertGrpcError(t, codes.Unauthenticated, err)
+
+		_, err = client.Authenticate(within(other), &emptypb.Empty{})
+		assert.NoError(t, err, "logout shouldn't revoke other sessions")
+	})
+
+	t.Run("password change revokes other sessions", func(t *testing.T) {
+		session := logIn()
+		other := logIn()
+
+		if _, err := client.ChangePassword(within(session), &tgrpc.ChangePasswordRequest{
+			UserID:      created.GetId(),
+			OldPassword: password,
+			NewPassword: "Session password 2",
+		}); err != nil {
+			t.Fatalf("Failed to change password: %v", err)
+		}
+		password = "Session password 2"
+
+		_, err := client.Authenticate(within(session), &emptypb.Empty{})
+		assert.NoError(t, err, "password change shouldn't revoke current session")
+
+		_, err = client.Authenticate(within(other), &emptypb.Empty{})
+		assertGrpcError(t, codes.Unauthenticated, err)
+		_, err = refresh(other.GetRefreshToken())
+		assertGrpcError(t, codes.Unauthenticated, err)
+	})
+
+	t.Run("deleted user loses sessions", func(t *testing.T) {
+		session := logIn()
+
+		if _, err := client.DeleteUserByID(context.Background(), &tgrpc.UserRequest{ID: created.GetId()}); err != nil {
+			t.Fatalf("Failed to delete user: %v", err)
+		}
+
+		_, err := client.Authenticate(within(session), &emptypb.Empty{})
+		assertGrpcError(t, codes.Unauthenticated, err)
+		_, err = refresh(session.GetRefreshToken())
+		assertGrpcError(t, codes.Unauthenticated, err)
+	})
+}
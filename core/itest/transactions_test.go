@@ -0,0 +1,112 @@
+// +build integration
+
+package itest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	tgrpc "github.com/kimbellG/tournament/core/handler/grpc"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestListUserTransactions(t *testing.T) {
+	client := tgrpc.NewTournamentServiceClient(conn)
+
+	created, err := client.CreateTournament(context.Background(), &tgrpc.CreateTournamentRequest{
+		Name:              "history tournament",
+		Deposit:           100,
+		WithdrawalPenalty: 20,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create tournament: %v", err)
+	}
+
+	saved, err := client.SaveUser(context.Background(), &tgrpc.User{Name: "history user"})
+	if err != nil {
+		t.Fatalf("Failed to save user: %v", err)
+	}
+
+	for _, addend := range []float64{500, 50, -20} {
+		if _, err := client.SumToBalance(context.Background(), &tgrpc.RequestToUpdateBalance{ID: saved.GetId(), Addend: addend}); err != nil {
+			t.Fatalf("Failed to update balance: %v", err)
+		}
+	}
+
+	if _, err := client.JoinTournament(context.Background(), &tgrpc.JoinRequest{
+		TournamentID: created.GetId(),
+		UserID:       saved.GetId(),
+	}); err != nil {
+		t.Fatalf("Failed to join tournament: %v", err)
+	}
+
+	if _, err := client.LeaveTournament(context.Background(), &tgrpc.ParticipantRequest{
+		TournamentID: created.GetId(),
+		UserID:       saved.GetId(),
+	}); err != nil {
+		t.Fatalf("Failed to leave tournament: %v", err)
+	}
+
+	history, err := client.ListUserTransactions(context.Background(), &tgrpc.UserTransactionsRequest{UserID: saved.GetId()})
+	if err != nil {
+		t.Fatalf("Failed to list transactions: %v", err)
+	}
+
+	want := []struct {
+		transactionType models.TransactionType
+		amount          float64
+		balanceAfter    float64
+	}{
+		{transactionType: models.JoinTransaction, amount: -20, balanceAfter: 510},
+		{transactionType: models.TakeTransaction, amount: -20, balanceAfter: 530},
+		{transactionType: models.FundTransaction, amount: 50, balanceAfter: 550},
+		{transactionType: models.FundTransaction, amount: 500, balanceAfter: 500},
+	}
+	if !assert.Equal(t, len(want), len(history.GetTransactions()), "every movement should be listed") {
+		return
+	}
+
+	for i, w := range want {
+		transaction := history.GetTransactions()[i]
+		assert.Equal(t, string(w.transactionType), transaction.GetType(), "newest transactions should be first")
+		assert.Equal(t, w.amount, transaction.GetAmount(), "amount of %v", w.transactionType)
+		assert.Equal(t, w.balanceAfter, transaction.GetBalanceAfter(), "balance after %v", w.transactionType)
+	}
+	assert.Equal(t, created.GetId(), history.GetTransactions()[0].GetTournamentID(), "join should refer to tournament")
+	assert.Zero(t, history.GetNextOffset(), "whole history should fit one page")
+
+	joins, err := client.ListUserTransactions(context.Background(), &tgrpc.UserTransactionsRequest{
+		UserID: saved.GetId(),
+		Types:  []string{"join", "prize"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to list transactions by type: %v", err)
+	}
+	assert.Equal(t, 1, len(joins.GetTransactions()), "only join should match")
+
+	page, err := client.ListUserTransactions(context.Background(), &tgrpc.UserTransactionsRequest{UserID: saved.GetId(), Limit: 3})
+	if err != nil {
+		t.Fatalf("Failed to list page of transactions: %v", err)
+	}
+	assert.Equal(t, 3, len(page.GetTransactions()), "page should be limited")
+	assert.Equal(t, int32(3), page.GetNextOffset(), "next page should follow")
+
+	future, err := client.ListUserTransactions(context.Background(), &tgrpc.UserTransactionsRequest{
+		UserID: saved.GetId(),
+		From:   timestamppb.New(time.Now().Add(time.Hour)),
+	})
+	if err != nil {
+		t.Fatalf("Failed to list transactions by date: %v", err)
+	}
+	assert.Empty(t, future.GetTransactions(), "no transactions should happen in future")
+
+	_, err = client.ListUserTransactions(context.Background(), &tgrpc.UserTransactionsRequest{
+		UserID: saved.GetId(),
+		Types:  []string{"bonus"},
+	})
+	assertGrpcError(t, codes.InvalidArgument, err)
+}
@@ -0,0 +1,96 @@
+// +build integration
+
+package itest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	tgrpc "github.com/kimbellG/tournament/core/handler/grpc"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestWaitlist(t *testing.T) {
+	client := tgrpc.NewTournamentServiceClient(conn)
+
+	created, err := client.CreateTournament(context.Background(), &tgrpc.CreateTournamentRequest{
+		Name:       "waitlist tournament",
+		Deposit:    100,
+		MaxPlayers: 2,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create tournament: %v", err)
+	}
+
+	var users []*models.User
+	for i, balance := range []float64{500, 500, 50, 500} {
+		users = append(users, createUser(t, db, &models.User{Name: fmt.Sprintf("waitlist user %d", i), Balances: usd(balance)}))
+	}
+
+	join := func(user *models.User, waitlist bool) (*tgrpc.JoinResponse, error) {
+		return client.JoinTournament(context.Background(), &tgrpc.JoinRequest{
+			TournamentID: created.GetId(),
+			UserID:       user.ID.String(),
+			Waitlist:     waitlist,
+		})
+	}
+
+	for _, user := range users[:2] {
+		resp, err := join(user, true)
+		if err != nil {
+			t.Fatalf("Failed to join tournament: %v", err)
+		}
+		assert.False(t, resp.GetWaitlisted(), "user should enter tournament with free places")
+	}
+
+	_, err = join(users[2], false)
+	assertGrpcError(t, codes.ResourceExhausted, err)
+
+	for i, user := range users[2:] {
+		resp, err := join(user, true)
+		if err != nil {
+			t.Fatalf("Failed to join waitlist: %v", err)
+		}
+		assert.True(t, resp.GetWaitlisted(), "user should be put on waitlist of full tournament")
+		assert.Equal(t, int32(i+1), resp.GetPosition(), "waitlist should keep order of joining")
+	}
+
+	_, err = join(users[3], true)
+	assertGrpcError(t, codes.AlreadyExists, err)
+
+	availableOf := func(user *models.User) models.Money {
+		var available models.Money
+		if err := db.QueryRow(userAvailableQuery, user.ID).Scan(&available); err != nil {
+			t.Fatalf("Failed to select available balance of user: %v", err)
+		}
+		return available
+	}
+	assert.Equal(t, users[3].BalanceIn(models.DefaultCurrency), availableOf(users[3]), "stake of waitlisted user shouldn't be held")
+
+	if _, err := client.RemoveParticipant(context.Background(), &tgrpc.ParticipantRequest{
+		TournamentID: created.GetId(),
+		UserID:       users[0].ID.String(),
+	}); err != nil {
+		t.Fatalf("Failed to remove participant: %v", err)
+	}
+
+	assert.Equal(t, users[0].BalanceIn(models.DefaultCurrency), availableOf(users[0]), "stake of removed participant should be released")
+	assert.Equal(t, users[2].BalanceIn(models.DefaultCurrency), availableOf(users[2]), "user who can't pay should be skipped")
+	assert.Equal(t, users[3].BalanceIn(models.DefaultCurrency).Sub(money(100)), availableOf(users[3]), "stake of promoted user should be held")
+
+	tournament, err := client.GetTournamentByID(context.Background(), &tgrpc.TournamentRequest{Id: created.GetId()})
+	if err != nil {
+		t.Fatalf("Failed to get tournament: %v", err)
+	}
+	assert.ElementsMatch(t, []string{users[1].ID.String(), users[3].ID.String()}, tournament.GetUsers(), "promoted user should take free place")
+	assert.Zero(t, tournament.GetPrize(), "stakes of participants should be held until start")
+
+	waitlist, err := client.GetWaitlist(context.Background(), &tgrpc.TournamentRequest{Id: created.GetId()})
+	if err != nil {
+		t.Fatalf("Failed to get waitlist: %v", err)
+	}
+	assert.Empty(t, waitlist.GetEntries(), "waitlist should be empty after promotion")
+}
@@ -0,0 +1,39 @@
+package models
+
+// Currency is a code of money like "USD" or "CHIPS" for play money.
+// Money of different currencies is never mixed: it is kept on separate balances.
+type Currency string
+
+// DefaultCurrency is the currency of money that doesn't name one, including everything from before currencies.
+const DefaultCurrency Currency = "USD"
+
+const (
+	minCurrencyLength = 3
+	maxCurrencyLength = 8
+)
+
+func (c Currency) Valid() bool {
+	if len(c) < minCurrencyLength || len(c) > maxCurrencyLength {
+		return false
+	}
+
+	for _, r := range c {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Balance is the money of user in one currency. Held part of Amount is reserved by holds and can't be spent.
+type Balance struct {
+	Currency Currency
+	Amount   Money
+	Held     Money
+}
+
+// Available returns the money that user can spend.
+func (b Balance) Available() Money {
+	return b.Amount.Sub(b.Held)
+}
@@ -0,0 +1,17 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCurrencyValid(t *testing.T) {
+	for _, currency := range []Currency{DefaultCurrency, "EUR", "CHIPS"} {
+		assert.True(t, currency.Valid(), "%q should be valid", currency)
+	}
+
+	for _, currency := range []Currency{"", "US", "usd", "US1", "VERYLONGNAME"} {
+		assert.False(t, currency.Valid(), "%q shouldn't be valid", currency)
+	}
+}
@@ -0,0 +1,23 @@
+package models
+
+import "github.com/google/uuid"
+
+const DrawAlgorithm = "HMAC-SHA256(serverSeed, tournamentID:clientSeed1:...:clientSeedN) as big-endian integer mod N; " +
+	"every next place repeats the draw without the entries already drawn"
+
+type DrawEntry struct {
+	UserID     uuid.UUID
+	ClientSeed string
+}
+
+// DrawProof holds everything needed to verify the winner of provably fair draw offline.
+// ServerSeed stays empty and WinnerIndex is -1 until the seed is revealed on finish.
+type DrawProof struct {
+	TournamentID   uuid.UUID
+	Algorithm      string
+	ServerSeedHash string
+	ServerSeed     string
+	Entries        []DrawEntry
+	WinnerIndex    int
+	Winner         uuid.UUID
+}
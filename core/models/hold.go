@@ -0,0 +1,12 @@
+package models
+
+import "github.com/google/uuid"
+
+// Hold reserves the stake of participant on the balance until tournament starts.
+// Hold is captured into the prize pool on start or released when participant leaves or tournament is cancelled.
+type Hold struct {
+	TournamentID uuid.UUID
+	UserID       uuid.UUID
+	Currency     Currency
+	Amount       Money
+}
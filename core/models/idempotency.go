@@ -0,0 +1,20 @@
+package models
+
+import "github.com/google/uuid"
+
+type IdempotentOperation string
+
+const (
+	UpdateBalanceOperation IdempotentOperation = "UpdateBalance"
+	JoinOperation          IdempotentOperation = "Join"
+)
+
+// IdempotentRequest is a request made with idempotency key, which is unique per user and operation.
+// Hash fingerprints the payload of request, Response keeps its encoded outcome.
+type IdempotentRequest struct {
+	Key       string
+	UserID    uuid.UUID
+	Operation IdempotentOperation
+	Hash      string
+	Response  []byte
+}
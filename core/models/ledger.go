@@ -0,0 +1,91 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type LedgerAccountType string
+
+const (
+	UserAccount       LedgerAccountType = "User"
+	TournamentAccount LedgerAccountType = "Tournament"
+	HouseAccount      LedgerAccountType = "House"
+	ExternalAccount   LedgerAccountType = "External"
+)
+
+// LedgerAccount is a holder of money. The house and the world outside of the system have no id.
+type LedgerAccount struct {
+	Type LedgerAccountType
+	ID   uuid.UUID
+}
+
+type TransactionType string
+
+const (
+	FundTransaction       TransactionType = "Fund"
+	TakeTransaction       TransactionType = "Take"
+	JoinTransaction       TransactionType = "Join"
+	RefundTransaction     TransactionType = "Refund"
+	PrizeTransaction      TransactionType = "Prize"
+	AdjustmentTransaction TransactionType = "Adjustment"
+)
+
+var TransactionTypes = []TransactionType{
+	FundTransaction,
+	TakeTransaction,
+	JoinTransaction,
+	RefundTransaction,
+	PrizeTransaction,
+	AdjustmentTransaction,
+}
+
+func (tt TransactionType) Valid() bool {
+	for _, known := range TransactionTypes {
+		if tt == known {
+			return true
+		}
+	}
+
+	return false
+}
+
+// LedgerEntry moves amount into account. Negative amount moves money out of it.
+type LedgerEntry struct {
+	Account LedgerAccount
+	Amount  Money
+}
+
+// LedgerTransaction is a set of entries whose amounts sum to zero, so money only moves between accounts.
+// All entries of transaction are in its currency.
+type LedgerTransaction struct {
+	ID           uuid.UUID
+	Type         TransactionType
+	Currency     Currency
+	TournamentID uuid.UUID
+	Entries      []LedgerEntry
+	CreatedAt    time.Time
+}
+
+// UserTransaction is a movement of money on the balance of user.
+type UserTransaction struct {
+	ID           uuid.UUID
+	Type         TransactionType
+	Currency     Currency
+	TournamentID uuid.UUID
+	Amount       Money
+	BalanceAfter Money
+	CreatedAt    time.Time
+}
+
+// TransactionFilter narrows history of transactions. Zero time leaves the range open,
+// From is included in the range and To isn't. Empty currency means all of them.
+type TransactionFilter struct {
+	Types    []TransactionType
+	Currency Currency
+	From     time.Time
+	To       time.Time
+	Limit    int
+	Offset   int
+}
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type LimitKind string
+
+const (
+	DepositLimit LimitKind = "Deposit"
+	EntryLimit   LimitKind = "Entry"
+)
+
+func (k LimitKind) Valid() bool {
+	return k == DepositLimit || k == EntryLimit
+}
+
+type LimitPeriod string
+
+const (
+	Day   LimitPeriod = "Day"
+	Week  LimitPeriod = "Week"
+	Month LimitPeriod = "Month"
+)
+
+func (p LimitPeriod) Valid() bool {
+	return p == Day || p == Week || p == Month
+}
+
+// Since returns the start of period which ends at now. Periods are rolling, so they don't depend on time zone of user.
+func (p LimitPeriod) Since(now time.Time) time.Time {
+	switch p {
+	case Week:
+		return now.AddDate(0, 0, -7)
+	case Month:
+		return now.AddDate(0, -1, 0)
+	default:
+		return now.AddDate(0, 0, -1)
+	}
+}
+
+// Limit caps the money user deposits or spends on entries of tournaments within a period in one currency.
+// Zero amount is no limit. Looser limit waits in PendingAmount until PendingFrom.
+type Limit struct {
+	UserID        uuid.UUID
+	Kind          LimitKind
+	Period        LimitPeriod
+	Currency      Currency
+	Amount        Money
+	PendingAmount Money
+	PendingFrom   time.Time
+}
+
+// At returns the amount of limit in force at t.
+func (l *Limit) At(t time.Time) Money {
+	if !l.PendingFrom.IsZero() && !t.Before(l.PendingFrom) {
+		return l.PendingAmount
+	}
+
+	return l.Amount
+}
+
+// SelfLimits are the limits user has set on themself. Zero ExcludedUntil means user isn't excluded.
+type SelfLimits struct {
+	Limits        []Limit
+	ExcludedUntil time.Time
+}
@@ -0,0 +1,38 @@
+package models
+
+import "github.com/google/uuid"
+
+type Match struct {
+	ID           uuid.UUID `sql:", type:uuid"`
+	TournamentID uuid.UUID
+	Round        int
+	Position     int
+	FirstUser    uuid.UUID
+	SecondUser   uuid.UUID
+	Winner       uuid.UUID
+	Draw         bool
+}
+
+func (m *Match) IsPlayer(userID uuid.UUID) bool {
+	return userID != uuid.Nil && (m.FirstUser == userID || m.SecondUser == userID)
+}
+
+func (m *Match) IsReady() bool {
+	return m.FirstUser != uuid.Nil && m.SecondUser != uuid.Nil
+}
+
+func (m *Match) IsBye() bool {
+	return m.FirstUser != uuid.Nil && m.SecondUser == uuid.Nil
+}
+
+func (m *Match) IsDecided() bool {
+	return m.Winner != uuid.Nil || m.Draw
+}
+
+func (m *Match) Opponent(userID uuid.UUID) uuid.UUID {
+	if m.FirstUser == userID {
+		return m.SecondUser
+	}
+
+	return m.FirstUser
+}
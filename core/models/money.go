@@ -0,0 +1,180 @@
+package models
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const centsInUnit = 100
+
+// Money is an exact amount of money kept in cents, so sums and splits of it never drift.
+// Zero value is no money.
+type Money struct {
+	cents int64
+}
+
+func Cents(cents int64) Money {
+	return Money{cents: cents}
+}
+
+// MoneyFromFloat rounds amount to the nearest cent. It is meant only for the edges
+// where amounts still come as float, arithmetic should be done on Money itself.
+func MoneyFromFloat(amount float64) Money {
+	return Money{cents: int64(math.Round(amount * centsInUnit))}
+}
+
+// ParseMoney reads decimal amount like "-12.30". Digits after cents are rounded half away from zero.
+func ParseMoney(s string) (Money, error) {
+	value := strings.TrimSpace(s)
+
+	negative := strings.HasPrefix(value, "-")
+	value = strings.TrimLeft(value, "+-")
+
+	units, fraction := value, ""
+	if i := strings.IndexByte(value, '.'); i >= 0 {
+		units, fraction = value[:i], value[i+1:]
+	}
+
+	if units == "" && fraction == "" || !isDigits(units) || !isDigits(fraction) {
+		return Money{}, fmt.Errorf("invalid amount of money: %q", s)
+	}
+
+	fraction += "000"
+	var cents int64
+	if units != "" {
+		var err error
+		if cents, err = strconv.ParseInt(units, 10, 64); err != nil {
+			return Money{}, fmt.Errorf("invalid amount of money %q: %w", s, err)
+		}
+	}
+
+	cents = cents*centsInUnit + int64(fraction[0]-'0')*10 + int64(fraction[1]-'0')
+	if fraction[2] >= '5' {
+		cents++
+	}
+
+	if negative {
+		cents = -cents
+	}
+
+	return Money{cents: cents}, nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (m Money) Cents() int64 {
+	return m.cents
+}
+
+func (m Money) Float64() float64 {
+	return float64(m.cents) / centsInUnit
+}
+
+func (m Money) Add(other Money) Money {
+	return Money{cents: m.cents + other.cents}
+}
+
+func (m Money) Sub(other Money) Money {
+	return Money{cents: m.cents - other.cents}
+}
+
+func (m Money) Neg() Money {
+	return Money{cents: -m.cents}
+}
+
+func (m Money) Less(other Money) bool {
+	return m.cents < other.cents
+}
+
+func (m Money) IsZero() bool {
+	return m.cents == 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.cents < 0
+}
+
+func (m Money) IsPositive() bool {
+	return m.cents > 0
+}
+
+// Percent returns percent of amount rounded half away from zero to the nearest cent.
+// Whole units of FullPercent are taken apart from the remainder, so large amounts don't overflow.
+func (m Money) Percent(percent Percent) Money {
+	whole, rest := m.cents/int64(FullPercent), m.cents%int64(FullPercent)
+
+	part := rest * int64(percent)
+	rounded := part / int64(FullPercent)
+	if remainder := part % int64(FullPercent); 2*remainder >= int64(FullPercent) {
+		rounded++
+	} else if 2*remainder <= -int64(FullPercent) {
+		rounded--
+	}
+
+	return Money{cents: whole*int64(percent) + rounded}
+}
+
+// String formats amount with two digits after the point, the way it is stored in the database.
+func (m Money) String() string {
+	sign, cents := "", m.cents
+	if cents < 0 {
+		sign, cents = "-", -cents
+	}
+
+	return fmt.Sprintf("%s%d.%02d", sign, cents/centsInUnit, cents%centsInUnit)
+}
+
+// Scan reads numeric column. NULL is read as no money.
+func (m *Money) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case nil:
+		*m = Money{}
+	case string:
+		parsed, err := ParseMoney(value)
+		if err != nil {
+			return err
+		}
+		*m = parsed
+	case []byte:
+		return m.Scan(string(value))
+	case int64:
+		*m = Money{cents: value * centsInUnit}
+	case float64:
+		*m = MoneyFromFloat(value)
+	default:
+		return fmt.Errorf("can't scan %T into money", src)
+	}
+
+	return nil
+}
+
+// Value passes amount to the database as decimal text, so it isn't rounded on the way.
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+// MarshalText writes amount as decimal text, so JSON keeps it exact.
+func (m Money) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+func (m *Money) UnmarshalText(text []byte) error {
+	parsed, err := ParseMoney(string(text))
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+	return nil
+}
@@ -0,0 +1,84 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMoney(t *testing.T) {
+	tt := []struct {
+		value string
+		want  Money
+		valid bool
+	}{
+		{value: "12.34", want: Cents(1234), valid: true},
+		{value: "-0.50", want: Cents(-50), valid: true},
+		{value: "7", want: Cents(700), valid: true},
+		{value: ".5", want: Cents(50), valid: true},
+		{value: "1.005", want: Cents(101), valid: true},
+		{value: "1.0049", want: Cents(100), valid: true},
+		{value: "", valid: false},
+		{value: "1.2.3", valid: false},
+		{value: "1e3", valid: false},
+	}
+
+	for _, tc := range tt {
+		actual, err := ParseMoney(tc.value)
+		if !tc.valid {
+			assert.Error(t, err, "%q shouldn't be parsed", tc.value)
+			continue
+		}
+
+		if assert.NoError(t, err, "%q should be parsed", tc.value) {
+			assert.Equal(t, tc.want, actual, "amount of %q", tc.value)
+		}
+	}
+}
+
+func TestMoneyString(t *testing.T) {
+	assert.Equal(t, "12.30", Cents(1230).String())
+	assert.Equal(t, "-0.05", Cents(-5).String())
+	assert.Equal(t, "0.00", Money{}.String())
+}
+
+func TestMoneyPercent(t *testing.T) {
+	assert.Equal(t, Cents(3333), Cents(33333).Percent(PercentFromFloat(10)), "percent should be rounded to cents")
+	assert.Equal(t, Cents(-50), Cents(-500).Percent(PercentFromFloat(10)), "percent of negative amount should be negative")
+	assert.Equal(t, Cents(1), Cents(5).Percent(PercentFromFloat(12.5)), "half cent should be rounded away from zero")
+	assert.Equal(t, Cents(-1), Cents(-5).Percent(PercentFromFloat(12.5)), "half cent should be rounded away from zero")
+	assert.Equal(t, Cents(4611686018427387903), Cents(9223372036854775806).Percent(PercentFromFloat(50)), "large amount shouldn't overflow")
+}
+
+func TestParsePercent(t *testing.T) {
+	percent, err := ParsePercent("33.33")
+	if assert.NoError(t, err) {
+		assert.Equal(t, Percent(3333), percent)
+		assert.Equal(t, "33.33", percent.String())
+	}
+
+	_, err = ParsePercent("1/3")
+	assert.Error(t, err)
+}
+
+func TestMoneyIsExact(t *testing.T) {
+	var sum Money
+	for i := 0; i < 10; i++ {
+		sum = sum.Add(MoneyFromFloat(0.1))
+	}
+
+	assert.Equal(t, Cents(100), sum, "sum of cents shouldn't drift")
+}
+
+func TestMoneyJSON(t *testing.T) {
+	encoded, err := json.Marshal(Cents(-1230))
+	if assert.NoError(t, err) {
+		assert.Equal(t, `"-12.30"`, string(encoded), "money should be encoded as exact decimal")
+	}
+
+	var decoded Money
+	if assert.NoError(t, json.Unmarshal(encoded, &decoded)) {
+		assert.Equal(t, Cents(-1230), decoded)
+	}
+}
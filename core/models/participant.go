@@ -0,0 +1,11 @@
+package models
+
+import "github.com/google/uuid"
+
+type Participant struct {
+	UserID        uuid.UUID
+	Stake         Money
+	Score         float64
+	ScoreReported bool
+	ClientSeed    string
+}
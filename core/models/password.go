@@ -0,0 +1,63 @@
+package models
+
+import (
+	"fmt"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+// MaxPasswordLength bounds the passwords users choose.
+const MaxPasswordLength = 128
+
+// PasswordPolicy is the strength passwords chosen by users should have. Classes of characters are
+// lower case letters, upper case letters, digits and the rest.
+type PasswordPolicy struct {
+	MinLength  int
+	MinClasses int
+}
+
+// Check returns the reason password is too weak for the policy or nil.
+func (p PasswordPolicy) Check(password string) error {
+	length := len([]rune(password))
+	if length < p.MinLength {
+		return fmt.Errorf("password should have at least %d characters", p.MinLength)
+	}
+
+	if length > MaxPasswordLength {
+		return fmt.Errorf("password should have at most %d characters", MaxPasswordLength)
+	}
+
+	if classes := characterClasses(password); classes < p.MinClasses {
+		return fmt.Errorf("password should mix at least %d of lower case letters, upper case letters, digits and other characters", p.MinClasses)
+	}
+
+	return nil
+}
+
+func characterClasses(password string) int {
+	var lower, upper, digit, other int
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = 1
+		case unicode.IsUpper(r):
+			upper = 1
+		case unicode.IsDigit(r):
+			digit = 1
+		default:
+			other = 1
+		}
+	}
+
+	return lower + upper + digit + other
+}
+
+// PasswordReset is a single-use token, which lets user set new password without the old one until ExpiresAt.
+// Only hash of token is kept.
+type PasswordReset struct {
+	TokenHash string
+	UserID    uuid.UUID
+	ExpiresAt time.Time
+}
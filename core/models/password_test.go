@@ -0,0 +1,34 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPasswordPolicyCheck(t *testing.T) {
+	policy := PasswordPolicy{MinLength: 8, MinClasses: 3}
+
+	tt := []struct {
+		password string
+		valid    bool
+	}{
+		{password: "Abcdef1!", valid: true},
+		{password: "abcdEFGH12", valid: true},
+		{password: "Пароль2024", valid: true},
+		{password: "Abc1!", valid: false},
+		{password: "abcdefgh12", valid: false},
+		{password: "ABCDEFGHIJ", valid: false},
+		{password: "Aa1" + strings.Repeat("x", MaxPasswordLength), valid: false},
+	}
+
+	for _, tc := range tt {
+		err := policy.Check(tc.password)
+		if tc.valid {
+			assert.NoError(t, err, "%q should be strong enough", tc.password)
+		} else {
+			assert.Error(t, err, "%q should be too weak", tc.password)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package models
+
+import "github.com/google/uuid"
+
+type PayoutType string
+
+const (
+	PercentagePayout PayoutType = "Percentage"
+	FixedPayout      PayoutType = "Fixed"
+)
+
+func (pt PayoutType) Valid() bool {
+	return pt == PercentagePayout || pt == FixedPayout
+}
+
+// DefaultPayouts gives the whole prize to the winner.
+var DefaultPayouts = []Percent{FullPercent}
+
+// Placement is a paid place of a finished tournament.
+type Placement struct {
+	Place  int
+	UserID uuid.UUID
+	Prize  Money
+}
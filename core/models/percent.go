@@ -0,0 +1,56 @@
+package models
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+)
+
+const basisPointsInPercent = 100
+
+// Percent is a percentage kept in basis points, hundredths of percent, so parts of money are taken exactly.
+type Percent int64
+
+// FullPercent is the whole of amount.
+const FullPercent Percent = 100 * basisPointsInPercent
+
+// PercentFromFloat rounds percentage to the nearest basis point. Like MoneyFromFloat, it is meant only
+// for the edges where percentages still come as float.
+func PercentFromFloat(percent float64) Percent {
+	return Percent(math.Round(percent * basisPointsInPercent))
+}
+
+// ParsePercent reads decimal percentage like "12.5". Digits after basis points are rounded half away from zero.
+func ParsePercent(s string) (Percent, error) {
+	hundredths, err := ParseMoney(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid percentage %q: %w", s, err)
+	}
+
+	return Percent(hundredths.Cents()), nil
+}
+
+func (p Percent) Float64() float64 {
+	return float64(p) / basisPointsInPercent
+}
+
+// String formats percentage with two digits after the point, the way it is stored in the database.
+func (p Percent) String() string {
+	return Cents(int64(p)).String()
+}
+
+// Scan reads numeric column.
+func (p *Percent) Scan(src interface{}) error {
+	var hundredths Money
+	if err := hundredths.Scan(src); err != nil {
+		return err
+	}
+
+	*p = Percent(hundredths.Cents())
+	return nil
+}
+
+// Value passes percentage to the database as decimal text, so it isn't rounded on the way.
+func (p Percent) Value() (driver.Value, error) {
+	return p.String(), nil
+}
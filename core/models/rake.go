@@ -0,0 +1,12 @@
+package models
+
+type RakeType string
+
+const (
+	PercentageRake RakeType = "Percentage"
+	FixedRake      RakeType = "Fixed"
+)
+
+func (rt RakeType) Valid() bool {
+	return rt == PercentageRake || rt == FixedRake
+}
@@ -0,0 +1,76 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DiscrepancyKind names the invariant that doesn't hold.
+type DiscrepancyKind string
+
+const (
+	// UserBalanceDiscrepancy is a balance of user that doesn't match the ledger.
+	UserBalanceDiscrepancy DiscrepancyKind = "UserBalance"
+	// HeldDiscrepancy is a held part of balance that doesn't match holds of user.
+	HeldDiscrepancy DiscrepancyKind = "Held"
+	// HouseBalanceDiscrepancy is a balance of the house that doesn't match the ledger.
+	HouseBalanceDiscrepancy DiscrepancyKind = "HouseBalance"
+	// PrizeDiscrepancy is a prize of active tournament that doesn't match the deposits in its pool.
+	PrizeDiscrepancy DiscrepancyKind = "Prize"
+	// UnpaidStakeDiscrepancy is a participant whose stake is neither held nor charged.
+	UnpaidStakeDiscrepancy DiscrepancyKind = "UnpaidStake"
+	// OrphanHoldDiscrepancy is a hold that will never be captured: its user doesn't participate
+	// or the registration of its tournament is over.
+	OrphanHoldDiscrepancy DiscrepancyKind = "OrphanHold"
+)
+
+// DiscrepancyKinds are in the order of correction: holds are released before held money is counted again.
+var DiscrepancyKinds = []DiscrepancyKind{
+	OrphanHoldDiscrepancy,
+	UserBalanceDiscrepancy,
+	HeldDiscrepancy,
+	HouseBalanceDiscrepancy,
+	PrizeDiscrepancy,
+	UnpaidStakeDiscrepancy,
+}
+
+// Correctable reports whether the discrepancy can be corrected without a decision of operator.
+// Unpaid stake can't: the participant should be either charged or removed.
+func (k DiscrepancyKind) Correctable() bool {
+	return k != UnpaidStakeDiscrepancy
+}
+
+// Discrepancy is a recorded value that differs from the expected one, which is derived from the ledger,
+// holds or participants. The user and the tournament are set when the value belongs to them.
+type Discrepancy struct {
+	Kind         DiscrepancyKind
+	UserID       uuid.UUID
+	TournamentID uuid.UUID
+	Currency     Currency
+	Recorded     Money
+	Expected     Money
+	Corrected    bool
+}
+
+// Difference returns how much the expected value exceeds the recorded one.
+func (d *Discrepancy) Difference() Money {
+	return d.Expected.Sub(d.Recorded)
+}
+
+// ReconciliationReport lists discrepancies found at CheckedAt.
+type ReconciliationReport struct {
+	CheckedAt     time.Time
+	Discrepancies []Discrepancy
+}
+
+// Consistent reports whether every discrepancy of report has been corrected.
+func (r *ReconciliationReport) Consistent() bool {
+	for _, discrepancy := range r.Discrepancies {
+		if !discrepancy.Corrected {
+			return false
+		}
+	}
+
+	return true
+}
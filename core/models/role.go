@@ -0,0 +1,15 @@
+package models
+
+// Role is the set of actions user is allowed to perform. Admin is allowed everything,
+// organizer runs tournaments, player enters them with the money on own balance.
+type Role string
+
+const (
+	AdminRole     Role = "Admin"
+	OrganizerRole Role = "Organizer"
+	PlayerRole    Role = "Player"
+)
+
+func (r Role) Valid() bool {
+	return r == AdminRole || r == OrganizerRole || r == PlayerRole
+}
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Session is a log in of user. Tokens issued within the session stop working, once it expires or is revoked.
+type Session struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	ExpiresAt time.Time
+	RevokedAt time.Time
+}
+
+func (s *Session) Active(now time.Time) bool {
+	return s.RevokedAt.IsZero() && now.Before(s.ExpiresAt)
+}
+
+// RefreshToken extends session once. Only hash of token is kept, zero UsedAt means token isn't used yet.
+type RefreshToken struct {
+	TokenHash string
+	SessionID uuid.UUID
+	UsedAt    time.Time
+}
+
+// Grant is what user gets on log in and refresh of session: the session with role of user
+// and the plain refresh token to extend the session with.
+type Grant struct {
+	Session      Session
+	Role         Role
+	RefreshToken string
+}
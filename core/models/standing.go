@@ -0,0 +1,19 @@
+package models
+
+import "github.com/google/uuid"
+
+const (
+	WinPoints  = 1.0
+	DrawPoints = 0.5
+)
+
+type Standing struct {
+	UserID     uuid.UUID
+	Played     int
+	Wins       int
+	Draws      int
+	Losses     int
+	Points     float64
+	Buchholz   float64
+	HeadToHead float64
+}
@@ -1,23 +1,177 @@
 package models
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
 type TournamentStatus string
 
 const (
-	Active TournamentStatus = "Active"
-	Cancel TournamentStatus = "Cancel"
-	Finish TournamentStatus = "Finish"
+	Draft              TournamentStatus = "Draft"
+	RegistrationOpen   TournamentStatus = "RegistrationOpen"
+	RegistrationClosed TournamentStatus = "RegistrationClosed"
+	InProgress         TournamentStatus = "InProgress"
+	Finished           TournamentStatus = "Finished"
+	Cancelled          TournamentStatus = "Cancelled"
+)
+
+var statusTransitions = map[TournamentStatus][]TournamentStatus{
+	Draft:              {RegistrationOpen, Cancelled},
+	RegistrationOpen:   {RegistrationClosed, InProgress, Finished, Cancelled},
+	RegistrationClosed: {RegistrationOpen, InProgress, Finished, Cancelled},
+	InProgress:         {Finished, Cancelled},
+}
+
+// CanBecome reports whether tournament is allowed to move from status s to next.
+func (s TournamentStatus) CanBecome(next TournamentStatus) bool {
+	for _, allowed := range statusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsRegistration reports whether players are known but the tournament hasn't started yet.
+func (s TournamentStatus) IsRegistration() bool {
+	return s == RegistrationOpen || s == RegistrationClosed
+}
+
+type TournamentFormat string
+
+const (
+	SingleElimination TournamentFormat = "SingleElimination"
+	RoundRobin        TournamentFormat = "RoundRobin"
+	Swiss             TournamentFormat = "Swiss"
+	// Unpaired tournament plays no matches, its winner strategy decides it even after start.
+	Unpaired TournamentFormat = "Unpaired"
+)
+
+func (f TournamentFormat) Valid() bool {
+	switch f {
+	case SingleElimination, RoundRobin, Swiss, Unpaired:
+		return true
+	}
+
+	return false
+}
+
+// HasMatches reports whether started tournament of the format is decided by its matches instead of the winner strategy.
+func (f TournamentFormat) HasMatches() bool {
+	return f != Unpaired
+}
+
+// HasStandings reports whether the winner of the format is decided by standings instead of a final.
+func (f TournamentFormat) HasStandings() bool {
+	return f == RoundRobin || f == Swiss
+}
+
+type Tiebreaker string
+
+const (
+	Buchholz   Tiebreaker = "Buchholz"
+	HeadToHead Tiebreaker = "HeadToHead"
+	Wins       Tiebreaker = "Wins"
+)
+
+var DefaultTiebreakers = []Tiebreaker{Buchholz, HeadToHead}
+
+func (tb Tiebreaker) Valid() bool {
+	switch tb {
+	case Buchholz, HeadToHead, Wins:
+		return true
+	}
+
+	return false
+}
+
+type WinnerStrategy string
+
+const (
+	RandomWinner WinnerStrategy = "Random"
+	StakeWinner  WinnerStrategy = "Stake"
+	ScoreWinner  WinnerStrategy = "Score"
+	ManualWinner WinnerStrategy = "Manual"
+	FairWinner   WinnerStrategy = "ProvablyFair"
 )
 
 type Tournament struct {
-	ID      uuid.UUID `sql:", type:uuid"`
-	Name    string
-	Deposit float64
-	Prize   float64
-	Users   []User
-	Winner  uuid.UUID
-	Status  TournamentStatus
+	ID             uuid.UUID `sql:", type:uuid"`
+	Name           string
+	Currency       Currency
+	Deposit        Money
+	Prize          Money
+	Users          []User
+	Winner         uuid.UUID
+	Status         TournamentStatus
+	Format         TournamentFormat
+	Rounds         int
+	Tiebreakers    []Tiebreaker
+	WinnerStrategy WinnerStrategy
+	ServerSeed     string
+	ServerSeedHash string
+	SeedRevealed   bool
+	PayoutType     PayoutType
+	// Payouts are the percentages of prize pool paid to places when PayoutType is PercentagePayout,
+	// PayoutAmounts are the prizes of places when it is FixedPayout.
+	Payouts       []Percent
+	PayoutAmounts []Money
+	Placements    []Placement
+	RakeType      RakeType
+	// Rake is the percentage of entry kept by the house when RakeType is PercentageRake,
+	// RakeAmount is the part of entry kept by it when it is FixedRake.
+	Rake          Percent
+	RakeAmount    Money
+	RakeCollected Money
+	Schedule      Schedule
+	MinPlayers    int
+	MaxPlayers    int
+	// WithdrawalPenalty is the percent of stake kept in the prize pool when participant leaves tournament.
+	WithdrawalPenalty Percent
+}
+
+// IsFull reports whether tournament reached its maximum of players. Zero maximum means no limit.
+func (t *Tournament) IsFull() bool {
+	return t.MaxPlayers > 0 && len(t.Users) >= t.MaxPlayers
+}
+
+// HasUser reports whether user is a participant of tournament.
+func (t *Tournament) HasUser(userID uuid.UUID) bool {
+	for _, user := range t.Users {
+		if user.ID == userID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Schedule holds the moments when tournament changes its status by itself. Zero time means it isn't scheduled.
+type Schedule struct {
+	RegistrationOpensAt  time.Time
+	RegistrationClosesAt time.Time
+	StartTime            time.Time
+	FinishDeadline       time.Time
+}
+
+// GrossEntries returns the sum of all entries, Prize holds only the part left after rake.
+func (t *Tournament) GrossEntries() Money {
+	return t.Prize.Add(t.RakeCollected)
+}
+
+// Places returns the number of paid places of tournament.
+func (t *Tournament) Places() int {
+	places := len(t.Payouts)
+	if t.PayoutType == FixedPayout {
+		places = len(t.PayoutAmounts)
+	}
+
+	if places == 0 {
+		return 1
+	}
+
+	return places
 }
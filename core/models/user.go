@@ -2,9 +2,35 @@ package models
 
 import "github.com/google/uuid"
 
+// User is a player of tournaments. Email is where password reset tokens are sent, it's optional.
+// Role is given by admin, new users are players.
 type User struct {
 	ID       uuid.UUID `sql:", type:uuid"`
 	Name     string
 	Password string
-	Balance  float64
+	Email    string
+	Role     Role
+	Balances []Balance
+}
+
+// BalanceIn returns the money of user in currency. User without balance in currency has no money in it.
+func (u *User) BalanceIn(currency Currency) Money {
+	for _, balance := range u.Balances {
+		if balance.Currency == currency {
+			return balance.Amount
+		}
+	}
+
+	return Money{}
+}
+
+// AvailableIn returns the money of user in currency, which isn't reserved by holds.
+func (u *User) AvailableIn(currency Currency) Money {
+	for _, balance := range u.Balances {
+		if balance.Currency == currency {
+			return balance.Available()
+		}
+	}
+
+	return Money{}
 }
@@ -0,0 +1,12 @@
+package models
+
+import "github.com/google/uuid"
+
+// WaitlistEntry is a user waiting for a free place in full tournament.
+// The stake isn't charged until the user is promoted to participants.
+type WaitlistEntry struct {
+	UserID     uuid.UUID
+	Stake      Money
+	ClientSeed string
+	Position   int
+}
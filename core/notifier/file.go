@@ -0,0 +1,46 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/kimbellG/tournament/core/debugutil"
+	"github.com/kimbellG/tournament/core/models"
+)
+
+// File appends messages to users to the file instead of delivering them. It's meant for local runs.
+type File struct {
+	path string
+	mu   sync.Mutex
+}
+
+func NewFile(path string) *File {
+	return &File{
+		path: path,
+	}
+}
+
+func (f *File) NotifyPasswordReset(ctx context.Context, user *models.User, token string, expiresAt time.Time) error {
+	return f.write(fmt.Sprintf("%s password reset of user(%v) %s: token %s until %s\n",
+		time.Now().UTC().Format(time.RFC3339), user.ID, user.Name, token, expiresAt.UTC().Format(time.RFC3339)))
+}
+
+func (f *File) write(line string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	file, err := os.OpenFile(f.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("open %s: %w", f.path, err)
+	}
+	defer debugutil.Close(file)
+
+	if _, err := file.WriteString(line); err != nil {
+		return fmt.Errorf("write to %s: %w", f.path, err)
+	}
+
+	return nil
+}
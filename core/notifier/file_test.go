@@ -0,0 +1,40 @@
+package notifier
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileNotifyPasswordReset(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notifications.log")
+	notifier := NewFile(path)
+	user := &models.User{ID: uuid.New(), Name: "player"}
+
+	assert.NoError(t, notifier.NotifyPasswordReset(context.Background(), user, "first-token", time.Now()))
+	assert.NoError(t, notifier.NotifyPasswordReset(context.Background(), user, "second-token", time.Now()))
+
+	content, err := os.ReadFile(path)
+	if assert.NoError(t, err) {
+		lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+		if assert.Len(t, lines, 2, "notifications should be appended") {
+			assert.Contains(t, lines[0], "first-token")
+			assert.Contains(t, lines[1], "second-token")
+			assert.Contains(t, lines[1], user.ID.String())
+		}
+	}
+}
+
+func TestMessage(t *testing.T) {
+	msg := string(message("noreply@example.com", "player@example.com", "Password reset", "text"))
+
+	assert.True(t, strings.HasPrefix(msg, "From: noreply@example.com\r\nTo: player@example.com\r\nSubject: Password reset\r\n"))
+	assert.True(t, strings.HasSuffix(msg, "\r\n\r\ntext\r\n"), "text should follow the headers after an empty line")
+}
@@ -0,0 +1,17 @@
+package notifier
+
+import (
+	"context"
+	"time"
+
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/sirupsen/logrus"
+)
+
+// Log writes messages to users to the log of service instead of delivering them. It's meant for local runs.
+type Log struct{}
+
+func (l *Log) NotifyPasswordReset(ctx context.Context, user *models.User, token string, expiresAt time.Time) error {
+	logrus.Infof("Password reset of user(%v) %s: token %s until %v", user.ID, user.Name, token, expiresAt)
+	return nil
+}
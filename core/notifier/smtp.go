@@ -0,0 +1,65 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"time"
+
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/sirupsen/logrus"
+)
+
+// SMTP sends messages to users by email. Users without email can't be notified and are skipped.
+type SMTP struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+// NewSMTP connects to the server at host and port. Server is used without authentication, when username is empty.
+func NewSMTP(host, port, username, password, from string) *SMTP {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &SMTP{
+		addr: net.JoinHostPort(host, port),
+		auth: auth,
+		from: from,
+	}
+}
+
+func (s *SMTP) NotifyPasswordReset(ctx context.Context, user *models.User, token string, expiresAt time.Time) error {
+	if user.Email == "" {
+		logrus.Warnf("User(%v) has no email to send password reset to", user.ID)
+		return nil
+	}
+
+	msg := message(s.from, user.Email, "Password reset", passwordResetText(user, token, expiresAt))
+	if err := smtp.SendMail(s.addr, s.auth, s.from, []string{user.Email}, msg); err != nil {
+		return fmt.Errorf("send mail to %s: %w", user.Email, err)
+	}
+
+	return nil
+}
+
+func message(from, to, subject, text string) []byte {
+	var msg bytes.Buffer
+
+	fmt.Fprintf(&msg, "From: %s\r\n", from)
+	fmt.Fprintf(&msg, "To: %s\r\n", to)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", subject)
+	fmt.Fprintf(&msg, "Content-Type: text/plain; charset=UTF-8\r\n")
+	fmt.Fprintf(&msg, "\r\n%s\r\n", text)
+
+	return msg.Bytes()
+}
+
+func passwordResetText(user *models.User, token string, expiresAt time.Time) string {
+	return fmt.Sprintf("Hello, %s!\r\n\r\nUse the token to set new password: %s\r\nThe token can be used once until %s.",
+		user.Name, token, expiresAt.UTC().Format(time.RFC1123))
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/core/debugutil"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+)
+
+type HoldRepository struct{}
+
+// Insert holds the money on balance of user. Balance without enough available money breaks the check of held money.
+func (hr *HoldRepository) Insert(ctx context.Context, store tx.DBTX, hold *models.Hold) error {
+	const query = `
+		INSERT INTO Holds(tournamentID, userID, currency, amount) VALUES ($1, $2, $3, $4);
+	`
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare for insert hold: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	if _, err := stmt.ExecContext(ctx, hold.TournamentID, hold.UserID, hold.Currency, hold.Amount); err != nil {
+		return kerror.Newf(kerror.SQLConstraintError, "holding %v %v of user(%v): %v", hold.Amount, hold.Currency, hold.UserID, err)
+	}
+
+	return nil
+}
+
+func (hr *HoldRepository) SelectByTournament(ctx context.Context, store tx.DBTX, tournamentID uuid.UUID) ([]models.Hold, error) {
+	const query = `
+		SELECT userID, currency, amount FROM Holds WHERE tournamentID = $1 ORDER BY createdAt;
+	`
+	holds := []models.Hold{}
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, kerror.Newf(kerror.SQLPrepareStatementError, "prepare for select holds: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	rows, err := stmt.QueryContext(ctx, tournamentID)
+	if err != nil {
+		return nil, kerror.Newf(kerror.SQLQueryError, "query holds of tournament(%v): %v", tournamentID, err)
+	}
+	defer debugutil.Close(rows)
+
+	for rows.Next() {
+		hold := models.Hold{TournamentID: tournamentID}
+
+		if err := rows.Scan(&hold.UserID, &hold.Currency, &hold.Amount); err != nil {
+			return nil, kerror.Newf(kerror.SQLScanError, "scan hold of tournament(%v): %v", tournamentID, err)
+		}
+
+		holds = append(holds, hold)
+	}
+
+	return holds, nil
+}
+
+func (hr *HoldRepository) Delete(ctx context.Context, store tx.DBTX, tournamentID, userID uuid.UUID) error {
+	const query = `
+		DELETE FROM Holds WHERE tournamentID = $1 AND userID = $2;
+	`
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare for delete hold: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	result, err := stmt.ExecContext(ctx, tournamentID, userID)
+	if err != nil {
+		return kerror.Newf(kerror.SQLExecutionError, "deleting hold of user(%v) in tournament(%v): %v", userID, tournamentID, err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return kerror.Newf(kerror.SQLExecutionError, "get count of deleted holds: %v", err)
+	}
+
+	if deleted == 0 {
+		return kerror.Newf(kerror.NotFound, "user(%v) has no hold in tournament(%v)", userID, tournamentID)
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/core/debugutil"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+)
+
+type HouseRepository struct{}
+
+// UpdateBalanceBySum adds d to house balance in currency, opening the balance on the first rake in it.
+func (hr *HouseRepository) UpdateBalanceBySum(ctx context.Context, store tx.DBTX, currency models.Currency, d models.Money) error {
+	const query = `
+		INSERT INTO HouseAccount(currency, balance) VALUES ($1, $2)
+		ON CONFLICT (currency) DO UPDATE
+			SET balance = HouseAccount.balance + EXCLUDED.balance;
+	`
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare for update house balance(addend: %v %v): %v", d, currency, err)
+	}
+	defer debugutil.Close(stmt)
+
+	if _, err := stmt.ExecContext(ctx, currency, d); err != nil {
+		return kerror.Newf(kerror.SQLConstraintError, "updating house balance(addend: %v %v): %v", d, currency, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/core/debugutil"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+)
+
+type IdempotencyRepository struct{}
+
+// Insert claims idempotency key of request. Insert of taken key waits until the transaction holding it finishes.
+func (ir *IdempotencyRepository) Insert(ctx context.Context, store tx.DBTX, request *models.IdempotentRequest) (bool, error) {
+	const query = `
+		INSERT INTO IdempotencyKeys(userID, operation, idempotencyKey, requestHash) VALUES ($1, $2, $3, $4)
+		ON CONFLICT DO NOTHING;
+	`
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return false, kerror.Newf(kerror.SQLPrepareStatementError, "prepare for insert idempotency key: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	result, err := stmt.ExecContext(ctx, request.UserID, request.Operation, request.Key, request.Hash)
+	if err != nil {
+		return false, kerror.Newf(kerror.SQLConstraintError, "inserting idempotency key(%v) of user(%v): %v", request.Key, request.UserID, err)
+	}
+
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return false, kerror.Newf(kerror.SQLExecutionError, "get count of inserted idempotency keys: %v", err)
+	}
+
+	return inserted == 1, nil
+}
+
+func (ir *IdempotencyRepository) Select(ctx context.Context, store tx.DBTX, userID uuid.UUID, operation models.IdempotentOperation, key string) (*models.IdempotentRequest, error) {
+	const query = `
+		SELECT requestHash, response FROM IdempotencyKeys
+		WHERE userID = $1 AND operation = $2 AND idempotencyKey = $3;
+	`
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, kerror.Newf(kerror.SQLPrepareStatementError, "prepare for select idempotency key: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	request := &models.IdempotentRequest{
+		Key:       key,
+		UserID:    userID,
+		Operation: operation,
+	}
+
+	if err := stmt.QueryRowContext(ctx, userID, operation, key).Scan(&request.Hash, &request.Response); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, kerror.Newf(kerror.NotFound, "no idempotency key(%v) of user(%v): %v", key, userID, err)
+		}
+
+		return nil, kerror.Newf(kerror.SQLScanError, "query: %v", err)
+	}
+
+	return request, nil
+}
+
+func (ir *IdempotencyRepository) UpdateResponse(ctx context.Context, store tx.DBTX, request *models.IdempotentRequest) error {
+	const query = `
+		UPDATE IdempotencyKeys SET response = $4
+		WHERE userID = $1 AND operation = $2 AND idempotencyKey = $3;
+	`
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare for update response of idempotency key: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	if _, err := stmt.ExecContext(ctx, request.UserID, request.Operation, request.Key, request.Response); err != nil {
+		return kerror.Newf(kerror.SQLExecutionError, "updating response of idempotency key(%v): %v", request.Key, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/core/debugutil"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+)
+
+type LedgerRepository struct{}
+
+func (lr *LedgerRepository) InsertTransaction(ctx context.Context, store tx.DBTX, transaction *models.LedgerTransaction) error {
+	const query = `
+		INSERT INTO LedgerTransactions(type, currency, tournamentID) VALUES ($1, $2, $3)
+			RETURNING id, createdAt;
+	`
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare query: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	if err := stmt.QueryRowContext(ctx, transaction.Type, transaction.Currency, nullableID(transaction.TournamentID)).Scan(&transaction.ID, &transaction.CreatedAt); err != nil {
+		return kerror.Newf(kerror.SQLConstraintError, "insert ledger transaction: %v", err)
+	}
+
+	if err := lr.insertEntries(ctx, store, transaction); err != nil {
+		return kerror.Errorf(err, "insert entries of ledger transaction")
+	}
+
+	return nil
+}
+
+func (lr *LedgerRepository) insertEntries(ctx context.Context, store tx.DBTX, transaction *models.LedgerTransaction) error {
+	const query = `
+		INSERT INTO LedgerEntries(transactionID, accountType, accountID, amount) VALUES ($1, $2, $3, $4);
+	`
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare query: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	for _, entry := range transaction.Entries {
+		if _, err := stmt.ExecContext(ctx, transaction.ID, entry.Account.Type, nullableID(entry.Account.ID), entry.Amount); err != nil {
+			return kerror.Newf(kerror.SQLConstraintError, "insert entry of %v account: %v", entry.Account.Type, err)
+		}
+	}
+
+	return nil
+}
+
+// SelectUserTransactions returns entries of user account, the newest first, with the balance
+// in currency of entry after every one of them.
+func (lr *LedgerRepository) SelectUserTransactions(ctx context.Context, store tx.DBTX, userID uuid.UUID, filter *models.TransactionFilter) ([]models.UserTransaction, error) {
+	const query = `
+		WITH history AS (
+			SELECT LedgerTransactions.id, LedgerTransactions.type, LedgerTransactions.currency, LedgerTransactions.tournamentID, LedgerEntries.amount,
+				SUM(LedgerEntries.amount) OVER (
+					PARTITION BY LedgerTransactions.currency ORDER BY LedgerTransactions.createdAt, LedgerEntries.id
+				) AS balanceAfter,
+				LedgerTransactions.createdAt, LedgerEntries.id AS entryID
+			FROM LedgerEntries INNER JOIN LedgerTransactions ON LedgerTransactions.id = LedgerEntries.transactionID
+			WHERE LedgerEntries.accountType = 'User' AND LedgerEntries.accountID = $1
+		)
+		SELECT id, type, currency, tournamentID, amount, balanceAfter, createdAt
+		FROM history
+		WHERE ($2 = '' OR type::text = ANY(string_to_array($2, ',')))
+			AND ($3 = '' OR currency = $3)
+			AND ($4::timestamptz IS NULL OR createdAt >= $4)
+			AND ($5::timestamptz IS NULL OR createdAt < $5)
+		ORDER BY createdAt DESC, entryID DESC
+		LIMIT $6 OFFSET $7;
+	`
+	transactions := []models.UserTransaction{}
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, kerror.Newf(kerror.SQLPrepareStatementError, "prepare query: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	rows, err := stmt.QueryContext(ctx, userID, joinTransactionTypes(filter.Types), filter.Currency,
+		nullableTime(filter.From), nullableTime(filter.To), filter.Limit, filter.Offset)
+	if err != nil {
+		return nil, kerror.Newf(kerror.SQLQueryError, "query transactions: %v", err)
+	}
+	defer debugutil.Close(rows)
+
+	for rows.Next() {
+		var transaction models.UserTransaction
+
+		if err := rows.Scan(&transaction.ID, &transaction.Type, &transaction.Currency, &transaction.TournamentID, &transaction.Amount,
+			&transaction.BalanceAfter, &transaction.CreatedAt); err != nil {
+			return nil, kerror.Newf(kerror.SQLScanError, "scan transaction of user(%v): %v", userID, err)
+		}
+
+		transactions = append(transactions, transaction)
+	}
+
+	return transactions, nil
+}
+
+func joinTransactionTypes(types []models.TransactionType) string {
+	names := make([]string, 0, len(types))
+	for _, transactionType := range types {
+		names = append(names, string(transactionType))
+	}
+
+	return strings.Join(names, ",")
+}
@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/core/debugutil"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+)
+
+type LimitRepository struct{}
+
+// SelectByUser locks the limits of user until the transaction finishes.
+func (lr *LimitRepository) SelectByUser(ctx context.Context, store tx.DBTX, userID uuid.UUID) ([]models.Limit, error) {
+	const query = `
+		SELECT kind, period, currency, amount, pendingAmount, pendingFrom FROM UserLimits
+		WHERE userID = $1
+		ORDER BY kind, period, currency
+		FOR UPDATE;
+	`
+	limits := []models.Limit{}
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, kerror.Newf(kerror.SQLPrepareStatementError, "prepare for select limits: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	rows, err := stmt.QueryContext(ctx, userID)
+	if err != nil {
+		return nil, kerror.Newf(kerror.SQLQueryError, "query limits of user(%v): %v", userID, err)
+	}
+	defer debugutil.Close(rows)
+
+	for rows.Next() {
+		limit := models.Limit{UserID: userID}
+		var pendingFrom sql.NullTime
+
+		if err := rows.Scan(&limit.Kind, &limit.Period, &limit.Currency, &limit.Amount, &limit.PendingAmount, &pendingFrom); err != nil {
+			return nil, kerror.Newf(kerror.SQLScanError, "scan limit of user(%v): %v", userID, err)
+		}
+		limit.PendingFrom = timeOf(pendingFrom)
+
+		limits = append(limits, limit)
+	}
+
+	return limits, nil
+}
+
+func (lr *LimitRepository) Upsert(ctx context.Context, store tx.DBTX, limit *models.Limit) error {
+	const query = `
+		INSERT INTO UserLimits(userID, kind, period, currency, amount, pendingAmount, pendingFrom) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (userID, kind, period, currency) DO UPDATE
+			SET amount = EXCLUDED.amount, pendingAmount = EXCLUDED.pendingAmount, pendingFrom = EXCLUDED.pendingFrom;
+	`
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare for upsert limit: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	var pendingAmount interface{}
+	if !limit.PendingFrom.IsZero() {
+		pendingAmount = limit.PendingAmount
+	}
+
+	if _, err := stmt.ExecContext(ctx, limit.UserID, limit.Kind, limit.Period, limit.Currency, limit.Amount,
+		pendingAmount, nullableTime(limit.PendingFrom)); err != nil {
+		return kerror.Newf(kerror.SQLConstraintError, "saving %v limit of user(%v): %v", limit.Kind, limit.UserID, err)
+	}
+
+	return nil
+}
+
+func (lr *LimitRepository) SelectExclusion(ctx context.Context, store tx.DBTX, userID uuid.UUID) (time.Time, error) {
+	const query = `
+		SELECT excludedUntil FROM Users WHERE id = $1;
+	`
+	var until sql.NullTime
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return time.Time{}, kerror.Newf(kerror.SQLPrepareStatementError, "prepare for select self-exclusion: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	if err := stmt.QueryRowContext(ctx, userID).Scan(&until); err != nil {
+		if err == sql.ErrNoRows {
+			return time.Time{}, kerror.Newf(kerror.UserDoesntExists, "no user with id %v: %v", userID, err)
+		}
+
+		return time.Time{}, kerror.Newf(kerror.SQLScanError, "query: %v", err)
+	}
+
+	return timeOf(until), nil
+}
+
+func (lr *LimitRepository) UpdateExclusion(ctx context.Context, store tx.DBTX, userID uuid.UUID, until time.Time) error {
+	const query = `
+		UPDATE Users SET excludedUntil = $1 WHERE id = $2;
+	`
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare for update self-exclusion: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	if _, err := stmt.ExecContext(ctx, until, userID); err != nil {
+		return kerror.Newf(kerror.SQLExecutionError, "updating self-exclusion of user(%v): %v", userID, err)
+	}
+
+	return nil
+}
+
+// SumDeposits counts the money user has funded balance with since the moment.
+func (lr *LimitRepository) SumDeposits(ctx context.Context, store tx.DBTX, userID uuid.UUID, currency models.Currency, since time.Time) (models.Money, error) {
+	const query = `
+		SELECT COALESCE(SUM(LedgerEntries.amount), 0)
+		FROM LedgerEntries INNER JOIN LedgerTransactions ON LedgerTransactions.id = LedgerEntries.transactionID
+		WHERE LedgerEntries.accountType = 'User' AND LedgerEntries.accountID = $1
+			AND LedgerTransactions.type = 'Fund' AND LedgerTransactions.currency = $2 AND LedgerTransactions.createdAt >= $3;
+	`
+
+	return sumMoney(ctx, store, query, "deposits", userID, currency, since)
+}
+
+// SumEntries counts the stakes of tournaments and waitlists user has entered since the moment.
+// Stakes of entries user has left aren't counted.
+func (lr *LimitRepository) SumEntries(ctx context.Context, store tx.DBTX, userID uuid.UUID, currency models.Currency, since time.Time) (models.Money, error) {
+	const query = `
+		SELECT COALESCE(SUM(stake), 0) FROM (
+			SELECT COALESCE(UsersOfTournaments.stake, Tournaments.deposit) AS stake
+			FROM UsersOfTournaments INNER JOIN Tournaments ON Tournaments.id = UsersOfTournaments.tournamentID
+			WHERE UsersOfTournaments.userID = $1 AND Tournaments.currency = $2 AND UsersOfTournaments.joinedAt >= $3
+			UNION ALL
+			SELECT Waitlist.stake
+			FROM Waitlist INNER JOIN Tournaments ON Tournaments.id = Waitlist.tournamentID
+			WHERE Waitlist.userID = $1 AND Tournaments.currency = $2 AND Waitlist.joinedAt >= $3
+		) AS Entries;
+	`
+
+	return sumMoney(ctx, store, query, "entries", userID, currency, since)
+}
+
+func sumMoney(ctx context.Context, store tx.DBTX, query, name string, userID uuid.UUID, currency models.Currency, since time.Time) (models.Money, error) {
+	var sum models.Money
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return sum, kerror.Newf(kerror.SQLPrepareStatementError, "prepare for sum %s: %v", name, err)
+	}
+	defer debugutil.Close(stmt)
+
+	if err := stmt.QueryRowContext(ctx, userID, currency, since).Scan(&sum); err != nil {
+		return sum, kerror.Newf(kerror.SQLScanError, "sum %s of user(%v): %v", name, userID, err)
+	}
+
+	return sum, nil
+}
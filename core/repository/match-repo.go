@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/core/debugutil"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+)
+
+type MatchRepository struct{}
+
+func (mr *MatchRepository) Insert(ctx context.Context, store tx.DBTX, match *models.Match) (uuid.UUID, error) {
+	const query = `
+		INSERT INTO Matches(tournamentID, round, position, firstUser, secondUser, winner, draw)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+			RETURNING id;
+	`
+	var id uuid.UUID
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return id, kerror.Newf(kerror.SQLPrepareStatementError, "prepare stmt: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	if err := stmt.QueryRowContext(ctx,
+		match.TournamentID,
+		match.Round,
+		match.Position,
+		nullableID(match.FirstUser),
+		nullableID(match.SecondUser),
+		nullableID(match.Winner),
+		match.Draw,
+	).Scan(&id); err != nil {
+		return id, kerror.Newf(kerror.SQLConstraintError, "insert match: %v", err)
+	}
+
+	return id, nil
+}
+
+func (mr *MatchRepository) SelectByID(ctx context.Context, store tx.DBTX, id uuid.UUID) (*models.Match, error) {
+	const query = `
+		SELECT id, tournamentID, round, position, firstUser, secondUser, winner, draw
+		FROM Matches WHERE id = $1;
+	`
+	match := &models.Match{}
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, kerror.Newf(kerror.SQLPrepareStatementError, "prepare stmt: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	if err := scanMatch(stmt.QueryRowContext(ctx, id), match); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, kerror.Newf(kerror.NotFound, "match with id(%v) isn't exists: %v", id, err)
+		}
+
+		return nil, kerror.Newf(kerror.SQLScanError, "scan match: %v", err)
+	}
+
+	return match, nil
+}
+
+func (mr *MatchRepository) SelectByPosition(ctx context.Context, store tx.DBTX, tournamentID uuid.UUID, round, position int) (*models.Match, error) {
+	const query = `
+		SELECT id, tournamentID, round, position, firstUser, secondUser, winner, draw
+		FROM Matches WHERE tournamentID = $1 AND round = $2 AND position = $3;
+	`
+	match := &models.Match{}
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, kerror.Newf(kerror.SQLPrepareStatementError, "prepare stmt: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	if err := scanMatch(stmt.QueryRowContext(ctx, tournamentID, round, position), match); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, kerror.Newf(kerror.NotFound, "match(round: %v, position: %v) of tournament(%v) isn't exists: %v", round, position, tournamentID, err)
+		}
+
+		return nil, kerror.Newf(kerror.SQLScanError, "scan match: %v", err)
+	}
+
+	return match, nil
+}
+
+func (mr *MatchRepository) SelectByTournament(ctx context.Context, store tx.DBTX, tournamentID uuid.UUID) ([]models.Match, error) {
+	const query = `
+		SELECT id, tournamentID, round, position, firstUser, secondUser, winner, draw
+		FROM Matches WHERE tournamentID = $1
+		ORDER BY round, position;
+	`
+	matches := []models.Match{}
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, kerror.Newf(kerror.SQLPrepareStatementError, "prepare stmt: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	rows, err := stmt.QueryContext(ctx, tournamentID)
+	if err != nil {
+		return nil, kerror.Newf(kerror.SQLQueryError, "query matches: %v", err)
+	}
+	defer debugutil.Close(rows)
+
+	for rows.Next() {
+		var match models.Match
+
+		if err := scanMatch(rows, &match); err != nil {
+			return nil, kerror.Newf(kerror.SQLScanError, "scan match of tournament(%v): %v", tournamentID, err)
+		}
+
+		matches = append(matches, match)
+	}
+
+	return matches, nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanMatch(row scanner, match *models.Match) error {
+	return row.Scan(&match.ID, &match.TournamentID, &match.Round, &match.Position, &match.FirstUser, &match.SecondUser, &match.Winner, &match.Draw)
+}
+
+func (mr *MatchRepository) Update(ctx context.Context, store tx.DBTX, match *models.Match) error {
+	const query = `
+		UPDATE Matches
+			SET firstUser = $1, secondUser = $2, winner = $3, draw = $4
+			WHERE id = $5;
+	`
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare stmt: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	if _, err := stmt.ExecContext(ctx,
+		nullableID(match.FirstUser),
+		nullableID(match.SecondUser),
+		nullableID(match.Winner),
+		match.Draw,
+		match.ID,
+	); err != nil {
+		return kerror.Newf(kerror.SQLExecutionError, "exec update query: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func nullableID(id uuid.UUID) interface{} {
+	if id == uuid.Nil {
+		return nil
+	}
+
+	return id
+}
+
+func nullableString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+
+	return s
+}
+
+func nullableTime(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+
+	return t
+}
+
+func timeOf(t sql.NullTime) time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+
+	return t.Time
+}
+
+func idOf(id uuid.NullUUID) uuid.UUID {
+	if !id.Valid {
+		return uuid.Nil
+	}
+
+	return id.UUID
+}
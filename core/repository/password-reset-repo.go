@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/core/debugutil"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+)
+
+type PasswordResetRepository struct{}
+
+func (pr *PasswordResetRepository) Insert(ctx context.Context, store tx.DBTX, reset *models.PasswordReset) error {
+	const query = `
+		INSERT INTO PasswordResets(tokenHash, userID, expiresAt) VALUES ($1, $2, $3);
+	`
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare for insert password reset: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	if _, err := stmt.ExecContext(ctx, reset.TokenHash, reset.UserID, reset.ExpiresAt); err != nil {
+		return kerror.Newf(kerror.SQLConstraintError, "saving password reset of user(%v): %v", reset.UserID, err)
+	}
+
+	return nil
+}
+
+// Take deletes the password reset with the hash of token and returns it. Unknown token gives nil.
+func (pr *PasswordResetRepository) Take(ctx context.Context, store tx.DBTX, tokenHash string) (*models.PasswordReset, error) {
+	const query = `
+		DELETE FROM PasswordResets WHERE tokenHash = $1
+			RETURNING userID, expiresAt;
+	`
+	reset := &models.PasswordReset{TokenHash: tokenHash}
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, kerror.Newf(kerror.SQLPrepareStatementError, "prepare for take password reset: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	if err := stmt.QueryRowContext(ctx, tokenHash).Scan(&reset.UserID, &reset.ExpiresAt); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, kerror.Newf(kerror.SQLScanError, "take password reset: %v", err)
+	}
+
+	return reset, nil
+}
+
+func (pr *PasswordResetRepository) DeleteByUser(ctx context.Context, store tx.DBTX, userID uuid.UUID) error {
+	const query = `
+		DELETE FROM PasswordResets WHERE userID = $1;
+	`
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare for delete password resets: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	if _, err := stmt.ExecContext(ctx, userID); err != nil {
+		return kerror.Newf(kerror.SQLExecutionError, "deleting password resets of user(%v): %v", userID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,208 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/core/debugutil"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+)
+
+type ReconciliationRepository struct{}
+
+// discrepancyQueries select user, tournament, currency, recorded and expected value of every discrepancy of the kind.
+// Users that were deleted aren't reconciled: their money in the ledger has nowhere to go.
+// Stakes of tournaments brought in by the opening balances of the ledger were charged before it, so they aren't checked.
+var discrepancyQueries = map[models.DiscrepancyKind]string{
+	models.UserBalanceDiscrepancy: `
+		WITH Ledger AS (
+			SELECT LedgerEntries.accountID AS userID, LedgerTransactions.currency, SUM(LedgerEntries.amount) AS balance
+			FROM LedgerEntries INNER JOIN LedgerTransactions ON LedgerTransactions.id = LedgerEntries.transactionID
+			WHERE LedgerEntries.accountType = 'User' AND LedgerEntries.accountID IN (SELECT id FROM Users)
+			GROUP BY LedgerEntries.accountID, LedgerTransactions.currency
+		)
+		SELECT COALESCE(UserBalances.userID, Ledger.userID), NULL::uuid, COALESCE(UserBalances.currency, Ledger.currency),
+			COALESCE(UserBalances.balance, 0), COALESCE(Ledger.balance, 0)
+		FROM UserBalances FULL JOIN Ledger ON Ledger.userID = UserBalances.userID AND Ledger.currency = UserBalances.currency
+		WHERE COALESCE(UserBalances.balance, 0) <> COALESCE(Ledger.balance, 0)
+		ORDER BY 1, 3;
+	`,
+	models.HeldDiscrepancy: `
+		WITH Held AS (
+			SELECT userID, currency, SUM(amount) AS held FROM Holds GROUP BY userID, currency
+		)
+		SELECT UserBalances.userID, NULL::uuid, UserBalances.currency, UserBalances.held, COALESCE(Held.held, 0)
+		FROM UserBalances LEFT JOIN Held ON Held.userID = UserBalances.userID AND Held.currency = UserBalances.currency
+		WHERE UserBalances.held <> COALESCE(Held.held, 0)
+		ORDER BY 1, 3;
+	`,
+	models.HouseBalanceDiscrepancy: `
+		WITH Ledger AS (
+			SELECT LedgerTransactions.currency, SUM(LedgerEntries.amount) AS balance
+			FROM LedgerEntries INNER JOIN LedgerTransactions ON LedgerTransactions.id = LedgerEntries.transactionID
+			WHERE LedgerEntries.accountType = 'House'
+			GROUP BY LedgerTransactions.currency
+		)
+		SELECT NULL::uuid, NULL::uuid, COALESCE(HouseAccount.currency, Ledger.currency),
+			COALESCE(HouseAccount.balance, 0), COALESCE(Ledger.balance, 0)
+		FROM HouseAccount FULL JOIN Ledger ON Ledger.currency = HouseAccount.currency
+		WHERE COALESCE(HouseAccount.balance, 0) <> COALESCE(Ledger.balance, 0)
+		ORDER BY 3;
+	`,
+	models.PrizeDiscrepancy: `
+		WITH Pools AS (
+			SELECT accountID AS tournamentID, SUM(amount) AS pool FROM LedgerEntries
+			WHERE accountType = 'Tournament'
+			GROUP BY accountID
+		)
+		SELECT NULL::uuid, Tournaments.id, Tournaments.currency, Tournaments.prize, COALESCE(Pools.pool, 0)
+		FROM Tournaments LEFT JOIN Pools ON Pools.tournamentID = Tournaments.id
+		WHERE Tournaments.status IN ('Draft', 'RegistrationOpen', 'RegistrationClosed', 'InProgress')
+			AND Tournaments.prize <> COALESCE(Pools.pool, 0)
+		ORDER BY 2;
+	`,
+	models.UnpaidStakeDiscrepancy: `
+		SELECT UsersOfTournaments.userID, Tournaments.id, Tournaments.currency, 0, COALESCE(UsersOfTournaments.stake, Tournaments.deposit)
+		FROM UsersOfTournaments INNER JOIN Tournaments ON Tournaments.id = UsersOfTournaments.tournamentID
+		WHERE Tournaments.status IN ('Draft', 'RegistrationOpen', 'RegistrationClosed', 'InProgress')
+			AND COALESCE(UsersOfTournaments.stake, Tournaments.deposit) > 0
+			AND NOT EXISTS (
+				SELECT 1 FROM Holds
+				WHERE Holds.tournamentID = Tournaments.id AND Holds.userID = UsersOfTournaments.userID
+			)
+			AND NOT EXISTS (
+				SELECT 1 FROM LedgerEntries INNER JOIN LedgerTransactions ON LedgerTransactions.id = LedgerEntries.transactionID
+				WHERE LedgerTransactions.tournamentID = Tournaments.id AND LedgerTransactions.type = 'Join'
+					AND LedgerEntries.accountType = 'User' AND LedgerEntries.accountID = UsersOfTournaments.userID
+			)
+			AND NOT EXISTS (
+				SELECT 1 FROM LedgerTransactions
+				WHERE LedgerTransactions.tournamentID = Tournaments.id AND LedgerTransactions.type = 'Adjustment'
+			)
+		ORDER BY 2, 1;
+	`,
+	models.OrphanHoldDiscrepancy: `
+		SELECT Holds.userID, Holds.tournamentID, Holds.currency, Holds.amount, 0
+		FROM Holds INNER JOIN Tournaments ON Tournaments.id = Holds.tournamentID
+		WHERE Tournaments.status NOT IN ('RegistrationOpen', 'RegistrationClosed')
+			OR NOT EXISTS (
+				SELECT 1 FROM UsersOfTournaments
+				WHERE UsersOfTournaments.tournamentID = Holds.tournamentID AND UsersOfTournaments.userID = Holds.userID
+			)
+		ORDER BY 2, 1;
+	`,
+}
+
+// Lock stops changes of money until the transaction finishes, so discrepancies are found in a consistent state.
+// Reading isn't blocked.
+func (rr *ReconciliationRepository) Lock(ctx context.Context, store tx.DBTX) error {
+	const query = `
+		LOCK TABLE UserBalances, HouseAccount, Tournaments, UsersOfTournaments, Holds, LedgerTransactions, LedgerEntries
+		IN SHARE MODE;
+	`
+
+	if _, err := store.ExecContext(ctx, query); err != nil {
+		return kerror.Newf(kerror.SQLExecutionError, "locking tables of balances: %v", err)
+	}
+
+	return nil
+}
+
+func (rr *ReconciliationRepository) SelectDiscrepancies(ctx context.Context, store tx.DBTX, kind models.DiscrepancyKind) ([]models.Discrepancy, error) {
+	query, ok := discrepancyQueries[kind]
+	if !ok {
+		return nil, kerror.Newf(kerror.InternalServerError, "unknown kind of discrepancy: %v", kind)
+	}
+	discrepancies := []models.Discrepancy{}
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, kerror.Newf(kerror.SQLPrepareStatementError, "prepare for select discrepancies of %v: %v", kind, err)
+	}
+	defer debugutil.Close(stmt)
+
+	rows, err := stmt.QueryContext(ctx)
+	if err != nil {
+		return nil, kerror.Newf(kerror.SQLQueryError, "query discrepancies of %v: %v", kind, err)
+	}
+	defer debugutil.Close(rows)
+
+	for rows.Next() {
+		discrepancy := models.Discrepancy{Kind: kind}
+		var userID, tournamentID uuid.NullUUID
+
+		if err := rows.Scan(&userID, &tournamentID, &discrepancy.Currency, &discrepancy.Recorded, &discrepancy.Expected); err != nil {
+			return nil, kerror.Newf(kerror.SQLScanError, "scan discrepancy of %v: %v", kind, err)
+		}
+		discrepancy.UserID, discrepancy.TournamentID = idOf(userID), idOf(tournamentID)
+
+		discrepancies = append(discrepancies, discrepancy)
+	}
+
+	return discrepancies, nil
+}
+
+// Correct derives the value again instead of writing the expected one, so earlier corrections are taken into account.
+func (rr *ReconciliationRepository) Correct(ctx context.Context, store tx.DBTX, discrepancy *models.Discrepancy) error {
+	query, args, err := correctionOf(discrepancy)
+	if err != nil {
+		return kerror.Errorf(err, "correction")
+	}
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare for correct %v: %v", discrepancy.Kind, err)
+	}
+	defer debugutil.Close(stmt)
+
+	if _, err := stmt.ExecContext(ctx, args...); err != nil {
+		return kerror.Newf(kerror.SQLConstraintError, "correcting %v: %v", discrepancy.Kind, err)
+	}
+
+	return nil
+}
+
+func correctionOf(discrepancy *models.Discrepancy) (string, []interface{}, error) {
+	switch discrepancy.Kind {
+	case models.OrphanHoldDiscrepancy:
+		return `
+			DELETE FROM Holds WHERE tournamentID = $1 AND userID = $2;
+		`, []interface{}{discrepancy.TournamentID, discrepancy.UserID}, nil
+	case models.HeldDiscrepancy:
+		return `
+			UPDATE UserBalances
+			SET held = COALESCE((SELECT SUM(amount) FROM Holds WHERE userID = $1 AND currency = $2), 0)
+			WHERE userID = $1 AND currency = $2;
+		`, []interface{}{discrepancy.UserID, discrepancy.Currency}, nil
+	case models.UserBalanceDiscrepancy:
+		// Held money is counted together with the balance, since the balance can't be less than it.
+		return `
+			INSERT INTO UserBalances(userID, currency, balance, held)
+				SELECT $1, $2, COALESCE(SUM(LedgerEntries.amount), 0),
+					COALESCE((SELECT SUM(amount) FROM Holds WHERE userID = $1 AND currency = $2), 0)
+				FROM LedgerEntries INNER JOIN LedgerTransactions ON LedgerTransactions.id = LedgerEntries.transactionID
+				WHERE LedgerEntries.accountType = 'User' AND LedgerEntries.accountID = $1 AND LedgerTransactions.currency = $2
+			ON CONFLICT (userID, currency) DO UPDATE
+				SET balance = EXCLUDED.balance, held = EXCLUDED.held;
+		`, []interface{}{discrepancy.UserID, discrepancy.Currency}, nil
+	case models.HouseBalanceDiscrepancy:
+		return `
+			INSERT INTO HouseAccount(currency, balance)
+				SELECT $1, COALESCE(SUM(LedgerEntries.amount), 0)
+				FROM LedgerEntries INNER JOIN LedgerTransactions ON LedgerTransactions.id = LedgerEntries.transactionID
+				WHERE LedgerEntries.accountType = 'House' AND LedgerTransactions.currency = $1
+			ON CONFLICT (currency) DO UPDATE
+				SET balance = EXCLUDED.balance;
+		`, []interface{}{discrepancy.Currency}, nil
+	case models.PrizeDiscrepancy:
+		return `
+			UPDATE Tournaments
+			SET prize = COALESCE((SELECT SUM(amount) FROM LedgerEntries WHERE accountType = 'Tournament' AND accountID = $1), 0)
+			WHERE id = $1;
+		`, []interface{}{discrepancy.TournamentID}, nil
+	default:
+		return "", nil, kerror.Newf(kerror.InternalServerError, "%v can't be corrected", discrepancy.Kind)
+	}
+}
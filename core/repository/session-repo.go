@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/core/debugutil"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/tx"
+)
+
+type SessionRepository struct{}
+
+func (sr *SessionRepository) Insert(ctx context.Context, store tx.DBTX, session *models.Session) (uuid.UUID, error) {
+	const query = `
+		INSERT INTO Sessions(userID, expiresAt) VALUES ($1, $2)
+			RETURNING id;
+	`
+	var id uuid.UUID
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return uuid.UUID{}, kerror.Newf(kerror.SQLPrepareStatementError, "prepare for insert session: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	if err := stmt.QueryRowContext(ctx, session.UserID, session.ExpiresAt).Scan(&id); err != nil {
+		return uuid.UUID{}, kerror.Newf(kerror.SQLConstraintError, "saving session of user(%v): %v", session.UserID, err)
+	}
+
+	return id, nil
+}
+
+// SelectByID returns the session with id. Unknown session gives nil.
+func (sr *SessionRepository) SelectByID(ctx context.Context, store tx.DBTX, id uuid.UUID) (*models.Session, error) {
+	const query = `
+		SELECT userID, expiresAt, revokedAt FROM Sessions WHERE id = $1;
+	`
+	session := &models.Session{ID: id}
+	var revokedAt sql.NullTime
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, kerror.Newf(kerror.SQLPrepareStatementError, "prepare for select session: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	if err := stmt.QueryRowContext(ctx, id).Scan(&session.UserID, &session.ExpiresAt, &revokedAt); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, kerror.Newf(kerror.SQLScanError, "select session(%v): %v", id, err)
+	}
+	session.RevokedAt = timeOf(revokedAt)
+
+	return session, nil
+}
+
+func (sr *SessionRepository) UpdateExpiration(ctx context.Context, store tx.DBTX, id uuid.UUID, expiresAt time.Time) error {
+	const query = `
+		UPDATE Sessions SET expiresAt = $1 WHERE id = $2;
+	`
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare for update expiration of session: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	if _, err := stmt.ExecContext(ctx, expiresAt, id); err != nil {
+		return kerror.Newf(kerror.SQLExecutionError, "updating expiration of session(%v): %v", id, err)
+	}
+
+	return nil
+}
+
+// Revoke marks the session revoked. Session revoked earlier keeps its first time of revocation.
+func (sr *SessionRepository) Revoke(ctx context.Context, store tx.DBTX, id uuid.UUID) error {
+	const query = `
+		UPDATE Sessions SET revokedAt = now() WHERE id = $1 AND revokedAt IS NULL;
+	`
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare for revoke session: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	if _, err := stmt.ExecContext(ctx, id); err != nil {
+		return kerror.Newf(kerror.SQLExecutionError, "revoking session(%v): %v", id, err)
+	}
+
+	return nil
+}
+
+// RevokeByUser marks every session of user revoked except the session except, which may be uuid.Nil.
+func (sr *SessionRepository) RevokeByUser(ctx context.Context, store tx.DBTX, userID, except uuid.UUID) error {
+	const query = `
+		UPDATE Sessions SET revokedAt = now() WHERE userID = $1 AND id <> $2 AND revokedAt IS NULL;
+	`
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare for revoke sessions of user: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	if _, err := stmt.ExecContext(ctx, userID, except); err != nil {
+		return kerror.Newf(kerror.SQLExecutionError, "revoking sessions of user(%v): %v", userID, err)
+	}
+
+	return nil
+}
+
+func (sr *SessionRepository) InsertRefreshToken(ctx context.Context, store tx.DBTX, token *models.RefreshToken) error {
+	const query = `
+		INSERT INTO RefreshTokens(tokenHash, sessionID) VALUES ($1, $2);
+	`
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare for insert refresh token: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	if _, err := stmt.ExecContext(ctx, token.TokenHash, token.SessionID); err != nil {
+		return kerror.Newf(kerror.SQLConstraintError, "saving refresh token of session(%v): %v", token.SessionID, err)
+	}
+
+	return nil
+}
+
+// SelectRefreshToken locks the refresh token with the hash until the transaction finishes,
+// so the token can't be used twice concurrently. Unknown token gives nil.
+func (sr *SessionRepository) SelectRefreshToken(ctx context.Context, store tx.DBTX, tokenHash string) (*models.RefreshToken, error) {
+	const query = `
+		SELECT sessionID, usedAt FROM RefreshTokens WHERE tokenHash = $1
+			FOR UPDATE;
+	`
+	token := &models.RefreshToken{TokenHash: tokenHash}
+	var usedAt sql.NullTime
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, kerror.Newf(kerror.SQLPrepareStatementError, "prepare for select refresh token: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	if err := stmt.QueryRowContext(ctx, tokenHash).Scan(&token.SessionID, &usedAt); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, kerror.Newf(kerror.SQLScanError, "select refresh token: %v", err)
+	}
+	token.UsedAt = timeOf(usedAt)
+
+	return token, nil
+}
+
+func (sr *SessionRepository) UseRefreshToken(ctx context.Context, store tx.DBTX, tokenHash string) error {
+	const query = `
+		UPDATE RefreshTokens SET usedAt = now() WHERE tokenHash = $1;
+	`
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare for use refresh token: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	if _, err := stmt.ExecContext(ctx, tokenHash); err != nil {
+		return kerror.Newf(kerror.SQLExecutionError, "using refresh token: %v", err)
+	}
+
+	return nil
+}
@@ -3,6 +3,10 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/kimbellG/kerror"
@@ -15,7 +19,10 @@ type TournamentRepository struct{}
 
 func (tr *TournamentRepository) Insert(ctx context.Context, store tx.DBTX, tournament *models.Tournament) (uuid.UUID, error) {
 	const query = `
-		INSERT INTO Tournaments(name, deposit) VALUES ($1, $2)
+		INSERT INTO Tournaments(name, deposit, format, rounds, tiebreakers, winnerStrategy, serverSeed, serverSeedHash, payoutType,
+			rakeType, rake, status, registrationOpensAt, registrationClosesAt, startTime, finishDeadline,
+			minPlayers, maxPlayers, withdrawalPenalty, currency)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)
 			RETURNING id;
 	`
 	var id uuid.UUID
@@ -26,7 +33,28 @@ func (tr *TournamentRepository) Insert(ctx context.Context, store tx.DBTX, tourn
 	}
 	defer debugutil.Close(stmt)
 
-	if err := stmt.QueryRowContext(ctx, tournament.Name, tournament.Deposit).Scan(&id); err != nil {
+	if err := stmt.QueryRowContext(ctx,
+		tournament.Name,
+		tournament.Deposit,
+		tournament.Format,
+		tournament.Rounds,
+		joinTiebreakers(tournament.Tiebreakers),
+		tournament.WinnerStrategy,
+		tournament.ServerSeed,
+		tournament.ServerSeedHash,
+		tournament.PayoutType,
+		tournament.RakeType,
+		rakeValue(tournament),
+		tournament.Status,
+		nullableTime(tournament.Schedule.RegistrationOpensAt),
+		nullableTime(tournament.Schedule.RegistrationClosesAt),
+		nullableTime(tournament.Schedule.StartTime),
+		nullableTime(tournament.Schedule.FinishDeadline),
+		tournament.MinPlayers,
+		tournament.MaxPlayers,
+		tournament.WithdrawalPenalty,
+		tournament.Currency,
+	).Scan(&id); err != nil {
 		return id, kerror.Newf(kerror.SQLConstraintError, "insert tournament: %w", err)
 	}
 
@@ -35,9 +63,17 @@ func (tr *TournamentRepository) Insert(ctx context.Context, store tx.DBTX, tourn
 
 func (tr *TournamentRepository) SelectByID(ctx context.Context, store tx.DBTX, id uuid.UUID) (*models.Tournament, error) {
 	const query = `
-		SELECT * FROM Tournaments WHERE id = $1
+		SELECT id, name, deposit, prize, winner, status, format, rounds, tiebreakers, winnerStrategy,
+			COALESCE(serverSeed, ''), COALESCE(serverSeedHash, ''), seedRevealed, payoutType,
+			rakeType, rake, rakeCollected, registrationOpensAt, registrationClosesAt, startTime, finishDeadline,
+			minPlayers, maxPlayers, withdrawalPenalty, currency
+		FROM Tournaments WHERE id = $1
 	`
 	tournament := &models.Tournament{}
+	var (
+		tiebreakers, rake                            string
+		opensAt, closesAt, startTime, finishDeadline sql.NullTime
+	)
 
 	stmt, err := store.PrepareContext(ctx, query)
 	if err != nil {
@@ -45,13 +81,49 @@ func (tr *TournamentRepository) SelectByID(ctx context.Context, store tx.DBTX, i
 	}
 	defer debugutil.Close(stmt)
 
-	if err := stmt.QueryRowContext(ctx, id).Scan(&tournament.ID, &tournament.Name, &tournament.Deposit, &tournament.Prize, &tournament.Winner, &tournament.Status); err != nil {
+	if err := stmt.QueryRowContext(ctx, id).Scan(
+		&tournament.ID,
+		&tournament.Name,
+		&tournament.Deposit,
+		&tournament.Prize,
+		&tournament.Winner,
+		&tournament.Status,
+		&tournament.Format,
+		&tournament.Rounds,
+		&tiebreakers,
+		&tournament.WinnerStrategy,
+		&tournament.ServerSeed,
+		&tournament.ServerSeedHash,
+		&tournament.SeedRevealed,
+		&tournament.PayoutType,
+		&tournament.RakeType,
+		&rake,
+		&tournament.RakeCollected,
+		&opensAt,
+		&closesAt,
+		&startTime,
+		&finishDeadline,
+		&tournament.MinPlayers,
+		&tournament.MaxPlayers,
+		&tournament.WithdrawalPenalty,
+		&tournament.Currency,
+	); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, kerror.Newf(kerror.TournamentDoesntExists, "tournament with id(%v) isn't exists: %v", id, err)
 		}
 
 		return nil, kerror.Newf(kerror.SQLScanError, "scan query: %v", err)
 	}
+	tournament.Tiebreakers = splitTiebreakers(tiebreakers)
+	if err := setRake(tournament, rake); err != nil {
+		return nil, kerror.Newf(kerror.SQLScanError, "scan rake of tournament(%v): %v", id, err)
+	}
+	tournament.Schedule = models.Schedule{
+		RegistrationOpensAt:  timeOf(opensAt),
+		RegistrationClosesAt: timeOf(closesAt),
+		StartTime:            timeOf(startTime),
+		FinishDeadline:       timeOf(finishDeadline),
+	}
 
 	users, err := tr.selectUserIDsOfTournament(ctx, store, id)
 	if err != nil {
@@ -59,13 +131,87 @@ func (tr *TournamentRepository) SelectByID(ctx context.Context, store tx.DBTX, i
 	}
 	tournament.Users = users
 
+	if err := tr.selectPayouts(ctx, store, tournament); err != nil {
+		return nil, kerror.Errorf(err, "get payouts of tournament")
+	}
+
+	placements, err := tr.selectPlacements(ctx, store, id)
+	if err != nil {
+		return nil, kerror.Errorf(err, "get placements of tournament")
+	}
+	tournament.Placements = placements
+
 	return tournament, nil
 
 }
 
+// LockByID locks tournament until the end of transaction, so that concurrent entries and transitions are serialized.
+func (tr *TournamentRepository) LockByID(ctx context.Context, store tx.DBTX, id uuid.UUID) error {
+	const query = `
+		SELECT id FROM Tournaments WHERE id = $1 FOR UPDATE;
+	`
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare stmt %v: %v", query, err)
+	}
+	defer debugutil.Close(stmt)
+
+	if err := stmt.QueryRowContext(ctx, id).Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return kerror.Newf(kerror.TournamentDoesntExists, "tournament with id(%v) isn't exists: %v", id, err)
+		}
+
+		return kerror.Newf(kerror.SQLScanError, "scan query: %v", err)
+	}
+
+	return nil
+}
+
+// rakeValue returns what rake column keeps for tournament: the percentage or the amount, by its rake type.
+func rakeValue(tournament *models.Tournament) driver.Valuer {
+	if tournament.RakeType == models.FixedRake {
+		return tournament.RakeAmount
+	}
+
+	return tournament.Rake
+}
+
+// setRake reads value of rake column into the percentage or the amount of tournament, by its rake type.
+func setRake(tournament *models.Tournament, value string) error {
+	var err error
+	if tournament.RakeType == models.FixedRake {
+		tournament.RakeAmount, err = models.ParseMoney(value)
+	} else {
+		tournament.Rake, err = models.ParsePercent(value)
+	}
+
+	return err
+}
+
+func joinTiebreakers(tiebreakers []models.Tiebreaker) string {
+	names := make([]string, 0, len(tiebreakers))
+	for _, tb := range tiebreakers {
+		names = append(names, string(tb))
+	}
+
+	return strings.Join(names, ",")
+}
+
+func splitTiebreakers(value string) []models.Tiebreaker {
+	tiebreakers := []models.Tiebreaker{}
+	for _, name := range strings.Split(value, ",") {
+		if name != "" {
+			tiebreakers = append(tiebreakers, models.Tiebreaker(name))
+		}
+	}
+
+	return tiebreakers
+}
+
 func (tr *TournamentRepository) selectUserIDsOfTournament(ctx context.Context, store tx.DBTX, tournamentID uuid.UUID) ([]models.User, error) {
 	const query = `
-		SELECT Users.id, Users.name, Users.balance
+		SELECT Users.id, Users.name
 		FROM UsersOfTournaments INNER JOIN Users ON Users.id = UsersOfTournaments.userID
 		WHERE tournamentID = $1;
 	`
@@ -86,7 +232,7 @@ func (tr *TournamentRepository) selectUserIDsOfTournament(ctx context.Context, s
 	for rows.Next() {
 		var user models.User
 
-		if err := rows.Scan(&user.ID, &user.Name, &user.Balance); err != nil {
+		if err := rows.Scan(&user.ID, &user.Name); err != nil {
 			return nil, kerror.Newf(kerror.SQLScanError, "scan user ids of tournament(%v): %v", tournamentID, err)
 		}
 
@@ -96,32 +242,192 @@ func (tr *TournamentRepository) selectUserIDsOfTournament(ctx context.Context, s
 	return users, nil
 }
 
-func (tr *TournamentRepository) SelectRandomUserOfTournament(ctx context.Context, store tx.DBTX, tournamentID uuid.UUID) (*models.User, error) {
+// selectPayouts reads shares of paid places into percentages or fixed prizes of tournament by its payout type.
+func (tr *TournamentRepository) selectPayouts(ctx context.Context, store tx.DBTX, tournament *models.Tournament) error {
+	const query = `
+		SELECT share FROM PayoutPlaces WHERE tournamentID = $1 ORDER BY place;
+	`
+	tournament.Payouts, tournament.PayoutAmounts = []models.Percent{}, []models.Money{}
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare query: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	rows, err := stmt.QueryContext(ctx, tournament.ID)
+	if err != nil {
+		return kerror.Newf(kerror.SQLQueryError, "query payouts: %v", err)
+	}
+	defer debugutil.Close(rows)
+
+	for rows.Next() {
+		if tournament.PayoutType == models.FixedPayout {
+			var prize models.Money
+			if err := rows.Scan(&prize); err != nil {
+				return kerror.Newf(kerror.SQLScanError, "scan payout of tournament(%v): %v", tournament.ID, err)
+			}
+
+			tournament.PayoutAmounts = append(tournament.PayoutAmounts, prize)
+			continue
+		}
+
+		var share models.Percent
+		if err := rows.Scan(&share); err != nil {
+			return kerror.Newf(kerror.SQLScanError, "scan payout of tournament(%v): %v", tournament.ID, err)
+		}
+
+		tournament.Payouts = append(tournament.Payouts, share)
+	}
+
+	return nil
+}
+
+func (tr *TournamentRepository) selectPlacements(ctx context.Context, store tx.DBTX, tournamentID uuid.UUID) ([]models.Placement, error) {
+	const query = `
+		SELECT place, userID, prize FROM Placements WHERE tournamentID = $1 ORDER BY place;
+	`
+	placements := []models.Placement{}
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, kerror.Newf(kerror.SQLPrepareStatementError, "prepare query: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	rows, err := stmt.QueryContext(ctx, tournamentID)
+	if err != nil {
+		return nil, kerror.Newf(kerror.SQLQueryError, "query placements: %v", err)
+	}
+	defer debugutil.Close(rows)
+
+	for rows.Next() {
+		var placement models.Placement
+
+		if err := rows.Scan(&placement.Place, &placement.UserID, &placement.Prize); err != nil {
+			return nil, kerror.Newf(kerror.SQLScanError, "scan placement of tournament(%v): %v", tournamentID, err)
+		}
+
+		placements = append(placements, placement)
+	}
+
+	return placements, nil
+}
+
+func (tr *TournamentRepository) InsertPayouts(ctx context.Context, store tx.DBTX, tournament *models.Tournament) error {
+	const query = `
+		INSERT INTO PayoutPlaces(tournamentID, place, share) VALUES ($1, $2, $3);
+	`
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare query: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	var shares []driver.Valuer
+	if tournament.PayoutType == models.FixedPayout {
+		for _, prize := range tournament.PayoutAmounts {
+			shares = append(shares, prize)
+		}
+	} else {
+		for _, share := range tournament.Payouts {
+			shares = append(shares, share)
+		}
+	}
+
+	for i, share := range shares {
+		if _, err := stmt.ExecContext(ctx, tournament.ID, i+1, share); err != nil {
+			return kerror.Newf(kerror.SQLConstraintError, "insert payout of %v place: %v", i+1, err)
+		}
+	}
+
+	return nil
+}
+
+func (tr *TournamentRepository) InsertPlacement(ctx context.Context, store tx.DBTX, tournamentID uuid.UUID, placement *models.Placement) error {
+	const query = `
+		INSERT INTO Placements(tournamentID, place, userID, prize) VALUES ($1, $2, $3, $4);
+	`
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare query: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	if _, err := stmt.ExecContext(ctx, tournamentID, placement.Place, placement.UserID, placement.Prize); err != nil {
+		return kerror.Newf(kerror.SQLConstraintError, "insert placement: %v", err)
+	}
+
+	return nil
+}
+
+func (tr *TournamentRepository) SelectParticipants(ctx context.Context, store tx.DBTX, tournamentID uuid.UUID) ([]models.Participant, error) {
 	const query = `
-		WITH random_id AS (
-			SELECT userID FROM UsersOfTournaments WHERE tournamentID = $1
-				ORDER BY random() LIMIT 1
+		SELECT userID, COALESCE(stake, Tournaments.deposit), score, clientSeed
+		FROM UsersOfTournaments INNER JOIN Tournaments ON Tournaments.id = UsersOfTournaments.tournamentID
+		WHERE tournamentID = $1
+		ORDER BY joinOrder;
+	`
+	participants := []models.Participant{}
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, kerror.Newf(kerror.SQLPrepareStatementError, "prepare query: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	rows, err := stmt.QueryContext(ctx, tournamentID)
+	if err != nil {
+		return nil, kerror.Newf(kerror.SQLQueryError, "query participants: %v", err)
+	}
+	defer debugutil.Close(rows)
+
+	for rows.Next() {
+		var (
+			participant models.Participant
+			score       sql.NullFloat64
 		)
-		SELECT * FROM Users WHERE id = (SELECT userID FROM random_id);
+
+		if err := rows.Scan(&participant.UserID, &participant.Stake, &score, &participant.ClientSeed); err != nil {
+			return nil, kerror.Newf(kerror.SQLScanError, "scan participant of tournament(%v): %v", tournamentID, err)
+		}
+		participant.Score, participant.ScoreReported = score.Float64, score.Valid
+
+		participants = append(participants, participant)
+	}
+
+	return participants, nil
+}
+
+func (tr *TournamentRepository) UpdateScore(ctx context.Context, store tx.DBTX, tournamentID, userID uuid.UUID, score float64) error {
+	const query = `
+		UPDATE UsersOfTournaments SET score = $1 WHERE tournamentID = $2 AND userID = $3;
 	`
-	var user models.User
 
 	stmt, err := store.PrepareContext(ctx, query)
 	if err != nil {
-		return nil, kerror.Newf(kerror.SQLPrepareStatementError, "prepare stmt: %v", err)
+		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare stmt: %v", err)
 	}
 	defer debugutil.Close(stmt)
 
-	if err := stmt.QueryRowContext(ctx, tournamentID).Scan(&user.ID, &user.Name, &user.Balance, &user.Password); err != nil {
-		return nil, kerror.Newf(kerror.SQLScanError, "scan user from db: %v", err)
+	result, err := stmt.ExecContext(ctx, score, tournamentID, userID)
+	if err != nil {
+		return kerror.Newf(kerror.SQLExecutionError, "exec update query: %v", err)
+	}
+
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return kerror.Newf(kerror.NotFound, "user(%v) isn't a participant of tournament(%v)", userID, tournamentID)
 	}
 
-	return &user, nil
+	return nil
 }
 
-func (tr *TournamentRepository) InsertUserToTournament(ctx context.Context, store tx.DBTX, tournamentID, userID uuid.UUID) error {
+func (tr *TournamentRepository) InsertUserToTournament(ctx context.Context, store tx.DBTX, tournamentID uuid.UUID, participant *models.Participant) error {
 	const query = `
-		INSERT INTO UsersOfTournaments(tournamentID, userID) VALUES ($1, $2); 
+		INSERT INTO UsersOfTournaments(tournamentID, userID, stake, clientSeed) VALUES ($1, $2, $3, $4)
+			ON CONFLICT (tournamentID, userID) DO NOTHING;
 	`
 
 	stmt, err := store.PrepareContext(ctx, query)
@@ -130,14 +436,19 @@ func (tr *TournamentRepository) InsertUserToTournament(ctx context.Context, stor
 	}
 	defer debugutil.Close(stmt)
 
-	if _, err := stmt.ExecContext(ctx, tournamentID, userID); err != nil {
+	result, err := stmt.ExecContext(ctx, tournamentID, participant.UserID, participant.Stake, participant.ClientSeed)
+	if err != nil {
 		return kerror.Newf(kerror.SQLExecutionError, "exec stmt: %v", err)
 	}
 
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return kerror.Newf(kerror.AlreadyJoined, "user(%v) already joined tournament(%v)", participant.UserID, tournamentID)
+	}
+
 	return nil
 }
 
-func (tr *TournamentRepository) AddToPrize(ctx context.Context, store tx.DBTX, ID uuid.UUID, d float64) error {
+func (tr *TournamentRepository) AddToPrize(ctx context.Context, store tx.DBTX, ID uuid.UUID, d models.Money) error {
 	const query = `
 		UPDATE Tournaments
 			SET prize = prize + $1
@@ -157,14 +468,11 @@ func (tr *TournamentRepository) AddToPrize(ctx context.Context, store tx.DBTX, I
 	return nil
 }
 
-func (tr *TournamentRepository) RefundDepositToUsers(ctx context.Context, store tx.DBTX, tournamentID uuid.UUID) error {
+func (tr *TournamentRepository) AddRake(ctx context.Context, store tx.DBTX, tournamentID uuid.UUID, rake models.Money) error {
 	const query = `
-		WITH depositOfTournament AS (
-			SELECT deposit FROM Tournaments WHERE id = $1
-		)
-		UPDATE Users
-			SET balance = balance + (SELECT deposit FROM depositOfTournament)
-			WHERE id IN (SELECT userID FROM UsersOfTournaments WHERE tournamentID = $1);
+		UPDATE Tournaments
+			SET rakeCollected = rakeCollected + $1
+			WHERE id = $2
 	`
 
 	stmt, err := store.PrepareContext(ctx, query)
@@ -173,8 +481,8 @@ func (tr *TournamentRepository) RefundDepositToUsers(ctx context.Context, store
 	}
 	defer debugutil.Close(stmt)
 
-	if _, err := stmt.ExecContext(ctx, tournamentID); err != nil {
-		return kerror.Newf(kerror.SQLExecutionError, "exec query: %v", err)
+	if _, err := stmt.ExecContext(ctx, rake, tournamentID); err != nil {
+		return kerror.Newf(kerror.SQLConstraintError, "exec update query: %v", err)
 	}
 
 	return nil
@@ -198,9 +506,100 @@ func (tr *TournamentRepository) SetWinner(ctx context.Context, store tx.DBTX, to
 	return nil
 }
 
-func (tr *TournamentRepository) UpdateStatus(ctx context.Context, store tx.DBTX, tournamentID uuid.UUID, newStatus models.TournamentStatus) error {
+func (tr *TournamentRepository) RevealServerSeed(ctx context.Context, store tx.DBTX, tournamentID uuid.UUID) error {
+	const query = `
+		UPDATE Tournaments SET seedRevealed = true WHERE id = $1;
+	`
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare stmt: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	if _, err := stmt.ExecContext(ctx, tournamentID); err != nil {
+		return kerror.Newf(kerror.SQLExecutionError, "exec update query: %v", err)
+	}
+
+	return nil
+}
+
+// LockNextScheduled locks a tournament that has a due transition in its schedule and returns its id.
+// Tournaments locked by other transactions and the skipped ones are passed over. uuid.Nil means that nothing is due.
+func (tr *TournamentRepository) LockNextScheduled(ctx context.Context, store tx.DBTX, now time.Time, skipped []uuid.UUID) (uuid.UUID, error) {
+	const query = `
+		SELECT id FROM Tournaments
+		WHERE ((status = 'Draft' AND registrationOpensAt <= $1)
+			OR (status = 'RegistrationOpen' AND registrationClosesAt <= $1)
+			OR (status IN ('RegistrationOpen', 'RegistrationClosed') AND startTime <= $1)
+			OR (status IN ('RegistrationOpen', 'RegistrationClosed', 'InProgress') AND finishDeadline <= $1))
+			AND NOT (id::text = ANY(string_to_array($2, ',')))
+		ORDER BY LEAST(registrationOpensAt, registrationClosesAt, startTime, finishDeadline)
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED;
+	`
+	var id uuid.UUID
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return uuid.Nil, kerror.Newf(kerror.SQLPrepareStatementError, "prepare stmt: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	if err := stmt.QueryRowContext(ctx, now, joinIDs(skipped)).Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return uuid.Nil, nil
+		}
+
+		return uuid.Nil, kerror.Newf(kerror.SQLScanError, "scan scheduled tournament: %v", err)
+	}
+
+	return id, nil
+}
+
+func joinIDs(ids []uuid.UUID) string {
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		names = append(names, id.String())
+	}
+
+	return strings.Join(names, ",")
+}
+
+// scheduleColumns are the columns of schedule moments, at which tournament moves to the status.
+var scheduleColumns = map[models.TournamentStatus]string{
+	models.RegistrationOpen:   "registrationOpensAt",
+	models.RegistrationClosed: "registrationClosesAt",
+	models.InProgress:         "startTime",
+	models.Finished:           "finishDeadline",
+}
+
+func (tr *TournamentRepository) ClearSchedule(ctx context.Context, store tx.DBTX, tournamentID uuid.UUID, next models.TournamentStatus) error {
+	column, ok := scheduleColumns[next]
+	if !ok {
+		return kerror.Newf(kerror.BadRequest, "tournament isn't scheduled to become %v", next)
+	}
+
+	query := fmt.Sprintf(`
+		UPDATE Tournaments SET %s = NULL WHERE id = $1;
+	`, column)
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare stmt: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	if _, err := stmt.ExecContext(ctx, tournamentID); err != nil {
+		return kerror.Newf(kerror.SQLExecutionError, "exec update query: %v", err)
+	}
+
+	return nil
+}
+
+func (tr *TournamentRepository) UpdateStatus(ctx context.Context, store tx.DBTX, tournamentID uuid.UUID, status, newStatus models.TournamentStatus) error {
 	const query = `
-		UPDATE Tournaments SET status = $1 WHERE id = $2;
+		UPDATE Tournaments SET status = $1 WHERE id = $2 AND status = $3;
 	`
 
 	stmt, err := store.PrepareContext(ctx, query)
@@ -209,10 +608,147 @@ func (tr *TournamentRepository) UpdateStatus(ctx context.Context, store tx.DBTX,
 	}
 	defer debugutil.Close(stmt)
 
-	if _, err := stmt.ExecContext(ctx, newStatus, tournamentID); err != nil {
+	result, err := stmt.ExecContext(ctx, newStatus, tournamentID, status)
+	if err != nil {
 		return kerror.Newf(kerror.SQLExecutionError, "exec update query: %v", err)
 	}
 
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return kerror.Newf(kerror.SQLExecutionError, "get count of updated tournaments: %v", err)
+	}
+
+	if updated == 0 {
+		return kerror.Newf(kerror.BadRequest, "tournament(%v) isn't %v anymore", tournamentID, status)
+	}
+
 	return nil
+}
 
+func (tr *TournamentRepository) SelectParticipant(ctx context.Context, store tx.DBTX, tournamentID, userID uuid.UUID) (*models.Participant, error) {
+	const query = `
+		SELECT userID, COALESCE(stake, Tournaments.deposit), score, clientSeed
+		FROM UsersOfTournaments INNER JOIN Tournaments ON Tournaments.id = UsersOfTournaments.tournamentID
+		WHERE tournamentID = $1 AND userID = $2;
+	`
+	var (
+		participant models.Participant
+		score       sql.NullFloat64
+	)
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, kerror.Newf(kerror.SQLPrepareStatementError, "prepare stmt: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	if err := stmt.QueryRowContext(ctx, tournamentID, userID).Scan(&participant.UserID, &participant.Stake, &score, &participant.ClientSeed); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, kerror.Newf(kerror.NotFound, "user(%v) isn't a participant of tournament(%v)", userID, tournamentID)
+		}
+
+		return nil, kerror.Newf(kerror.SQLScanError, "scan participant: %v", err)
+	}
+	participant.Score, participant.ScoreReported = score.Float64, score.Valid
+
+	return &participant, nil
+}
+
+func (tr *TournamentRepository) DeleteUserFromTournament(ctx context.Context, store tx.DBTX, tournamentID, userID uuid.UUID) error {
+	const query = `
+		DELETE FROM UsersOfTournaments WHERE tournamentID = $1 AND userID = $2;
+	`
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare stmt: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	result, err := stmt.ExecContext(ctx, tournamentID, userID)
+	if err != nil {
+		return kerror.Newf(kerror.SQLExecutionError, "exec delete query: %v", err)
+	}
+
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return kerror.Newf(kerror.NotFound, "user(%v) isn't a participant of tournament(%v)", userID, tournamentID)
+	}
+
+	return nil
+}
+
+func (tr *TournamentRepository) InsertWaitlistEntry(ctx context.Context, store tx.DBTX, tournamentID uuid.UUID, entry *models.WaitlistEntry) error {
+	const query = `
+		INSERT INTO Waitlist(tournamentID, userID, stake, clientSeed) VALUES ($1, $2, $3, $4)
+			ON CONFLICT (tournamentID, userID) DO NOTHING;
+	`
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare query: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	result, err := stmt.ExecContext(ctx, tournamentID, entry.UserID, entry.Stake, entry.ClientSeed)
+	if err != nil {
+		return kerror.Newf(kerror.SQLConstraintError, "insert waitlist entry: %v", err)
+	}
+
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return kerror.Newf(kerror.AlreadyJoined, "user(%v) already waits for tournament(%v)", entry.UserID, tournamentID)
+	}
+
+	return nil
+}
+
+// SelectWaitlist returns users waiting for tournament in order of waiting, numbering their positions from 1.
+func (tr *TournamentRepository) SelectWaitlist(ctx context.Context, store tx.DBTX, tournamentID uuid.UUID) ([]models.WaitlistEntry, error) {
+	const query = `
+		SELECT userID, stake, clientSeed, ROW_NUMBER() OVER (ORDER BY waitOrder)
+		FROM Waitlist WHERE tournamentID = $1
+		ORDER BY waitOrder;
+	`
+	waitlist := []models.WaitlistEntry{}
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, kerror.Newf(kerror.SQLPrepareStatementError, "prepare query: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	rows, err := stmt.QueryContext(ctx, tournamentID)
+	if err != nil {
+		return nil, kerror.Newf(kerror.SQLQueryError, "query waitlist: %v", err)
+	}
+	defer debugutil.Close(rows)
+
+	for rows.Next() {
+		var entry models.WaitlistEntry
+
+		if err := rows.Scan(&entry.UserID, &entry.Stake, &entry.ClientSeed, &entry.Position); err != nil {
+			return nil, kerror.Newf(kerror.SQLScanError, "scan waitlist entry of tournament(%v): %v", tournamentID, err)
+		}
+
+		waitlist = append(waitlist, entry)
+	}
+
+	return waitlist, nil
+}
+
+func (tr *TournamentRepository) DeleteWaitlistEntry(ctx context.Context, store tx.DBTX, tournamentID, userID uuid.UUID) error {
+	const query = `
+		DELETE FROM Waitlist WHERE tournamentID = $1 AND userID = $2;
+	`
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare stmt: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	if _, err := stmt.ExecContext(ctx, tournamentID, userID); err != nil {
+		return kerror.Newf(kerror.SQLExecutionError, "exec delete query: %v", err)
+	}
+
+	return nil
 }
@@ -15,7 +15,7 @@ type UserRepository struct{}
 
 func (u *UserRepository) Insert(ctx context.Context, store tx.DBTX, user *models.User) (uuid.UUID, error) {
 	const query = `
-		INSERT INTO Users(name, balance, password) VALUES ($1, $2, $3)
+		INSERT INTO Users(name, password, email) VALUES ($1, $2, $3)
 			RETURNING id;
 	`
 	var id uuid.UUID
@@ -26,7 +26,7 @@ func (u *UserRepository) Insert(ctx context.Context, store tx.DBTX, user *models
 	}
 	defer debugutil.Close(inStmt)
 
-	if err := inStmt.QueryRowContext(ctx, user.Name, user.Balance, user.Password).Scan(&id); err != nil {
+	if err := inStmt.QueryRowContext(ctx, user.Name, user.Password, nullableString(user.Email)).Scan(&id); err != nil {
 		return id, kerror.Newf(kerror.SQLConstraintError, "scan: %v", err)
 	}
 
@@ -35,9 +35,10 @@ func (u *UserRepository) Insert(ctx context.Context, store tx.DBTX, user *models
 
 func (u *UserRepository) SelectByID(ctx context.Context, store tx.DBTX, id uuid.UUID) (*models.User, error) {
 	const query = `
-		SELECT * FROM Users WHERE id = $1;	
+		SELECT id, name, password, email, role FROM Users WHERE id = $1;
 	`
 	user := &models.User{}
+	var email sql.NullString
 
 	selectStmt, err := store.PrepareContext(ctx, query)
 	if err != nil {
@@ -45,20 +46,27 @@ func (u *UserRepository) SelectByID(ctx context.Context, store tx.DBTX, id uuid.
 	}
 	defer debugutil.Close(selectStmt)
 
-	if err := selectStmt.QueryRowContext(ctx, id).Scan(&user.ID, &user.Name, &user.Balance, &user.Password); err != nil {
+	if err := selectStmt.QueryRowContext(ctx, id).Scan(&user.ID, &user.Name, &user.Password, &email, &user.Role); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, kerror.Newf(kerror.UserDoesntExists, "no user with id %v: %v", id, err)
 		}
 
 		return nil, kerror.Newf(kerror.SQLScanError, "query: %v", err)
 	}
+	user.Email = email.String
+
+	user.Balances, err = u.selectBalances(ctx, store, user.ID)
+	if err != nil {
+		return nil, kerror.Errorf(err, "select balances of user")
+	}
 
 	return user, nil
 }
 
 func (u *UserRepository) SelectByName(ctx context.Context, store tx.DBTX, username string) (*models.User, error) {
-	const query = "SELECT id, name, balance, password FROM Users WHERE name = $1"
+	const query = "SELECT id, name, password, email, role FROM Users WHERE name = $1"
 	user := &models.User{}
+	var email sql.NullString
 
 	selectStmt, err := store.PrepareContext(ctx, query)
 	if err != nil {
@@ -66,13 +74,19 @@ func (u *UserRepository) SelectByName(ctx context.Context, store tx.DBTX, userna
 	}
 	defer debugutil.Close(selectStmt)
 
-	if err := selectStmt.QueryRowContext(ctx, username).Scan(&user.ID, &user.Name, &user.Balance, &user.Password); err != nil {
+	if err := selectStmt.QueryRowContext(ctx, username).Scan(&user.ID, &user.Name, &user.Password, &email, &user.Role); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, kerror.Newf(kerror.UserDoesntExists, "no user with username %v: %v", username, err)
 		}
 
 		return nil, kerror.Newf(kerror.SQLScanError, "query: %v", err)
 	}
+	user.Email = email.String
+
+	user.Balances, err = u.selectBalances(ctx, store, user.ID)
+	if err != nil {
+		return nil, kerror.Errorf(err, "select balances of user")
+	}
 
 	return user, nil
 }
@@ -95,21 +109,109 @@ func (u *UserRepository) DeleteByID(ctx context.Context, store tx.DBTX, id uuid.
 	return nil
 }
 
-func (u *UserRepository) UpdateBalanceBySum(ctx context.Context, store tx.DBTX, id uuid.UUID, d float64) error {
+func (u *UserRepository) UpdatePassword(ctx context.Context, store tx.DBTX, id uuid.UUID, password string) error {
+	const query = `
+		UPDATE Users SET password = $1 WHERE id = $2;
+	`
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare for update password: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	result, err := stmt.ExecContext(ctx, password, id)
+	if err != nil {
+		return kerror.Newf(kerror.SQLExecutionError, "updating password of user(%v): %v", id, err)
+	}
+
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return kerror.Newf(kerror.SQLExecutionError, "get count of updated users: %v", err)
+	}
+
+	if updated == 0 {
+		return kerror.Newf(kerror.UserDoesntExists, "no user with id %v", id)
+	}
+
+	return nil
+}
+
+func (u *UserRepository) UpdateRole(ctx context.Context, store tx.DBTX, id uuid.UUID, role models.Role) error {
+	const query = `
+		UPDATE Users SET role = $1 WHERE id = $2;
+	`
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare for update role: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	result, err := stmt.ExecContext(ctx, role, id)
+	if err != nil {
+		return kerror.Newf(kerror.SQLExecutionError, "updating role of user(%v): %v", id, err)
+	}
+
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return kerror.Newf(kerror.SQLExecutionError, "get count of updated users: %v", err)
+	}
+
+	if updated == 0 {
+		return kerror.Newf(kerror.UserDoesntExists, "no user with id %v", id)
+	}
+
+	return nil
+}
+
+func (u *UserRepository) selectBalances(ctx context.Context, store tx.DBTX, id uuid.UUID) ([]models.Balance, error) {
+	const query = `
+		SELECT currency, balance, held FROM UserBalances WHERE userID = $1 ORDER BY currency;
+	`
+	balances := []models.Balance{}
+
+	stmt, err := store.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, kerror.Newf(kerror.SQLPrepareStatementError, "prepare query: %v", err)
+	}
+	defer debugutil.Close(stmt)
+
+	rows, err := stmt.QueryContext(ctx, id)
+	if err != nil {
+		return nil, kerror.Newf(kerror.SQLQueryError, "query balances: %v", err)
+	}
+	defer debugutil.Close(rows)
+
+	for rows.Next() {
+		var balance models.Balance
+
+		if err := rows.Scan(&balance.Currency, &balance.Amount, &balance.Held); err != nil {
+			return nil, kerror.Newf(kerror.SQLScanError, "scan balance of user(%v): %v", id, err)
+		}
+
+		balances = append(balances, balance)
+	}
+
+	return balances, nil
+}
+
+// UpdateBalanceBySum adds d to balance of user in currency, opening the balance if user had no money in it.
+func (u *UserRepository) UpdateBalanceBySum(ctx context.Context, store tx.DBTX, id uuid.UUID, currency models.Currency, d models.Money) error {
 	const query = `
-		UPDATE Users
-		SET balance = balance + $1
-		WHERE id = $2
+		INSERT INTO UserBalances(userID, currency, balance) VALUES ($1, $2, $3)
+		ON CONFLICT (userID, currency) DO UPDATE
+			SET balance = UserBalances.balance + EXCLUDED.balance;
 	`
 
 	updateStmt, err := store.PrepareContext(ctx, query)
 	if err != nil {
-		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare for update balance for %v(addend: %v): %v", id, d, err)
+		return kerror.Newf(kerror.SQLPrepareStatementError, "prepare for update balance for %v(addend: %v %v): %v", id, d, currency, err)
 	}
 	defer debugutil.Close(updateStmt)
 
-	if _, err := updateStmt.ExecContext(ctx, d, id); err != nil {
-		return kerror.Newf(kerror.SQLConstraintError, "updating balance for %v(addend: %v): %v", id, d, err)
+	if _, err := updateStmt.ExecContext(ctx, id, currency, d); err != nil {
+		return kerror.Newf(kerror.SQLConstraintError, "updating balance for %v(addend: %v %v): %v", id, d, currency, err)
 	}
 
 	return nil
@@ -69,7 +69,10 @@ func startServer(ctx context.Context) {
 	}
 	defer debugutil.Close(db)
 
-	srv := newServer(listener, startHandler(db))
+	srvHandler, scheduler := startHandler(db)
+	srv := newServer(listener, srvHandler)
+
+	go runScheduler(ctx, scheduler, schedulerInterval())
 
 	go func() {
 		if err := srv.Serve(listener); err != nil {
@@ -103,23 +106,35 @@ func InitDB() (*sql.DB, error) {
 	return db, nil
 }
 
-func startHandler(db *sql.DB) *handler.ServiceHandler {
+func startHandler(db *sql.DB) (*handler.ServiceHandler, controller.Scheduler) {
 	store := tx.NewStore(db)
 	userRepo := &repository.UserRepository{}
 	tournamentRepo := &repository.TournamentRepository{}
+	matchRepo := &repository.MatchRepository{}
+	houseRepo := &repository.HouseRepository{}
+	ledger := controller.NewLedger(&repository.LedgerRepository{}, userRepo, houseRepo, &repository.HoldRepository{})
+
+	idempotency := controller.NewIdempotency(&repository.IdempotencyRepository{})
+	limits := controller.NewLimits(&repository.LimitRepository{}, limitCoolingOff())
+	resets := controller.NewPasswordResets(&repository.PasswordResetRepository{}, passwordResetNotifier(), resetTokenTTL())
+	sessions := controller.NewSessions(&repository.SessionRepository{}, refreshTokenTTL())
 
-	userController := controller.NewUserController(userRepo, store)
-	tournamentController := controller.NewTournamentController(tournamentRepo, userRepo, store)
+	userController := controller.NewUserController(userRepo, ledger, idempotency, limits, passwordPolicy(), resets, sessions, store)
+	tournamentController := controller.NewTournamentController(tournamentRepo, userRepo, matchRepo, ledger, idempotency, limits, store)
+	reconciliationController := controller.NewReconciliationController(&repository.ReconciliationRepository{}, store)
 
-	return handler.NewServiceHandler(userController, tournamentController)
+	return handler.NewServiceHandler(userController, tournamentController, reconciliationController), tournamentController
 }
 
-func newServer(listener net.Listener, handler *handler.ServiceHandler) *grpc.Server {
+func newServer(listener net.Listener, srvHandler *handler.ServiceHandler) *grpc.Server {
 	opts := []grpc.ServerOption{
-		grpc.UnaryInterceptor(interceptor.Error(interceptor.Log)),
+		grpc.ChainUnaryInterceptor(
+			interceptor.Error(interceptor.Log),
+			interceptor.Authorize(handler.AccessPolicy(), os.Getenv("TK_PASSWORD"), srvHandler),
+		),
 	}
 	srv := grpc.NewServer(opts...)
-	pb.RegisterTournamentServiceServer(srv, handler)
+	pb.RegisterTournamentServiceServer(srv, srvHandler)
 
 	return srv
 }
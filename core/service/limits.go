@@ -0,0 +1,18 @@
+package service
+
+import (
+	"os"
+	"time"
+)
+
+const defaultLimitCoolingOff = 24 * time.Hour
+
+// limitCoolingOff is the delay before a looser self-limit takes effect.
+func limitCoolingOff() time.Duration {
+	coolingOff, err := time.ParseDuration(os.Getenv("LIMIT_COOLING_OFF"))
+	if err != nil || coolingOff < 0 {
+		return defaultLimitCoolingOff
+	}
+
+	return coolingOff
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/kimbellG/tournament/core/controller"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/notifier"
+)
+
+const (
+	defaultPasswordMinLength  = 8
+	defaultPasswordMinClasses = 2
+)
+
+// passwordPolicy reads the strength of passwords chosen by users from PASSWORD_MIN_LENGTH and PASSWORD_MIN_CLASSES.
+func passwordPolicy() models.PasswordPolicy {
+	return models.PasswordPolicy{
+		MinLength:  positiveEnv("PASSWORD_MIN_LENGTH", defaultPasswordMinLength),
+		MinClasses: positiveEnv("PASSWORD_MIN_CLASSES", defaultPasswordMinClasses),
+	}
+}
+
+func positiveEnv(name string, fallback int) int {
+	value, err := strconv.Atoi(os.Getenv(name))
+	if err != nil || value <= 0 {
+		return fallback
+	}
+
+	return value
+}
+
+const defaultResetTokenTTL = time.Hour
+
+// resetTokenTTL is how long token of password reset can be used.
+func resetTokenTTL() time.Duration {
+	ttl, err := time.ParseDuration(os.Getenv("RESET_TOKEN_TTL"))
+	if err != nil || ttl <= 0 {
+		return defaultResetTokenTTL
+	}
+
+	return ttl
+}
+
+// passwordResetNotifier chooses how tokens of password reset are delivered by NOTIFIER:
+// "smtp" sends them by email, "file" appends them to NOTIFIER_FILE, anything else writes them to the log.
+func passwordResetNotifier() controller.Notifier {
+	switch os.Getenv("NOTIFIER") {
+	case "smtp":
+		return notifier.NewSMTP(os.Getenv("SMTP_HOST"), os.Getenv("SMTP_PORT"),
+			os.Getenv("SMTP_USER"), os.Getenv("SMTP_PASSWORD"), os.Getenv("SMTP_FROM"))
+	case "file":
+		return notifier.NewFile(os.Getenv("NOTIFIER_FILE"))
+	default:
+		return &notifier.Log{}
+	}
+}
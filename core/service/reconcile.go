@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+
+	"github.com/kimbellG/tournament/core/controller"
+	"github.com/kimbellG/tournament/core/debugutil"
+	"github.com/kimbellG/tournament/core/models"
+	"github.com/kimbellG/tournament/core/repository"
+	"github.com/kimbellG/tournament/core/tx"
+)
+
+// Reconcile checks balances against the ledger once, without starting the server.
+func Reconcile(ctx context.Context, correct bool) (*models.ReconciliationReport, error) {
+	db, err := InitDB()
+	if err != nil {
+		return nil, err
+	}
+	defer debugutil.Close(db)
+
+	reconciliation := controller.NewReconciliationController(&repository.ReconciliationRepository{}, tx.NewStore(db))
+
+	return reconciliation.Reconcile(ctx, correct)
+}
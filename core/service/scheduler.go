@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/kimbellG/tournament/core/controller"
+	"github.com/sirupsen/logrus"
+)
+
+const defaultSchedulerInterval = 10 * time.Second
+
+func schedulerInterval() time.Duration {
+	interval, err := time.ParseDuration(os.Getenv("SCHEDULER_INTERVAL"))
+	if err != nil || interval <= 0 {
+		return defaultSchedulerInterval
+	}
+
+	return interval
+}
+
+// runScheduler performs due transitions of scheduled tournaments until ctx is done.
+// Pending work lives in the database, so nothing is lost when the service restarts.
+func runScheduler(ctx context.Context, scheduler controller.Scheduler, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			processed, err := scheduler.RunSchedule(ctx, now)
+			if err != nil {
+				logrus.Errorf("Failed to run schedule of tournaments: %v", err)
+			}
+
+			if processed > 0 {
+				logrus.Infof("Scheduler moved %d tournaments", processed)
+			}
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package service
+
+import (
+	"os"
+	"time"
+)
+
+const defaultRefreshTokenTTL = 30 * 24 * time.Hour
+
+// refreshTokenTTL is how long session lasts since its last refresh.
+func refreshTokenTTL() time.Duration {
+	ttl, err := time.ParseDuration(os.Getenv("REFRESH_TOKEN_TTL"))
+	if err != nil || ttl <= 0 {
+		return defaultRefreshTokenTTL
+	}
+
+	return ttl
+}
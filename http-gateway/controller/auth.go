@@ -0,0 +1,16 @@
+package controller
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// authorizationMetadata is the gRPC metadata, which carries token of user to the core.
+const authorizationMetadata = "authorization"
+
+// WithAuthToken makes requests to the core made with ctx on behalf of the user the token was issued to,
+// so the core checks them against the role of the user too.
+func WithAuthToken(ctx context.Context, token string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, authorizationMetadata, "Bearer "+token)
+}
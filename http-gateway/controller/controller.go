@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"time"
 
 	pb "github.com/kimbellG/tournament/core/handler/grpc"
 	"github.com/kimbellG/tournament/http/internal"
@@ -13,14 +14,37 @@ type TournamentController interface {
 	CreateUser(ctx context.Context, user *internal.User) (*internal.User, error)
 	GetUserByID(ctx context.Context, id string) (*internal.User, error)
 	DeleteUser(ctx context.Context, id string) error
-	UpdateBalanceBySum(ctx context.Context, id string, d float64) error
-	LogIn(ctx context.Context, login, password string) (string, error)
+	UpdateBalanceBySum(ctx context.Context, id, currency string, d internal.Money) error
+	LogIn(ctx context.Context, login, password string) (*internal.Session, error)
+	RefreshSession(ctx context.Context, refreshToken string) (*internal.Session, error)
+	Logout(ctx context.Context) error
+	Authenticate(ctx context.Context) error
+	SetUserRole(ctx context.Context, id string, role internal.Role) error
+	ChangePassword(ctx context.Context, id, oldPassword, newPassword string) error
+	RequestPasswordReset(ctx context.Context, login string) error
+	ResetPassword(ctx context.Context, token, newPassword string) error
+	ListUserTransactions(ctx context.Context, id string, filter *internal.TransactionFilter) (*internal.TransactionsPage, error)
+	SetLimit(ctx context.Context, id string, limit *internal.Limit) (*internal.Limit, error)
+	GetLimits(ctx context.Context, id string) (*internal.SelfLimits, error)
+	SelfExclude(ctx context.Context, id string, until time.Time) error
 
-	CreateTournament(ctx context.Context, name string, deposit float64) (string, error)
+	CreateTournament(ctx context.Context, tournament *internal.Tournament) (string, error)
 	GetTournamentByID(ctx context.Context, id string) (*internal.Tournament, error)
-	JoinTournament(ctx context.Context, tournamentID, userID string) error
-	FinishTournament(ctx context.Context, id string) error
+	JoinTournament(ctx context.Context, tournamentID, userID string, stake internal.Money, clientSeed string, waitlist bool) (*internal.JoinResult, error)
+	LeaveTournament(ctx context.Context, tournamentID, userID string) error
+	RemoveParticipant(ctx context.Context, tournamentID, userID string) error
+	GetWaitlist(ctx context.Context, id string) ([]internal.WaitlistEntry, error)
+	FinishTournament(ctx context.Context, id string, ranking []string) error
 	CancelTournament(ctx context.Context, id string) error
+	OpenRegistration(ctx context.Context, id string) error
+	CloseRegistration(ctx context.Context, id string) error
+	ReportScore(ctx context.Context, tournamentID, userID string, score float64) error
+	GetDrawProof(ctx context.Context, id string) (*internal.DrawProof, error)
+
+	StartTournament(ctx context.Context, id string) error
+	GetMatches(ctx context.Context, id string) ([]internal.Match, error)
+	GetStandings(ctx context.Context, id string) ([]internal.Standing, error)
+	ReportMatchResult(ctx context.Context, tournamentID, matchID, winnerID string, draw bool) error
 }
 
 type tournamentInteractor struct {
@@ -0,0 +1,20 @@
+package controller
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// idempotencyKeyMetadata is the gRPC metadata, which carries idempotency key of request to the core.
+const idempotencyKeyMetadata = "idempotency-key"
+
+// WithIdempotencyKey makes requests to the core made with ctx idempotent: their retry with the same key
+// returns the first outcome instead of being executed again. Empty key leaves ctx as it is.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	if key == "" {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, idempotencyKeyMetadata, key)
+}
@@ -11,12 +11,18 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// grpcToErrorCode is the fallback for errors without kerror details. Every gRPC code maps to its most general
+// meaning, the particular one, like LimitExceeded for PermissionDenied, comes only with the details.
 var grpcToErrorCode = map[codes.Code]kerror.StatusCode{
 	codes.InvalidArgument:    kerror.BadRequest,
 	codes.NotFound:           kerror.NotFound,
 	codes.FailedPrecondition: kerror.SQLConstraintError,
 	codes.Internal:           kerror.SQLQueryError,
 	codes.Aborted:            kerror.SQLTransactionError,
+	codes.ResourceExhausted:  kerror.TournamentIsFull,
+	codes.AlreadyExists:      kerror.AlreadyJoined,
+	codes.PermissionDenied:   kerror.Forbidden,
+	codes.Unauthenticated:    kerror.Unauthenticated,
 	codes.Unknown:            kerror.Unknown,
 }
 
@@ -0,0 +1,37 @@
+package interceptor
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/core/handler/kegrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDecodeErrorFromGrpc(t *testing.T) {
+	tt := []struct {
+		name string
+		err  error
+		want kerror.StatusCode
+	}{
+		{name: "unauthenticated without details", err: status.Error(codes.Unauthenticated, "no token"), want: kerror.Unauthenticated},
+		{name: "permission denied without details", err: status.Error(codes.PermissionDenied, "not allowed"), want: kerror.Forbidden},
+		{name: "limit with details", err: kegrpc.Newf(kerror.LimitExceeded, "deposit limit"), want: kerror.LimitExceeded},
+		{name: "incorrect password with details", err: kegrpc.Newf(kerror.IncorrectPassword, "wrong password"), want: kerror.IncorrectPassword},
+		{name: "unmapped code", err: status.Error(codes.DataLoss, "lost"), want: kerror.Unknown},
+	}
+
+	for _, tc := range tt {
+		var kerr kerror.Error
+		if !errors.As(DecodeErrorFromGrpc(tc.err), &kerr) {
+			t.Errorf("%s: error should be decoded into kerror", tc.name)
+			continue
+		}
+
+		if kerr.StatusCode() != tc.want {
+			t.Errorf("%s: want %v, got %v", tc.name, tc.want.Message(), kerr.StatusCode().Message())
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/kimbellG/kerror"
+	pb "github.com/kimbellG/tournament/core/handler/grpc"
+	"github.com/kimbellG/tournament/http/internal"
+)
+
+func (t *tournamentInteractor) SetLimit(ctx context.Context, id string, limit *internal.Limit) (*internal.Limit, error) {
+	resp, err := t.tgrpc.SetLimit(ctx, &pb.SetLimitRequest{
+		UserID:   id,
+		Kind:     limit.Kind,
+		Period:   limit.Period,
+		Currency: limit.Currency,
+		Amount:   moneyToProto(limit.Amount),
+	})
+	if err != nil {
+		return nil, kerror.Errorf(err, "grpc-core")
+	}
+
+	return limitFromProto(resp), nil
+}
+
+func limitFromProto(limit *pb.Limit) *internal.Limit {
+	converted := &internal.Limit{
+		Kind:        limit.GetKind(),
+		Period:      limit.GetPeriod(),
+		Currency:    limit.GetCurrency(),
+		Amount:      moneyFromProto(limit.GetAmount(), 0),
+		PendingFrom: timeFromProto(limit.GetPendingFrom()),
+	}
+
+	if limit.GetPendingAmount() != nil {
+		pending := moneyFromProto(limit.GetPendingAmount(), 0)
+		converted.PendingAmount = &pending
+	}
+
+	return converted
+}
+
+func (t *tournamentInteractor) GetLimits(ctx context.Context, id string) (*internal.SelfLimits, error) {
+	resp, err := t.tgrpc.GetLimits(ctx, &pb.UserRequest{ID: id})
+	if err != nil {
+		return nil, kerror.Errorf(err, "grpc-core")
+	}
+
+	limits := &internal.SelfLimits{
+		Limits:        make([]internal.Limit, 0, len(resp.GetLimits())),
+		ExcludedUntil: timeFromProto(resp.GetExcludedUntil()),
+	}
+	for _, limit := range resp.GetLimits() {
+		limits.Limits = append(limits.Limits, *limitFromProto(limit))
+	}
+
+	return limits, nil
+}
+
+func (t *tournamentInteractor) SelfExclude(ctx context.Context, id string, until time.Time) error {
+	if _, err := t.tgrpc.SelfExclude(ctx, &pb.SelfExcludeRequest{UserID: id, Until: timeToProto(&until)}); err != nil {
+		return kerror.Errorf(err, "grpc-core")
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/kimbellG/kerror"
+	pb "github.com/kimbellG/tournament/core/handler/grpc"
+	"github.com/kimbellG/tournament/http/internal"
+)
+
+func (t *tournamentInteractor) StartTournament(ctx context.Context, id string) error {
+	if _, err := t.tgrpc.StartTournament(ctx, &pb.TournamentRequest{Id: id}); err != nil {
+		return kerror.Errorf(err, "grpc-core")
+	}
+
+	return nil
+}
+
+func (t *tournamentInteractor) GetMatches(ctx context.Context, id string) ([]internal.Match, error) {
+	resp, err := t.tgrpc.GetMatches(ctx, &pb.TournamentRequest{Id: id})
+	if err != nil {
+		return nil, kerror.Errorf(err, "grpc-core")
+	}
+
+	return matchesFromProto(resp.GetMatches()), nil
+}
+
+func matchesFromProto(protoMatches []*pb.Match) []internal.Match {
+	matches := make([]internal.Match, 0, len(protoMatches))
+	for _, match := range protoMatches {
+		matches = append(matches, internal.Match{
+			ID:         match.GetId(),
+			Round:      int(match.GetRound()),
+			Position:   int(match.GetPosition()),
+			FirstUser:  match.GetFirstUser(),
+			SecondUser: match.GetSecondUser(),
+			Winner:     match.GetWinner(),
+			Draw:       match.GetDraw(),
+		})
+	}
+
+	return matches
+}
+
+func (t *tournamentInteractor) GetStandings(ctx context.Context, id string) ([]internal.Standing, error) {
+	resp, err := t.tgrpc.GetStandings(ctx, &pb.TournamentRequest{Id: id})
+	if err != nil {
+		return nil, kerror.Errorf(err, "grpc-core")
+	}
+
+	standings := make([]internal.Standing, 0, len(resp.GetStandings()))
+	for _, standing := range resp.GetStandings() {
+		standings = append(standings, internal.Standing{
+			UserID:     standing.GetUserID(),
+			Played:     int(standing.GetPlayed()),
+			Wins:       int(standing.GetWins()),
+			Draws:      int(standing.GetDraws()),
+			Losses:     int(standing.GetLosses()),
+			Points:     standing.GetPoints(),
+			Buchholz:   standing.GetBuchholz(),
+			HeadToHead: standing.GetHeadToHead(),
+		})
+	}
+
+	return standings, nil
+}
+
+func (t *tournamentInteractor) ReportMatchResult(ctx context.Context, tournamentID, matchID, winnerID string, draw bool) error {
+	if _, err := t.tgrpc.ReportMatchResult(ctx, &pb.MatchResultRequest{
+		TournamentID: tournamentID,
+		MatchID:      matchID,
+		WinnerID:     winnerID,
+		Draw:         draw,
+	}); err != nil {
+		return kerror.Errorf(err, "grpc-core")
+	}
+
+	return nil
+}
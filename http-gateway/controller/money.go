@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"math"
+
+	pb "github.com/kimbellG/tournament/core/handler/grpc"
+	"github.com/kimbellG/tournament/http/internal"
+)
+
+const (
+	centsInUnit = 100
+	nanosInCent = 10000000
+)
+
+func moneyToProto(money internal.Money) *pb.Money {
+	return &pb.Money{
+		Units: money.Cents() / centsInUnit,
+		Nanos: int32(money.Cents()%centsInUnit) * nanosInCent,
+	}
+}
+
+// moneyFromProto prefers exact amount and falls back to the double field when core doesn't send it.
+func moneyFromProto(money *pb.Money, legacy float64) internal.Money {
+	if money == nil {
+		return internal.Cents(int64(math.Round(legacy * centsInUnit)))
+	}
+
+	return internal.Cents(money.GetUnits()*centsInUnit + int64(math.Round(float64(money.GetNanos())/nanosInCent)))
+}
@@ -2,14 +2,37 @@ package controller
 
 import (
 	"context"
+	"time"
 
 	"github.com/kimbellG/kerror"
 	pb "github.com/kimbellG/tournament/core/handler/grpc"
 	"github.com/kimbellG/tournament/http/internal"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func (t *tournamentInteractor) CreateTournament(ctx context.Context, name string, deposit float64) (string, error) {
-	resp, err := t.tgrpc.CreateTournament(ctx, &pb.CreateTournamentRequest{Name: name, Deposit: deposit})
+func (t *tournamentInteractor) CreateTournament(ctx context.Context, tournament *internal.Tournament) (string, error) {
+	resp, err := t.tgrpc.CreateTournament(ctx, &pb.CreateTournamentRequest{
+		Name:                 tournament.Name,
+		Currency:             tournament.Currency,
+		Deposit:              tournament.Deposit.Float64(),
+		DepositMoney:         moneyToProto(tournament.Deposit),
+		Format:               tournament.Format,
+		Rounds:               int32(tournament.Rounds),
+		Tiebreakers:          tournament.Tiebreakers,
+		WinnerStrategy:       tournament.WinnerStrategy,
+		PayoutType:           tournament.PayoutType,
+		Payouts:              tournament.Payouts,
+		RakeType:             tournament.RakeType,
+		Rake:                 tournament.Rake,
+		Draft:                tournament.Status == internal.Draft,
+		RegistrationOpensAt:  timeToProto(tournament.RegistrationOpensAt),
+		RegistrationClosesAt: timeToProto(tournament.RegistrationClosesAt),
+		StartTime:            timeToProto(tournament.StartTime),
+		FinishDeadline:       timeToProto(tournament.FinishDeadline),
+		MinPlayers:           int32(tournament.MinPlayers),
+		MaxPlayers:           int32(tournament.MaxPlayers),
+		WithdrawalPenalty:    tournament.WithdrawalPenalty,
+	})
 	if err != nil {
 		return "", kerror.Errorf(err, "grcp-core")
 	}
@@ -28,25 +51,123 @@ func (t *tournamentInteractor) GetTournamentByID(ctx context.Context, id string)
 
 func tournamentFromProto(tournament *pb.Tournament) *internal.Tournament {
 	return &internal.Tournament{
-		ID:      tournament.GetId(),
-		Name:    tournament.GetName(),
-		Deposit: tournament.GetDeposit(),
-		Prize:   tournament.GetPrize(),
-		Users:   tournament.GetUsers(),
-		Winner:  tournament.GetWinner(),
+		ID:                tournament.GetId(),
+		Name:              tournament.GetName(),
+		Currency:          tournament.GetCurrency(),
+		Deposit:           moneyFromProto(tournament.GetDepositMoney(), tournament.GetDeposit()),
+		Prize:             moneyFromProto(tournament.GetPrizeMoney(), tournament.GetPrize()),
+		Users:             tournament.GetUsers(),
+		Winner:            tournament.GetWinner(),
+		Status:            internal.TournamentStatus(tournament.GetStatus()),
+		Format:            tournament.GetFormat(),
+		Rounds:            int(tournament.GetRounds()),
+		Tiebreakers:       tournament.GetTiebreakers(),
+		WinnerStrategy:    tournament.GetWinnerStrategy(),
+		ServerSeedHash:    tournament.GetServerSeedHash(),
+		PayoutType:        tournament.GetPayoutType(),
+		Payouts:           tournament.GetPayouts(),
+		Placements:        placementsFromProto(tournament.GetPlacements()),
+		RakeType:          tournament.GetRakeType(),
+		Rake:              tournament.GetRake(),
+		GrossEntries:      moneyFromProto(tournament.GetGrossEntriesMoney(), tournament.GetGrossEntries()),
+		RakeCollected:     moneyFromProto(tournament.GetRakeCollectedMoney(), tournament.GetRakeCollected()),
+		MinPlayers:        int(tournament.GetMinPlayers()),
+		MaxPlayers:        int(tournament.GetMaxPlayers()),
+		WithdrawalPenalty: tournament.GetWithdrawalPenalty(),
+		Schedule: internal.Schedule{
+			RegistrationOpensAt:  timeFromProto(tournament.GetRegistrationOpensAt()),
+			RegistrationClosesAt: timeFromProto(tournament.GetRegistrationClosesAt()),
+			StartTime:            timeFromProto(tournament.GetStartTime()),
+			FinishDeadline:       timeFromProto(tournament.GetFinishDeadline()),
+		},
 	}
 }
 
-func (t *tournamentInteractor) JoinTournament(ctx context.Context, tournamentID, userID string) error {
-	if _, err := t.tgrpc.JoinTournament(ctx, &pb.JoinRequest{TournamentID: tournamentID, UserID: userID}); err != nil {
+func timeToProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
+func timeFromProto(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+
+	t := ts.AsTime()
+	return &t
+}
+
+func placementsFromProto(protoPlacements []*pb.Placement) []internal.Placement {
+	placements := make([]internal.Placement, 0, len(protoPlacements))
+	for _, placement := range protoPlacements {
+		placements = append(placements, internal.Placement{
+			Place:  int(placement.GetPlace()),
+			UserID: placement.GetUserID(),
+			Prize:  moneyFromProto(placement.GetPrizeMoney(), placement.GetPrize()),
+		})
+	}
+
+	return placements
+}
+
+func (t *tournamentInteractor) JoinTournament(ctx context.Context, tournamentID, userID string, stake internal.Money, clientSeed string, waitlist bool) (*internal.JoinResult, error) {
+	resp, err := t.tgrpc.JoinTournament(ctx, &pb.JoinRequest{
+		TournamentID: tournamentID,
+		UserID:       userID,
+		Stake:        stake.Float64(),
+		StakeMoney:   moneyToProto(stake),
+		ClientSeed:   clientSeed,
+		Waitlist:     waitlist,
+	})
+	if err != nil {
+		return nil, kerror.Errorf(err, "grpc-core")
+	}
+
+	return &internal.JoinResult{
+		Waitlisted: resp.GetWaitlisted(),
+		Position:   int(resp.GetPosition()),
+	}, nil
+}
+
+func (t *tournamentInteractor) LeaveTournament(ctx context.Context, tournamentID, userID string) error {
+	if _, err := t.tgrpc.LeaveTournament(ctx, &pb.ParticipantRequest{TournamentID: tournamentID, UserID: userID}); err != nil {
+		return kerror.Errorf(err, "grpc-core")
+	}
+
+	return nil
+}
+
+func (t *tournamentInteractor) RemoveParticipant(ctx context.Context, tournamentID, userID string) error {
+	if _, err := t.tgrpc.RemoveParticipant(ctx, &pb.ParticipantRequest{TournamentID: tournamentID, UserID: userID}); err != nil {
 		return kerror.Errorf(err, "grpc-core")
 	}
 
 	return nil
 }
 
-func (t *tournamentInteractor) FinishTournament(ctx context.Context, tournamentID string) error {
-	if _, err := t.tgrpc.FinishTournament(ctx, &pb.TournamentRequest{Id: tournamentID}); err != nil {
+func (t *tournamentInteractor) GetWaitlist(ctx context.Context, id string) ([]internal.WaitlistEntry, error) {
+	resp, err := t.tgrpc.GetWaitlist(ctx, &pb.TournamentRequest{Id: id})
+	if err != nil {
+		return nil, kerror.Errorf(err, "grpc-core")
+	}
+
+	waitlist := make([]internal.WaitlistEntry, 0, len(resp.GetEntries()))
+	for _, entry := range resp.GetEntries() {
+		waitlist = append(waitlist, internal.WaitlistEntry{
+			UserID:   entry.GetUserID(),
+			Stake:    moneyFromProto(entry.GetStakeMoney(), entry.GetStake()),
+			Position: int(entry.GetPosition()),
+		})
+	}
+
+	return waitlist, nil
+}
+
+func (t *tournamentInteractor) FinishTournament(ctx context.Context, tournamentID string, ranking []string) error {
+	if _, err := t.tgrpc.FinishTournament(ctx, &pb.FinishRequest{Id: tournamentID, Ranking: ranking}); err != nil {
 		return kerror.Errorf(err, "grpc-core")
 	}
 
@@ -60,3 +181,52 @@ func (t *tournamentInteractor) CancelTournament(ctx context.Context, tournamentI
 
 	return nil
 }
+
+func (t *tournamentInteractor) OpenRegistration(ctx context.Context, tournamentID string) error {
+	if _, err := t.tgrpc.OpenRegistration(ctx, &pb.TournamentRequest{Id: tournamentID}); err != nil {
+		return kerror.Errorf(err, "grpc-core")
+	}
+
+	return nil
+}
+
+func (t *tournamentInteractor) CloseRegistration(ctx context.Context, tournamentID string) error {
+	if _, err := t.tgrpc.CloseRegistration(ctx, &pb.TournamentRequest{Id: tournamentID}); err != nil {
+		return kerror.Errorf(err, "grpc-core")
+	}
+
+	return nil
+}
+
+func (t *tournamentInteractor) ReportScore(ctx context.Context, tournamentID, userID string, score float64) error {
+	if _, err := t.tgrpc.ReportScore(ctx, &pb.ScoreRequest{TournamentID: tournamentID, UserID: userID, Score: score}); err != nil {
+		return kerror.Errorf(err, "grpc-core")
+	}
+
+	return nil
+}
+
+func (t *tournamentInteractor) GetDrawProof(ctx context.Context, id string) (*internal.DrawProof, error) {
+	proof, err := t.tgrpc.GetDrawProof(ctx, &pb.TournamentRequest{Id: id})
+	if err != nil {
+		return nil, kerror.Errorf(err, "grpc-core")
+	}
+
+	entries := make([]internal.DrawEntry, 0, len(proof.GetEntries()))
+	for _, entry := range proof.GetEntries() {
+		entries = append(entries, internal.DrawEntry{
+			UserID:     entry.GetUserID(),
+			ClientSeed: entry.GetClientSeed(),
+		})
+	}
+
+	return &internal.DrawProof{
+		TournamentID:   proof.GetTournamentID(),
+		Algorithm:      proof.GetAlgorithm(),
+		ServerSeedHash: proof.GetServerSeedHash(),
+		ServerSeed:     proof.GetServerSeed(),
+		Entries:        entries,
+		WinnerIndex:    int(proof.GetWinnerIndex()),
+		Winner:         proof.GetWinner(),
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/kimbellG/kerror"
 	pb "github.com/kimbellG/tournament/core/handler/grpc"
 	"github.com/kimbellG/tournament/http/internal"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 func (t *tournamentInteractor) CreateUser(ctx context.Context, user *internal.User) (*internal.User, error) {
@@ -20,10 +21,19 @@ func (t *tournamentInteractor) CreateUser(ctx context.Context, user *internal.Us
 }
 
 func userToProto(user *internal.User) *pb.User {
+	balances := make([]*pb.Balance, 0, len(user.Balances))
+	for _, balance := range user.Balances {
+		balances = append(balances, &pb.Balance{Currency: balance.Currency, Amount: moneyToProto(balance.Amount)})
+	}
+
 	return &pb.User{
-		ID:      user.ID,
-		Name:    user.Name,
-		Balance: user.Balance,
+		ID:           user.ID,
+		Name:         user.Name,
+		Balance:      user.Balance.Float64(),
+		BalanceMoney: moneyToProto(user.Balance),
+		Balances:     balances,
+		Password:     user.Password,
+		Email:        user.Email,
 	}
 }
 
@@ -37,10 +47,26 @@ func (t *tournamentInteractor) GetUserByID(ctx context.Context, id string) (*int
 }
 
 func userFromProto(user *pb.User) *internal.User {
+	balances := make([]internal.Balance, 0, len(user.GetBalances()))
+	for _, balance := range user.GetBalances() {
+		amount := moneyFromProto(balance.GetAmount(), 0)
+		balances = append(balances, internal.Balance{
+			Currency:  balance.GetCurrency(),
+			Amount:    amount,
+			Available: moneyFromProto(balance.GetAvailable(), amount.Float64()),
+		})
+	}
+
+	total := moneyFromProto(user.GetBalanceMoney(), user.GetBalance())
+
 	return &internal.User{
-		ID:      user.GetID(),
-		Name:    user.GetName(),
-		Balance: user.GetBalance(),
+		ID:               user.GetID(),
+		Name:             user.GetName(),
+		Email:            user.GetEmail(),
+		Role:             internal.Role(user.GetRole()),
+		Balance:          total,
+		AvailableBalance: moneyFromProto(user.GetAvailableBalanceMoney(), total.Float64()),
+		Balances:         balances,
 	}
 }
 
@@ -52,19 +78,130 @@ func (t *tournamentInteractor) DeleteUser(ctx context.Context, id string) error
 	return nil
 }
 
-func (t *tournamentInteractor) UpdateBalanceBySum(ctx context.Context, id string, d float64) error {
-	if _, err := t.tgrpc.SumToBalance(ctx, &pb.RequestToUpdateBalance{ID: id, Addend: d}); err != nil {
+func (t *tournamentInteractor) UpdateBalanceBySum(ctx context.Context, id, currency string, d internal.Money) error {
+	if _, err := t.tgrpc.SumToBalance(ctx, &pb.RequestToUpdateBalance{
+		ID:          id,
+		Addend:      d.Float64(),
+		AddendMoney: moneyToProto(d),
+		Currency:    currency,
+	}); err != nil {
 		return kerror.Errorf(err, "grpc-core")
 	}
 
 	return nil
 }
 
-func (t *tournamentInteractor) LogIn(ctx context.Context, login, password string) (string, error) {
+func (t *tournamentInteractor) LogIn(ctx context.Context, login, password string) (*internal.Session, error) {
 	resp, err := t.tgrpc.UserAuthorization(ctx, &pb.AuthorizationRequest{Username: login, Password: password})
 	if err != nil {
-		return "", kerror.Errorf(err, "grpc request to core service")
+		return nil, kerror.Errorf(err, "grpc request to core service")
+	}
+
+	return sessionFromProto(resp), nil
+}
+
+func (t *tournamentInteractor) RefreshSession(ctx context.Context, refreshToken string) (*internal.Session, error) {
+	resp, err := t.tgrpc.RefreshSession(ctx, &pb.RefreshRequest{RefreshToken: refreshToken})
+	if err != nil {
+		return nil, kerror.Errorf(err, "grpc-core")
+	}
+
+	return sessionFromProto(resp), nil
+}
+
+func sessionFromProto(resp *pb.AuthorizationResponse) *internal.Session {
+	return &internal.Session{
+		ID:           resp.GetSessionID(),
+		UserID:       resp.GetId(),
+		Role:         internal.Role(resp.GetRole()),
+		RefreshToken: resp.GetRefreshToken(),
+		ExpiresAt:    resp.GetSessionExpiresAt().AsTime(),
+	}
+}
+
+// Logout revokes the session of the token ctx carries.
+func (t *tournamentInteractor) Logout(ctx context.Context) error {
+	if _, err := t.tgrpc.Logout(ctx, &emptypb.Empty{}); err != nil {
+		return kerror.Errorf(err, "grpc-core")
+	}
+
+	return nil
+}
+
+// Authenticate fails, when the session of the token ctx carries is expired or revoked.
+func (t *tournamentInteractor) Authenticate(ctx context.Context) error {
+	if _, err := t.tgrpc.Authenticate(ctx, &emptypb.Empty{}); err != nil {
+		return kerror.Errorf(err, "grpc-core")
+	}
+
+	return nil
+}
+
+func (t *tournamentInteractor) SetUserRole(ctx context.Context, id string, role internal.Role) error {
+	if _, err := t.tgrpc.SetUserRole(ctx, &pb.SetRoleRequest{UserID: id, Role: string(role)}); err != nil {
+		return kerror.Errorf(err, "grpc-core")
+	}
+
+	return nil
+}
+
+func (t *tournamentInteractor) ChangePassword(ctx context.Context, id, oldPassword, newPassword string) error {
+	if _, err := t.tgrpc.ChangePassword(ctx, &pb.ChangePasswordRequest{
+		UserID:      id,
+		OldPassword: oldPassword,
+		NewPassword: newPassword,
+	}); err != nil {
+		return kerror.Errorf(err, "grpc-core")
+	}
+
+	return nil
+}
+
+func (t *tournamentInteractor) RequestPasswordReset(ctx context.Context, login string) error {
+	if _, err := t.tgrpc.RequestPasswordReset(ctx, &pb.PasswordResetRequest{Username: login}); err != nil {
+		return kerror.Errorf(err, "grpc-core")
+	}
+
+	return nil
+}
+
+func (t *tournamentInteractor) ResetPassword(ctx context.Context, token, newPassword string) error {
+	if _, err := t.tgrpc.ResetPassword(ctx, &pb.ResetPasswordRequest{Token: token, NewPassword: newPassword}); err != nil {
+		return kerror.Errorf(err, "grpc-core")
+	}
+
+	return nil
+}
+
+func (t *tournamentInteractor) ListUserTransactions(ctx context.Context, id string, filter *internal.TransactionFilter) (*internal.TransactionsPage, error) {
+	resp, err := t.tgrpc.ListUserTransactions(ctx, &pb.UserTransactionsRequest{
+		UserID:   id,
+		Types:    filter.Types,
+		Currency: filter.Currency,
+		From:     timeToProto(filter.From),
+		To:       timeToProto(filter.To),
+		Limit:    int32(filter.Limit),
+		Offset:   int32(filter.Offset),
+	})
+	if err != nil {
+		return nil, kerror.Errorf(err, "grpc-core")
+	}
+
+	page := &internal.TransactionsPage{
+		Transactions: make([]internal.UserTransaction, 0, len(resp.GetTransactions())),
+		NextOffset:   int(resp.GetNextOffset()),
+	}
+	for _, transaction := range resp.GetTransactions() {
+		page.Transactions = append(page.Transactions, internal.UserTransaction{
+			ID:           transaction.GetId(),
+			Type:         transaction.GetType(),
+			TournamentID: transaction.GetTournamentID(),
+			Currency:     transaction.GetCurrency(),
+			Amount:       moneyFromProto(transaction.GetAmountMoney(), transaction.GetAmount()),
+			BalanceAfter: moneyFromProto(transaction.GetBalanceAfterMoney(), transaction.GetBalanceAfter()),
+			CreatedAt:    transaction.GetCreatedAt().AsTime(),
+		})
 	}
 
-	return resp.GetId(), nil
+	return page, nil
 }
@@ -4,7 +4,7 @@ go 1.16
 
 replace github.com/kimbellG/tournament/core => ../core
 
-replace github.com/kimbellG/kerror => ../../tournament-error
+replace github.com/kimbellG/kerror => ../tournament-error
 
 require (
 	github.com/golang-jwt/jwt v3.2.2+incompatible // indirect
@@ -14,4 +14,5 @@ require (
 	github.com/kimbellG/kerror v0.0.0-20210820142247-2f3f8ab8756f
 	github.com/kimbellG/tournament/core v0.0.0-00010101000000-000000000000
 	google.golang.org/grpc v1.40.0
+	google.golang.org/protobuf v1.27.1
 )
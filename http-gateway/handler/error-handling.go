@@ -29,6 +29,11 @@ var httpCodeDict = map[kerror.StatusCode]int{
 
 	kerror.TournamentDoesntExists: http.StatusNotFound,
 	kerror.UserDoesntExists:       http.StatusNotFound,
+	kerror.TournamentIsFull:       http.StatusConflict,
+	kerror.AlreadyJoined:          http.StatusConflict,
+	kerror.LimitExceeded:          http.StatusForbidden,
+	kerror.Unauthenticated:        http.StatusUnauthorized,
+	kerror.Forbidden:              http.StatusForbidden,
 	kerror.Unknown:                http.StatusBadRequest,
 }
 
@@ -4,6 +4,9 @@ import (
 	"github.com/kimbellG/tournament/http/controller"
 )
 
+// IdempotencyKeyHeader lets clients retry requests, which move money, without repeating their effect.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
 type Handler struct {
 	tournament controller.TournamentController
 }
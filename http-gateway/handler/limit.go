@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/http/internal"
+)
+
+func (h *Handler) GetLimits(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)[IDPath]
+
+	limits, err := h.tournament.GetLimits(r.Context(), id)
+	if err != nil {
+		http.Error(w, "Failed to get limits of user: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(limits); err != nil {
+		http.Error(w, "Failed to encode limits in body: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) SetLimit(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)[IDPath]
+
+	limit := &internal.Limit{}
+	if err := json.NewDecoder(r.Body).Decode(limit); err != nil {
+		http.Error(w, "Failed to decode limit request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer Close(r.Body)
+
+	if err := limit.Valid(); err != nil {
+		http.Error(w, "Failed to validate limit request: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+
+	saved, err := h.tournament.SetLimit(r.Context(), id, limit)
+	if err != nil {
+		http.Error(w, "Failed to set limit of user: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(saved); err != nil {
+		http.Error(w, "Failed to encode limit in body: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// SelfExcludeRequest blocks entries of user into tournaments until the moment.
+type SelfExcludeRequest struct {
+	Until time.Time `json:"until"`
+}
+
+func (s *SelfExcludeRequest) Valid() error {
+	if s.Until.IsZero() {
+		return kerror.Newf(kerror.BadRequest, "end of self-exclusion should be set")
+	}
+
+	return nil
+}
+
+func (h *Handler) SelfExclude(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)[IDPath]
+
+	excludeRequest := &SelfExcludeRequest{}
+	if err := json.NewDecoder(r.Body).Decode(excludeRequest); err != nil {
+		http.Error(w, "Failed to decode self-exclusion request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer Close(r.Body)
+
+	if err := excludeRequest.Valid(); err != nil {
+		http.Error(w, "Failed to validate self-exclusion request: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+
+	if err := h.tournament.SelfExclude(r.Context(), id, excludeRequest.Until); err != nil {
+		http.Error(w, "Failed to self-exclude user: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+}
@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/kimbellG/kerror"
+)
+
+func (h *Handler) StartTournament(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)[IDPath]
+
+	if err := h.tournament.StartTournament(r.Context(), id); err != nil {
+		http.Error(w, "Failed to start tournament: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+}
+
+func (h *Handler) GetMatches(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)[IDPath]
+
+	matches, err := h.tournament.GetMatches(r.Context(), id)
+	if err != nil {
+		http.Error(w, "Failed to get matches of tournament: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(matches); err != nil {
+		http.Error(w, "Failed to encode matches in response body: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) GetStandings(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)[IDPath]
+
+	standings, err := h.tournament.GetStandings(r.Context(), id)
+	if err != nil {
+		http.Error(w, "Failed to get standings of tournament: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(standings); err != nil {
+		http.Error(w, "Failed to encode standings in response body: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+type MatchResultRequest struct {
+	WinnerID string `json:"winnerId"`
+	Draw     bool   `json:"draw"`
+}
+
+func (m *MatchResultRequest) Valid() error {
+	if m.Draw {
+		if m.WinnerID != "" {
+			return kerror.Newf(kerror.BadRequest, "drawn match can't have a winner")
+		}
+
+		return nil
+	}
+
+	if _, err := uuid.Parse(m.WinnerID); err != nil {
+		return kerror.Newf(kerror.BadRequest, "invalid format of winner id: %v", err)
+	}
+
+	return nil
+}
+
+func (h *Handler) ReportMatchResult(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	resultRequest := &MatchResultRequest{}
+
+	if err := json.NewDecoder(r.Body).Decode(resultRequest); err != nil {
+		http.Error(w, "Failed to decode match result body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := resultRequest.Valid(); err != nil {
+		http.Error(w, "Failed to validate match result: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+
+	if err := h.tournament.ReportMatchResult(r.Context(), vars[IDPath], vars[MatchIDPath], resultRequest.WinnerID, resultRequest.Draw); err != nil {
+		http.Error(w, "Failed to report result of match: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+}
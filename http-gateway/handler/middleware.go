@@ -1,26 +1,37 @@
 package handler
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 	"strings"
 
 	"github.com/golang-jwt/jwt"
+	"github.com/kimbellG/tournament/http/controller"
 )
 
+// Sessions checks in the core that session of the token ctx carries isn't expired or revoked.
+type Sessions interface {
+	Authenticate(ctx context.Context) error
+}
+
 type AuthenticationMiddleware struct {
 	TokenPassword string
+	Sessions      Sessions
 	NotAuthPaths  []string
 }
 
 func (amw *AuthenticationMiddleware) Middleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if amw.isPathWithAuthentication(r.URL.Path) {
-			if err := amw.logIn(r); err != nil {
+			ctx, err := amw.logIn(r)
+			if err != nil {
 				http.Error(w, "Failed of user authentication: "+err.Error(), http.StatusForbidden)
 				return
 			}
+
+			r = r.WithContext(ctx)
 		}
 
 		next.ServeHTTP(w, r)
@@ -37,20 +48,28 @@ func (amw *AuthenticationMiddleware) isPathWithAuthentication(path string) bool
 	return true
 }
 
-func (amw *AuthenticationMiddleware) logIn(r *http.Request) error {
+// logIn validates token of request and returns context of request with claims and the token,
+// which is forwarded to the core. Token of revoked session is rejected.
+func (amw *AuthenticationMiddleware) logIn(r *http.Request) (context.Context, error) {
 	tkString, err := getAuthTokenString(r)
 	if err != nil {
-		return fmt.Errorf("get token from header: %v", err)
+		return nil, fmt.Errorf("get token from header: %v", err)
 	}
 
-	tk, err := jwt.ParseWithClaims(tkString, &LogClaims{}, func(token *jwt.Token) (interface{}, error) {
+	claims := &LogClaims{}
+	tk, err := jwt.ParseWithClaims(tkString, claims, func(token *jwt.Token) (interface{}, error) {
 		return []byte(amw.TokenPassword), nil
 	})
 	if err := ValidateToken(tk, err); err != nil {
-		return fmt.Errorf("validate token from header: %v", err)
+		return nil, fmt.Errorf("validate token from header: %v", err)
 	}
 
-	return nil
+	ctx := controller.WithAuthToken(context.WithValue(r.Context(), claimsKey{}, claims), tkString)
+	if err := amw.Sessions.Authenticate(ctx); err != nil {
+		return nil, fmt.Errorf("check session of token: %v", err)
+	}
+
+	return ctx, nil
 }
 
 func getAuthTokenString(r *http.Request) (string, error) {
@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/http/internal"
+)
+
+var (
+	allRoles      = []internal.Role{internal.AdminRole, internal.OrganizerRole, internal.PlayerRole}
+	playerRoles   = []internal.Role{internal.AdminRole, internal.PlayerRole}
+	organizerRole = []internal.Role{internal.AdminRole, internal.OrganizerRole}
+	adminRole     = []internal.Role{internal.AdminRole}
+)
+
+// claimsKey is the key of claims of authenticated user in the context of request.
+type claimsKey struct{}
+
+func claimsFromRequest(r *http.Request) (*LogClaims, bool) {
+	claims, ok := r.Context().Value(claimsKey{}).(*LogClaims)
+	return claims, ok
+}
+
+// allow lets through requests of the users with one of roles. The core checks roles again,
+// so the policy of routes should follow the policy of methods of the core.
+func allow(roles []internal.Role, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		claims, ok := claimsFromRequest(r)
+		if !ok {
+			err := kerror.Newf(kerror.Unauthenticated, "user isn't authenticated")
+			http.Error(w, "Failed of user authorization: "+err.Error(), decodeStatusCode(err))
+			return
+		}
+
+		for _, role := range roles {
+			if claims.Role == role {
+				next(w, r)
+				return
+			}
+		}
+
+		err := kerror.Newf(kerror.Forbidden, "%s isn't allowed to %s %s", claims.Role, r.Method, r.URL.Path)
+		http.Error(w, "Failed of user authorization: "+err.Error(), decodeStatusCode(err))
+	}
+}
+
+// ownAccount lets users call routes of their own account, the one in the path. Admins call routes of any account.
+func ownAccount(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := authorizeOwner(r, mux.Vars(r)[IDPath]); err != nil {
+			http.Error(w, "Failed of user authorization: "+err.Error(), decodeStatusCode(err))
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+// authorizeOwner fails, unless the authenticated user is the one with userID or admin. Denied attempts are logged.
+func authorizeOwner(r *http.Request, userID string) error {
+	claims, ok := claimsFromRequest(r)
+	if !ok {
+		return kerror.Newf(kerror.Unauthenticated, "user isn't authenticated")
+	}
+
+	if claims.Role == internal.AdminRole || claims.ID == userID {
+		return nil
+	}
+
+	log.Printf("Denied %s %s: user(%s) acted on account of user(%s)", r.Method, r.URL.Path, claims.ID, userID)
+	return kerror.Newf(kerror.Forbidden, "user(%s) isn't allowed to act on account of user(%s)", claims.ID, userID)
+}
+
+// callerID returns id of the authenticated user.
+func callerID(r *http.Request) string {
+	if claims, ok := claimsFromRequest(r); ok {
+		return claims.ID
+	}
+
+	return ""
+}
@@ -9,13 +9,20 @@ import (
 
 const (
 	IDPath         = "id"
+	MatchIDPath    = "matchId"
+	UserIDPath     = "userId"
 	UserPath       = "user"
 	TournamentPath = "tournament"
 	LogInPath      = "login"
+	LogOutPath     = "logout"
+	RefreshPath    = "token/refresh"
+
+	PasswordResetPath = "password-reset"
 )
 
 const uuidRegex = "[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"
 
+// RegisterUserEndpoints registers routes of users. Every route, which needs authentication, names the roles allowed to call it.
 func RegisterUserEndpoints(router *mux.Router, tc controller.TournamentController) {
 	h := NewHandler(tc)
 
@@ -23,36 +30,103 @@ func RegisterUserEndpoints(router *mux.Router, tc controller.TournamentControlle
 		h.CreateUser).Methods("POST")
 
 	router.HandleFunc(fmt.Sprintf("/%s/{%s:%s}", UserPath, IDPath, uuidRegex),
-		h.GetUserByID).Methods("GET")
+		allow(allRoles, ownAccount(h.GetUserByID))).Methods("GET")
 
 	router.HandleFunc(fmt.Sprintf("/%s/{%s:%s}", UserPath, IDPath, uuidRegex),
-		h.DeleteUser).Methods("DELETE")
+		allow(playerRoles, ownAccount(h.DeleteUser))).Methods("DELETE")
 
 	router.HandleFunc(fmt.Sprintf("/%s/{%s:%s}/take", UserPath, IDPath, uuidRegex),
-		h.TakeFromBalance).Methods("POST")
+		allow(playerRoles, ownAccount(h.TakeFromBalance))).Methods("POST")
 
 	router.HandleFunc(fmt.Sprintf("/%s/{%s:%s}/fund", UserPath, IDPath, uuidRegex),
-		h.AddToBalance).Methods("POST")
+		allow(playerRoles, ownAccount(h.AddToBalance))).Methods("POST")
+
+	router.HandleFunc(fmt.Sprintf("/%s/{%s:%s}/transactions", UserPath, IDPath, uuidRegex),
+		allow(playerRoles, ownAccount(h.ListUserTransactions))).Methods("GET")
+
+	router.HandleFunc(fmt.Sprintf("/%s/{%s:%s}/limits", UserPath, IDPath, uuidRegex),
+		allow(playerRoles, ownAccount(h.GetLimits))).Methods("GET")
+
+	router.HandleFunc(fmt.Sprintf("/%s/{%s:%s}/limits", UserPath, IDPath, uuidRegex),
+		allow(playerRoles, ownAccount(h.SetLimit))).Methods("PUT")
+
+	router.HandleFunc(fmt.Sprintf("/%s/{%s:%s}/exclusion", UserPath, IDPath, uuidRegex),
+		allow(playerRoles, ownAccount(h.SelfExclude))).Methods("POST")
+
+	router.HandleFunc(fmt.Sprintf("/%s/{%s:%s}/role", UserPath, IDPath, uuidRegex),
+		allow(adminRole, h.SetUserRole)).Methods("PUT")
+
+	router.HandleFunc(fmt.Sprintf("/%s/{%s:%s}/password", UserPath, IDPath, uuidRegex),
+		allow(allRoles, ownAccount(h.ChangePassword))).Methods("PUT")
 
 	router.HandleFunc(fmt.Sprintf("/%s", LogInPath),
 		h.UserLogIn).Methods("GET")
+
+	router.HandleFunc(fmt.Sprintf("/%s", RefreshPath),
+		h.RefreshToken).Methods("POST")
+
+	router.HandleFunc(fmt.Sprintf("/%s", LogOutPath),
+		allow(allRoles, h.Logout)).Methods("POST")
+
+	router.HandleFunc(fmt.Sprintf("/%s", PasswordResetPath),
+		h.RequestPasswordReset).Methods("POST")
+
+	router.HandleFunc(fmt.Sprintf("/%s", PasswordResetPath),
+		h.ResetPassword).Methods("PUT")
 }
 
+// RegisterTournamentEndpoints registers routes of tournaments. Every route names the roles allowed to call it.
 func RegisterTournamentEndpoints(router *mux.Router, tc controller.TournamentController) {
 	h := NewHandler(tc)
 
 	router.HandleFunc(fmt.Sprintf("/%s", TournamentPath),
-		h.CreateTournament).Methods("POST")
+		allow(organizerRole, h.CreateTournament)).Methods("POST")
 
 	router.HandleFunc(fmt.Sprintf("/%s/{%s:%s}", TournamentPath, IDPath, uuidRegex),
-		h.GetTournamentByID).Methods("GET")
+		allow(allRoles, h.GetTournamentByID)).Methods("GET")
 
 	router.HandleFunc(fmt.Sprintf("/%s/{%s:%s}", TournamentPath, IDPath, uuidRegex),
-		h.CancelTournament).Methods("DELETE")
+		allow(organizerRole, h.CancelTournament)).Methods("DELETE")
+
+	router.HandleFunc(fmt.Sprintf("/%s/{%s:%s}/registration/open", TournamentPath, IDPath, uuidRegex),
+		allow(organizerRole, h.OpenRegistration)).Methods("POST")
+
+	router.HandleFunc(fmt.Sprintf("/%s/{%s:%s}/registration/close", TournamentPath, IDPath, uuidRegex),
+		allow(organizerRole, h.CloseRegistration)).Methods("POST")
+
+	router.HandleFunc(fmt.Sprintf("/%s/{%s:%s}/join", TournamentPath, IDPath, uuidRegex),
+		allow(playerRoles, h.JoinTournament)).Methods("POST")
 
 	router.HandleFunc(fmt.Sprintf("/%s/{%s:%s}/join", TournamentPath, IDPath, uuidRegex),
-		h.JoinTournament).Methods("POST")
+		allow(playerRoles, h.LeaveTournament)).Methods("DELETE")
+
+	router.HandleFunc(fmt.Sprintf("/%s/{%s:%s}/participants/{%s:%s}", TournamentPath, IDPath, uuidRegex, UserIDPath, uuidRegex),
+		allow(organizerRole, h.RemoveParticipant)).Methods("DELETE")
+
+	router.HandleFunc(fmt.Sprintf("/%s/{%s:%s}/waitlist", TournamentPath, IDPath, uuidRegex),
+		allow(allRoles, h.GetWaitlist)).Methods("GET")
+
+	router.HandleFunc(fmt.Sprintf("/%s/{%s:%s}/waitlist/{%s:%s}", TournamentPath, IDPath, uuidRegex, UserIDPath, uuidRegex),
+		allow(allRoles, h.GetWaitlistPosition)).Methods("GET")
 
 	router.HandleFunc(fmt.Sprintf("/%s/{%s:%s}/finish", TournamentPath, IDPath, uuidRegex),
-		h.JoinTournament).Methods("POST")
+		allow(organizerRole, h.FinishTournament)).Methods("POST")
+
+	router.HandleFunc(fmt.Sprintf("/%s/{%s:%s}/score", TournamentPath, IDPath, uuidRegex),
+		allow(organizerRole, h.ReportScore)).Methods("POST")
+
+	router.HandleFunc(fmt.Sprintf("/%s/{%s:%s}/draw-proof", TournamentPath, IDPath, uuidRegex),
+		allow(allRoles, h.GetDrawProof)).Methods("GET")
+
+	router.HandleFunc(fmt.Sprintf("/%s/{%s:%s}/start", TournamentPath, IDPath, uuidRegex),
+		allow(organizerRole, h.StartTournament)).Methods("POST")
+
+	router.HandleFunc(fmt.Sprintf("/%s/{%s:%s}/matches", TournamentPath, IDPath, uuidRegex),
+		allow(allRoles, h.GetMatches)).Methods("GET")
+
+	router.HandleFunc(fmt.Sprintf("/%s/{%s:%s}/standings", TournamentPath, IDPath, uuidRegex),
+		allow(allRoles, h.GetStandings)).Methods("GET")
+
+	router.HandleFunc(fmt.Sprintf("/%s/{%s:%s}/matches/{%s:%s}", TournamentPath, IDPath, uuidRegex, MatchIDPath, uuidRegex),
+		allow(organizerRole, h.ReportMatchResult)).Methods("POST")
 }
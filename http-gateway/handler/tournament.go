@@ -2,23 +2,56 @@ package handler
 
 import (
 	"encoding/json"
+	"io"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/http/controller"
+	"github.com/kimbellG/tournament/http/internal"
 )
 
 type TournamentCreateRequest struct {
-	Name    string
-	Deposit float64
+	Name              string
+	Currency          string
+	Deposit           internal.Money
+	Format            string
+	Rounds            int
+	Tiebreakers       []string
+	WinnerStrategy    string
+	PayoutType        string
+	Payouts           []float64
+	RakeType          string
+	Rake              float64
+	Draft             bool
+	MinPlayers        int
+	MaxPlayers        int
+	WithdrawalPenalty float64
+	internal.Schedule
 }
 
 func (tc *TournamentCreateRequest) Valid() error {
-	if tc.Deposit <= 0 {
+	if !tc.Deposit.IsPositive() {
 		return kerror.Newf(kerror.BadRequest, "deposit should be more than 0")
 	}
 
+	if tc.Rounds < 0 {
+		return kerror.Newf(kerror.BadRequest, "rounds should be positive")
+	}
+
+	if tc.Rake < 0 {
+		return kerror.Newf(kerror.BadRequest, "rake should be positive")
+	}
+
+	if tc.MinPlayers < 0 || tc.MaxPlayers < 0 {
+		return kerror.Newf(kerror.BadRequest, "limits of players should be positive")
+	}
+
+	if tc.WithdrawalPenalty < 0 {
+		return kerror.Newf(kerror.BadRequest, "withdrawal penalty should be positive")
+	}
+
 	return nil
 }
 
@@ -38,7 +71,24 @@ func (h *Handler) CreateTournament(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	id, err := h.tournament.CreateTournament(r.Context(), tournament.Name, tournament.Deposit)
+	id, err := h.tournament.CreateTournament(r.Context(), &internal.Tournament{
+		Name:              tournament.Name,
+		Currency:          tournament.Currency,
+		Deposit:           tournament.Deposit,
+		Format:            tournament.Format,
+		Rounds:            tournament.Rounds,
+		Tiebreakers:       tournament.Tiebreakers,
+		WinnerStrategy:    tournament.WinnerStrategy,
+		PayoutType:        tournament.PayoutType,
+		Payouts:           tournament.Payouts,
+		RakeType:          tournament.RakeType,
+		Rake:              tournament.Rake,
+		Status:            createStatus(tournament.Draft),
+		Schedule:          tournament.Schedule,
+		MinPlayers:        tournament.MinPlayers,
+		MaxPlayers:        tournament.MaxPlayers,
+		WithdrawalPenalty: tournament.WithdrawalPenalty,
+	})
 	if err != nil {
 		http.Error(w, "Failed to create tournament: "+err.Error(), decodeStatusCode(err))
 		return
@@ -50,6 +100,14 @@ func (h *Handler) CreateTournament(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func createStatus(draft bool) internal.TournamentStatus {
+	if draft {
+		return internal.Draft
+	}
+
+	return internal.RegistrationOpen
+}
+
 func (h *Handler) GetTournamentByID(w http.ResponseWriter, r *http.Request) {
 	id := mux.Vars(r)[IDPath]
 
@@ -65,8 +123,12 @@ func (h *Handler) GetTournamentByID(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// JoinRequest without UserID joins the authenticated user. Only admin joins other users.
 type JoinRequest struct {
-	UserID string `json:"userId"`
+	UserID     string         `json:"userId"`
+	Stake      internal.Money `json:"stake"`
+	ClientSeed string         `json:"clientSeed"`
+	Waitlist   bool           `json:"waitlist"`
 }
 
 func (j *JoinRequest) Valid() error {
@@ -75,6 +137,10 @@ func (j *JoinRequest) Valid() error {
 		return kerror.Newf(kerror.BadRequest, "invalid format of user id: %v", err)
 	}
 
+	if j.Stake.IsNegative() {
+		return kerror.Newf(kerror.BadRequest, "stake should be positive")
+	}
+
 	return nil
 }
 
@@ -82,26 +148,172 @@ func (h *Handler) JoinTournament(w http.ResponseWriter, r *http.Request) {
 	tournamentID := mux.Vars(r)[IDPath]
 	joinRequest := &JoinRequest{}
 
-	if err := json.NewDecoder(r.Body).Decode(joinRequest); err != nil {
+	if err := json.NewDecoder(r.Body).Decode(joinRequest); err != nil && err != io.EOF {
 		http.Error(w, "Failed to decode join request body:"+err.Error(), http.StatusBadRequest)
 		return
 	}
 
+	if joinRequest.UserID == "" {
+		joinRequest.UserID = callerID(r)
+	}
+
 	if err := joinRequest.Valid(); err != nil {
 		http.Error(w, "Failed to validate join request: "+err.Error(), decodeStatusCode(err))
 		return
 	}
 
-	if err := h.tournament.JoinTournament(r.Context(), tournamentID, joinRequest.UserID); err != nil {
+	if err := authorizeOwner(r, joinRequest.UserID); err != nil {
+		http.Error(w, "Failed of user authorization: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+
+	ctx := controller.WithIdempotencyKey(r.Context(), r.Header.Get(IdempotencyKeyHeader))
+	result, err := h.tournament.JoinTournament(ctx, tournamentID, joinRequest.UserID, joinRequest.Stake, joinRequest.ClientSeed, joinRequest.Waitlist)
+	if err != nil {
 		http.Error(w, "Failed to join user to tournament: "+err.Error(), decodeStatusCode(err))
 		return
 	}
+
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		http.Error(w, "Failed to encode join result in response body: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// LeaveRequest without UserID is made for the authenticated user.
+type LeaveRequest struct {
+	UserID string `json:"userId"`
+}
+
+func (l *LeaveRequest) Valid() error {
+	if _, err := uuid.Parse(l.UserID); err != nil {
+		return kerror.Newf(kerror.BadRequest, "invalid format of user id: %v", err)
+	}
+
+	return nil
+}
+
+func (h *Handler) LeaveTournament(w http.ResponseWriter, r *http.Request) {
+	tournamentID := mux.Vars(r)[IDPath]
+	leaveRequest := &LeaveRequest{}
+
+	if err := json.NewDecoder(r.Body).Decode(leaveRequest); err != nil && err != io.EOF {
+		http.Error(w, "Failed to decode leave request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if leaveRequest.UserID == "" {
+		leaveRequest.UserID = callerID(r)
+	}
+
+	if err := leaveRequest.Valid(); err != nil {
+		http.Error(w, "Failed to validate leave request: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+
+	if err := authorizeOwner(r, leaveRequest.UserID); err != nil {
+		http.Error(w, "Failed of user authorization: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+
+	if err := h.tournament.LeaveTournament(r.Context(), tournamentID, leaveRequest.UserID); err != nil {
+		http.Error(w, "Failed to leave tournament: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+}
+
+func (h *Handler) RemoveParticipant(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	if err := h.tournament.RemoveParticipant(r.Context(), vars[IDPath], vars[UserIDPath]); err != nil {
+		http.Error(w, "Failed to remove participant: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+}
+
+func (h *Handler) GetWaitlist(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)[IDPath]
+
+	waitlist, err := h.tournament.GetWaitlist(r.Context(), id)
+	if err != nil {
+		http.Error(w, "Failed to get waitlist: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(waitlist); err != nil {
+		http.Error(w, "Failed to encode waitlist in response body: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) GetWaitlistPosition(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	waitlist, err := h.tournament.GetWaitlist(r.Context(), vars[IDPath])
+	if err != nil {
+		http.Error(w, "Failed to get waitlist: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+
+	for _, entry := range waitlist {
+		if entry.UserID != vars[UserIDPath] {
+			continue
+		}
+
+		if err := json.NewEncoder(w).Encode(entry); err != nil {
+			http.Error(w, "Failed to encode waitlist position in response body: "+err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	http.Error(w, "User isn't on waitlist of tournament", http.StatusNotFound)
+}
+
+type FinishRequest struct {
+	WinnerID string   `json:"winnerId"`
+	Ranking  []string `json:"ranking"`
+}
+
+func (f *FinishRequest) Valid() error {
+	if f.WinnerID != "" {
+		if _, err := uuid.Parse(f.WinnerID); err != nil {
+			return kerror.Newf(kerror.BadRequest, "invalid format of winner id: %v", err)
+		}
+	}
+
+	for _, userID := range f.Ranking {
+		if _, err := uuid.Parse(userID); err != nil {
+			return kerror.Newf(kerror.BadRequest, "invalid format of ranked user id: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// ranking returns ranked users from the first place, falling back to the single winner.
+func (f *FinishRequest) ranking() []string {
+	if len(f.Ranking) == 0 && f.WinnerID != "" {
+		return []string{f.WinnerID}
+	}
+
+	return f.Ranking
 }
 
 func (h *Handler) FinishTournament(w http.ResponseWriter, r *http.Request) {
 	id := mux.Vars(r)[IDPath]
+	finishRequest := &FinishRequest{}
+
+	if err := json.NewDecoder(r.Body).Decode(finishRequest); err != nil && err != io.EOF {
+		http.Error(w, "Failed to decode finish request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := finishRequest.Valid(); err != nil {
+		http.Error(w, "Failed to validate finish request: "+err.Error(), decodeStatusCode(err))
+		return
+	}
 
-	if err := h.tournament.FinishTournament(r.Context(), id); err != nil {
+	if err := h.tournament.FinishTournament(r.Context(), id, finishRequest.ranking()); err != nil {
 		http.Error(w, "Failed to finish tournament: "+err.Error(), decodeStatusCode(err))
 		return
 	}
@@ -115,3 +327,69 @@ func (h *Handler) CancelTournament(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
+
+func (h *Handler) OpenRegistration(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)[IDPath]
+
+	if err := h.tournament.OpenRegistration(r.Context(), id); err != nil {
+		http.Error(w, "Failed to open registration: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+}
+
+func (h *Handler) CloseRegistration(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)[IDPath]
+
+	if err := h.tournament.CloseRegistration(r.Context(), id); err != nil {
+		http.Error(w, "Failed to close registration: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+}
+
+type ScoreRequest struct {
+	UserID string  `json:"userId"`
+	Score  float64 `json:"score"`
+}
+
+func (sr *ScoreRequest) Valid() error {
+	if _, err := uuid.Parse(sr.UserID); err != nil {
+		return kerror.Newf(kerror.BadRequest, "invalid format of user id: %v", err)
+	}
+
+	return nil
+}
+
+func (h *Handler) ReportScore(w http.ResponseWriter, r *http.Request) {
+	tournamentID := mux.Vars(r)[IDPath]
+	scoreRequest := &ScoreRequest{}
+
+	if err := json.NewDecoder(r.Body).Decode(scoreRequest); err != nil {
+		http.Error(w, "Failed to decode score request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := scoreRequest.Valid(); err != nil {
+		http.Error(w, "Failed to validate score request: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+
+	if err := h.tournament.ReportScore(r.Context(), tournamentID, scoreRequest.UserID, scoreRequest.Score); err != nil {
+		http.Error(w, "Failed to report score: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+}
+
+func (h *Handler) GetDrawProof(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)[IDPath]
+
+	proof, err := h.tournament.GetDrawProof(r.Context(), id)
+	if err != nil {
+		http.Error(w, "Failed to get draw proof: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(proof); err != nil {
+		http.Error(w, "Failed to encode draw proof in response body: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -8,10 +8,12 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/golang-jwt/jwt"
 	"github.com/kimbellG/kerror"
+	"github.com/kimbellG/tournament/http/controller"
 	"github.com/kimbellG/tournament/http/internal"
 
 	"github.com/gorilla/mux"
@@ -19,7 +21,7 @@ import (
 
 type CreateUserResponse struct {
 	ID       string `json:"id"`
-	Password string `json:"password"`
+	Password string `json:"password,omitempty"`
 }
 
 func Close(cl io.Closer) {
@@ -54,9 +56,13 @@ func (h *Handler) CreateUser(w http.ResponseWriter, r *http.Request) {
 }
 
 type GetUserResponse struct {
-	ID      string  `json:"id"`
-	Name    string  `json:"name"`
-	Balance float64 `json:"balance"`
+	ID               string             `json:"id"`
+	Name             string             `json:"name"`
+	Email            string             `json:"email,omitempty"`
+	Role             internal.Role      `json:"role"`
+	Balance          internal.Money     `json:"balance"`
+	AvailableBalance internal.Money     `json:"availableBalance"`
+	Balances         []internal.Balance `json:"balances"`
 }
 
 func (h *Handler) GetUserByID(w http.ResponseWriter, r *http.Request) {
@@ -69,9 +75,13 @@ func (h *Handler) GetUserByID(w http.ResponseWriter, r *http.Request) {
 	}
 
 	resp := &GetUserResponse{
-		ID:      user.ID,
-		Name:    user.Name,
-		Balance: user.Balance,
+		ID:               user.ID,
+		Name:             user.Name,
+		Email:            user.Email,
+		Role:             user.Role,
+		Balance:          user.Balance,
+		AvailableBalance: user.AvailableBalance,
+		Balances:         user.Balances,
 	}
 
 	if err := json.NewEncoder(w).Encode(resp); err != nil {
@@ -89,12 +99,14 @@ func (h *Handler) DeleteUser(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// UpdateBalanceRequest changes balance of user. Empty currency means the default currency.
 type UpdateBalanceRequest struct {
-	Summand float64 `json:"summand"`
+	Summand  internal.Money `json:"summand"`
+	Currency string         `json:"currency"`
 }
 
 func (u *UpdateBalanceRequest) Valid() error {
-	if u.Summand <= 0 {
+	if !u.Summand.IsPositive() {
 		return kerror.Newf(kerror.BadRequest, "summand to balance should be more than 0")
 	}
 
@@ -115,7 +127,8 @@ func (h *Handler) AddToBalance(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.tournament.UpdateBalanceBySum(r.Context(), id, updateRequest.Summand); err != nil {
+	ctx := controller.WithIdempotencyKey(r.Context(), r.Header.Get(IdempotencyKeyHeader))
+	if err := h.tournament.UpdateBalanceBySum(ctx, id, updateRequest.Currency, updateRequest.Summand); err != nil {
 		http.Error(w, "Failed to add points to user balance: "+err.Error(), decodeStatusCode(err))
 		return
 	}
@@ -135,23 +148,86 @@ func (h *Handler) TakeFromBalance(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.tournament.UpdateBalanceBySum(r.Context(), id, -takeRequest.Summand); err != nil {
+	ctx := controller.WithIdempotencyKey(r.Context(), r.Header.Get(IdempotencyKeyHeader))
+	if err := h.tournament.UpdateBalanceBySum(ctx, id, takeRequest.Currency, takeRequest.Summand.Neg()); err != nil {
 		http.Error(w, "Failed to take points from user balance: "+err.Error(), decodeStatusCode(err))
 		return
 	}
 }
 
+func (h *Handler) ListUserTransactions(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)[IDPath]
+
+	filter, err := transactionFilterFromQuery(r)
+	if err != nil {
+		http.Error(w, "Failed to parse transactions query: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+
+	page, err := h.tournament.ListUserTransactions(r.Context(), id, filter)
+	if err != nil {
+		http.Error(w, "Failed to list user's transactions: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(page); err != nil {
+		http.Error(w, "Failed to encode transactions in body: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func transactionFilterFromQuery(r *http.Request) (*internal.TransactionFilter, error) {
+	query := r.URL.Query()
+	filter := &internal.TransactionFilter{
+		Types:    query["type"],
+		Currency: query.Get("currency"),
+	}
+
+	for name, moment := range map[string]**time.Time{"from": &filter.From, "to": &filter.To} {
+		value := query.Get(name)
+		if value == "" {
+			continue
+		}
+
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, kerror.Newf(kerror.BadRequest, "%s should be RFC3339 time: %v", name, err)
+		}
+		*moment = &t
+	}
+
+	for name, number := range map[string]*int{"limit": &filter.Limit, "offset": &filter.Offset} {
+		value := query.Get(name)
+		if value == "" {
+			continue
+		}
+
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return nil, kerror.Newf(kerror.BadRequest, "%s should be non-negative integer", name)
+		}
+		*number = n
+	}
+
+	return filter, nil
+}
+
 type LogInRequest struct {
 	Login    string `json:"login"`
 	Password string `json:"password"`
 }
 
+// LogInResponse carries short-lived access token and refresh token, which gives new pair of tokens once.
 type LogInResponse struct {
-	Token string `json:"token"`
+	Token        string `json:"token"`
+	RefreshToken string `json:"refreshToken"`
 }
 
+// LogClaims are the claims of token issued on log in and refresh. Id of the token is id of the session
+// it's issued within. The core validates them too.
 type LogClaims struct {
-	ID string
+	ID   string
+	Role internal.Role
 	jwt.StandardClaims
 }
 
@@ -163,34 +239,210 @@ func (h *Handler) UserLogIn(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	id, err := h.tournament.LogIn(r.Context(), rBody.Login, passwordHash(rBody.Password))
+	session, err := h.tournament.LogIn(r.Context(), rBody.Login, passwordHash(rBody.Password))
 	if err != nil {
 		http.Error(w, "Failed to user log in: "+err.Error(), decodeStatusCode(err))
 		return
 	}
 
-	tk, err := createToken(id)
+	writeTokens(w, session)
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// RefreshToken gives new access and refresh tokens for the refresh token. Refresh token is used once,
+// using it again logs the session out.
+func (h *Handler) RefreshToken(w http.ResponseWriter, r *http.Request) {
+	refreshRequest := &RefreshTokenRequest{}
+	if err := json.NewDecoder(r.Body).Decode(refreshRequest); err != nil {
+		http.Error(w, "Failed to decode refresh token request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer Close(r.Body)
+
+	if refreshRequest.RefreshToken == "" {
+		http.Error(w, "Failed to validate refresh token request: refresh token should be set", http.StatusBadRequest)
+		return
+	}
+
+	session, err := h.tournament.RefreshSession(r.Context(), refreshRequest.RefreshToken)
+	if err != nil {
+		http.Error(w, "Failed to refresh session: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+
+	writeTokens(w, session)
+}
+
+func writeTokens(w http.ResponseWriter, session *internal.Session) {
+	tk, err := createToken(session)
 	if err != nil {
 		http.Error(w, "Failed to create token: "+err.Error(), decodeStatusCode(err))
 		return
 	}
 
-	if err := json.NewEncoder(w).Encode(&LogInResponse{Token: tk}); err != nil {
+	if err := json.NewEncoder(w).Encode(&LogInResponse{Token: tk, RefreshToken: session.RefreshToken}); err != nil {
 		http.Error(w, "Failed to encode reponse: ", http.StatusInternalServerError)
 		return
 	}
+}
+
+// Logout revokes the session of the caller's token, so its access and refresh tokens stop working.
+func (h *Handler) Logout(w http.ResponseWriter, r *http.Request) {
+	if err := h.tournament.Logout(r.Context()); err != nil {
+		http.Error(w, "Failed to log out: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+}
+
+// ChangePasswordRequest carries plain passwords, core checks the strength of the new one.
+type ChangePasswordRequest struct {
+	OldPassword string `json:"oldPassword"`
+	NewPassword string `json:"newPassword"`
+}
+
+func (c *ChangePasswordRequest) Valid() error {
+	if c.OldPassword == "" || c.NewPassword == "" {
+		return kerror.Newf(kerror.BadRequest, "old and new passwords should be set")
+	}
+
+	return nil
+}
+
+func (h *Handler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)[IDPath]
+
+	changeRequest := &ChangePasswordRequest{}
+	if err := json.NewDecoder(r.Body).Decode(changeRequest); err != nil {
+		http.Error(w, "Failed to decode change password request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer Close(r.Body)
+
+	if err := changeRequest.Valid(); err != nil {
+		http.Error(w, "Failed to validate change password request: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+
+	if err := h.tournament.ChangePassword(r.Context(), id, changeRequest.OldPassword, changeRequest.NewPassword); err != nil {
+		http.Error(w, "Failed to change password: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+}
+
+type SetRoleRequest struct {
+	Role internal.Role `json:"role"`
+}
+
+func (h *Handler) SetUserRole(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)[IDPath]
+
+	roleRequest := &SetRoleRequest{}
+	if err := json.NewDecoder(r.Body).Decode(roleRequest); err != nil {
+		http.Error(w, "Failed to decode role request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer Close(r.Body)
+
+	if roleRequest.Role == "" {
+		http.Error(w, "Failed to validate role request: role should be set", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.tournament.SetUserRole(r.Context(), id, roleRequest.Role); err != nil {
+		http.Error(w, "Failed to set role of user: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+}
+
+type PasswordResetRequest struct {
+	Login string `json:"login"`
+}
+
+// RequestPasswordReset answers the same way whether user exists or not.
+func (h *Handler) RequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+	resetRequest := &PasswordResetRequest{}
+	if err := json.NewDecoder(r.Body).Decode(resetRequest); err != nil {
+		http.Error(w, "Failed to decode password reset request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer Close(r.Body)
+
+	if resetRequest.Login == "" {
+		http.Error(w, "Failed to validate password reset request: login should be set", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.tournament.RequestPasswordReset(r.Context(), resetRequest.Login); err != nil {
+		http.Error(w, "Failed to request password reset: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+}
 
+// ResetPasswordRequest carries the token sent to user and plain new password.
+type ResetPasswordRequest struct {
+	Token       string `json:"token"`
+	NewPassword string `json:"newPassword"`
+}
+
+func (rp *ResetPasswordRequest) Valid() error {
+	if rp.Token == "" || rp.NewPassword == "" {
+		return kerror.Newf(kerror.BadRequest, "token and new password should be set")
+	}
+
+	return nil
+}
+
+func (h *Handler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	resetRequest := &ResetPasswordRequest{}
+	if err := json.NewDecoder(r.Body).Decode(resetRequest); err != nil {
+		http.Error(w, "Failed to decode reset password request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer Close(r.Body)
+
+	if err := resetRequest.Valid(); err != nil {
+		http.Error(w, "Failed to validate reset password request: "+err.Error(), decodeStatusCode(err))
+		return
+	}
+
+	if err := h.tournament.ResetPassword(r.Context(), resetRequest.Token, resetRequest.NewPassword); err != nil {
+		http.Error(w, "Failed to reset password: "+err.Error(), decodeStatusCode(err))
+		return
+	}
 }
 
 func passwordHash(password string) string {
 	return fmt.Sprintf("%x", sha256.Sum256([]byte(password)))
 }
 
-func createToken(id string) (string, error) {
+const defaultAccessTokenTTL = 15 * time.Minute
+
+// accessTokenTTL is how long token issued on log in and refresh is valid, ACCESS_TOKEN_TTL sets it.
+func accessTokenTTL() time.Duration {
+	ttl, err := time.ParseDuration(os.Getenv("ACCESS_TOKEN_TTL"))
+	if err != nil || ttl <= 0 {
+		return defaultAccessTokenTTL
+	}
+
+	return ttl
+}
+
+// createToken issues token within the session. Token doesn't outlive the session.
+func createToken(session *internal.Session) (string, error) {
+	expiresAt := time.Now().Add(accessTokenTTL())
+	if !session.ExpiresAt.IsZero() && session.ExpiresAt.Before(expiresAt) {
+		expiresAt = session.ExpiresAt
+	}
+
 	claims := &LogClaims{
-		id,
+		session.UserID,
+		session.Role,
 		jwt.StandardClaims{
-			ExpiresAt: time.Now().Add(time.Hour).Unix(),
+			Id:        session.ID,
+			ExpiresAt: expiresAt.Unix(),
 		},
 	}
 
@@ -0,0 +1,36 @@
+package internal
+
+import (
+	"time"
+
+	"github.com/kimbellG/kerror"
+)
+
+// Limit caps deposits or entries of user within a Day, Week or Month in one currency. Zero amount is no limit.
+// Lowered limit applies immediately, raised one waits in PendingAmount until PendingFrom.
+type Limit struct {
+	Kind          string     `json:"kind"`
+	Period        string     `json:"period"`
+	Currency      string     `json:"currency"`
+	Amount        Money      `json:"amount"`
+	PendingAmount *Money     `json:"pendingAmount,omitempty"`
+	PendingFrom   *time.Time `json:"pendingFrom,omitempty"`
+}
+
+func (l *Limit) Valid() error {
+	if l.Kind == "" || l.Period == "" {
+		return kerror.Newf(kerror.BadRequest, "kind and period of limit should be set")
+	}
+
+	if l.Amount.IsNegative() {
+		return kerror.Newf(kerror.BadRequest, "limit shouldn't be negative")
+	}
+
+	return nil
+}
+
+// SelfLimits are the limits user has set on themself. ExcludedUntil is set while user is self-excluded from tournaments.
+type SelfLimits struct {
+	Limits        []Limit    `json:"limits"`
+	ExcludedUntil *time.Time `json:"excludedUntil,omitempty"`
+}
@@ -0,0 +1,22 @@
+package internal
+
+type Match struct {
+	ID         string `json:"id"`
+	Round      int    `json:"round"`
+	Position   int    `json:"position"`
+	FirstUser  string `json:"firstUser"`
+	SecondUser string `json:"secondUser"`
+	Winner     string `json:"winner"`
+	Draw       bool   `json:"draw"`
+}
+
+type Standing struct {
+	UserID     string  `json:"userId"`
+	Played     int     `json:"played"`
+	Wins       int     `json:"wins"`
+	Draws      int     `json:"draws"`
+	Losses     int     `json:"losses"`
+	Points     float64 `json:"points"`
+	Buchholz   float64 `json:"buchholz"`
+	HeadToHead float64 `json:"headToHead"`
+}
@@ -0,0 +1,127 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const centsInUnit = 100
+
+// Money is an exact amount of money kept in cents. In JSON it is a plain number with two digits
+// after the point, so clients that read amounts as floats keep working.
+type Money struct {
+	cents int64
+}
+
+func Cents(cents int64) Money {
+	return Money{cents: cents}
+}
+
+func (m Money) Cents() int64 {
+	return m.cents
+}
+
+func (m Money) Float64() float64 {
+	return float64(m.cents) / centsInUnit
+}
+
+func (m Money) Neg() Money {
+	return Money{cents: -m.cents}
+}
+
+func (m Money) IsPositive() bool {
+	return m.cents > 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.cents < 0
+}
+
+func (m Money) String() string {
+	sign, cents := "", m.cents
+	if cents < 0 {
+		sign, cents = "-", -cents
+	}
+
+	return fmt.Sprintf("%s%d.%02d", sign, cents/centsInUnit, cents%centsInUnit)
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalJSON reads exact amount from number or string like "-12.30". Amounts with more than two digits
+// after the point, exponents and fractions are rejected instead of being rounded.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	value := string(data)
+	if len(value) >= 2 && strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`) {
+		value = value[1 : len(value)-1]
+	}
+
+	cents, err := parseCents(value)
+	if err != nil {
+		return fmt.Errorf("invalid amount of money %s: %w", data, err)
+	}
+
+	m.cents = cents
+	return nil
+}
+
+func parseCents(value string) (int64, error) {
+	negative := strings.HasPrefix(value, "-")
+	value = strings.TrimPrefix(value, "-")
+
+	units, fraction := value, ""
+	if i := strings.IndexByte(value, '.'); i >= 0 {
+		units, fraction = value[:i], value[i+1:]
+		if fraction == "" {
+			return 0, errors.New("no digits after the point")
+		}
+	}
+
+	if units == "" || !isDigits(units) || !isDigits(fraction) {
+		return 0, errors.New("expected decimal number")
+	}
+
+	if len(fraction) > 2 {
+		return 0, errors.New("more than two digits after the point")
+	}
+
+	whole, err := strconv.ParseInt(units, 10, 64)
+	if err != nil || whole > math.MaxInt64/centsInUnit {
+		return 0, errors.New("amount is too large")
+	}
+
+	var rest int64
+	if fraction != "" {
+		rest, _ = strconv.ParseInt((fraction + "0")[:2], 10, 64)
+	}
+
+	cents := whole*centsInUnit + rest
+	if cents < 0 {
+		return 0, errors.New("amount is too large")
+	}
+
+	if negative {
+		cents = -cents
+	}
+
+	return cents, nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,52 @@
+package internal
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMoneyUnmarshalJSON(t *testing.T) {
+	tt := []struct {
+		data  string
+		want  Money
+		valid bool
+	}{
+		{data: `12.34`, want: Cents(1234), valid: true},
+		{data: `"12.34"`, want: Cents(1234), valid: true},
+		{data: `-0.5`, want: Cents(-50), valid: true},
+		{data: `7`, want: Cents(700), valid: true},
+		{data: `"92233720368547758.07"`, want: Cents(9223372036854775807), valid: true},
+		{data: `null`, want: Money{}, valid: true},
+		{data: `0.001`, valid: false},
+		{data: `1.005`, valid: false},
+		{data: `"1/3"`, valid: false},
+		{data: `1e400`, valid: false},
+		{data: `1E2`, valid: false},
+		{data: `"92233720368547758.08"`, valid: false},
+		{data: `"99999999999999999999"`, valid: false},
+		{data: `"1."`, valid: false},
+		{data: `".5"`, valid: false},
+		{data: `"--1"`, valid: false},
+		{data: `""`, valid: false},
+	}
+
+	for _, tc := range tt {
+		var actual Money
+		err := json.Unmarshal([]byte(tc.data), &actual)
+		if !tc.valid {
+			if err == nil {
+				t.Errorf("%s shouldn't be read, got %v", tc.data, actual)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s should be read: %v", tc.data, err)
+			continue
+		}
+
+		if actual != tc.want {
+			t.Errorf("amount of %s: want %v, got %v", tc.data, tc.want, actual)
+		}
+	}
+}
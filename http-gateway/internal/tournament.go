@@ -1,33 +1,97 @@
 package internal
 
-import "github.com/kimbellG/kerror"
+import (
+	"time"
+
+	"github.com/kimbellG/kerror"
+)
 
 type TournamentStatus string
 
 const (
-	Active TournamentStatus = "Active"
-	Cancel TournamentStatus = "Cancel"
-	Finish TournamentStatus = "Finish"
+	Draft              TournamentStatus = "Draft"
+	RegistrationOpen   TournamentStatus = "RegistrationOpen"
+	RegistrationClosed TournamentStatus = "RegistrationClosed"
+	InProgress         TournamentStatus = "InProgress"
+	Finished           TournamentStatus = "Finished"
+	Cancelled          TournamentStatus = "Cancelled"
 )
 
 type Tournament struct {
-	ID      string           `json:"id"`
-	Name    string           `json:"name"`
-	Deposit float64          `json:"deposit"`
-	Prize   float64          `json:"prize"`
-	Users   []string         `json:"users"`
-	Winner  string           `json:"winner"`
-	Status  TournamentStatus `json:"status"`
+	ID                string           `json:"id"`
+	Name              string           `json:"name"`
+	Currency          string           `json:"currency"`
+	Deposit           Money            `json:"deposit"`
+	Prize             Money            `json:"prize"`
+	Users             []string         `json:"users"`
+	Winner            string           `json:"winner"`
+	Status            TournamentStatus `json:"status"`
+	Format            string           `json:"format"`
+	Rounds            int              `json:"rounds"`
+	Tiebreakers       []string         `json:"tiebreakers"`
+	WinnerStrategy    string           `json:"winnerStrategy"`
+	ServerSeedHash    string           `json:"serverSeedHash"`
+	PayoutType        string           `json:"payoutType"`
+	Payouts           []float64        `json:"payouts"`
+	Placements        []Placement      `json:"placements"`
+	RakeType          string           `json:"rakeType"`
+	Rake              float64          `json:"rake"`
+	GrossEntries      Money            `json:"grossEntries"`
+	RakeCollected     Money            `json:"rakeCollected"`
+	MinPlayers        int              `json:"minPlayers"`
+	MaxPlayers        int              `json:"maxPlayers"`
+	WithdrawalPenalty float64          `json:"withdrawalPenalty"`
+	Schedule
+}
+
+// Schedule holds the moments when tournament changes its status by itself.
+type Schedule struct {
+	RegistrationOpensAt  *time.Time `json:"registrationOpensAt,omitempty"`
+	RegistrationClosesAt *time.Time `json:"registrationClosesAt,omitempty"`
+	StartTime            *time.Time `json:"startTime,omitempty"`
+	FinishDeadline       *time.Time `json:"finishDeadline,omitempty"`
+}
+
+type Placement struct {
+	Place  int    `json:"place"`
+	UserID string `json:"userId"`
+	Prize  Money  `json:"prize"`
 }
 
 func (t *Tournament) Valid() error {
-	if t.Deposit <= 0 {
+	if !t.Deposit.IsPositive() {
 		return kerror.Newf(kerror.BadRequest, "deposit should be more than 0")
 	}
 
-	if t.Prize < 0 {
+	if t.Prize.IsNegative() {
 		return kerror.Newf(kerror.BadRequest, "prize should be positive")
 	}
 
 	return nil
 }
+
+type DrawEntry struct {
+	UserID     string `json:"userId"`
+	ClientSeed string `json:"clientSeed"`
+}
+
+type DrawProof struct {
+	TournamentID   string      `json:"tournamentId"`
+	Algorithm      string      `json:"algorithm"`
+	ServerSeedHash string      `json:"serverSeedHash"`
+	ServerSeed     string      `json:"serverSeed"`
+	Entries        []DrawEntry `json:"entries"`
+	WinnerIndex    int         `json:"winnerIndex"`
+	Winner         string      `json:"winner"`
+}
+
+type JoinResult struct {
+	Waitlisted bool `json:"waitlisted"`
+	Position   int  `json:"position,omitempty"`
+}
+
+type WaitlistEntry struct {
+	UserID   string `json:"userId"`
+	Stake    Money  `json:"stake"`
+	Position int    `json:"position"`
+}
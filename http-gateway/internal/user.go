@@ -1,18 +1,94 @@
 package internal
 
-import "github.com/kimbellG/kerror"
+import (
+	"time"
 
+	"github.com/kimbellG/kerror"
+)
+
+// User is a player of tournaments. Balance is the balance in the default currency, Balances are the balances in every currency.
+// AvailableBalance is the part of Balance, which isn't held for joined tournaments.
+// Password is chosen by user on creation, user without it gets a generated one.
+// Email is where tokens of password reset are sent. Role is given by admin, new users are players.
 type User struct {
-	ID       string  `json:"id"`
-	Name     string  `json:"name"`
-	Balance  float64 `json:"balance"`
-	Password string  `json:"password"`
+	ID               string    `json:"id"`
+	Name             string    `json:"name"`
+	Balance          Money     `json:"balance"`
+	AvailableBalance Money     `json:"availableBalance"`
+	Balances         []Balance `json:"balances,omitempty"`
+	Password         string    `json:"password"`
+	Email            string    `json:"email,omitempty"`
+	Role             Role      `json:"role,omitempty"`
+}
+
+// Role is the set of actions user is allowed to perform. Admin is allowed everything,
+// organizer runs tournaments, player enters them with the money on own balance.
+type Role string
+
+const (
+	AdminRole     Role = "Admin"
+	OrganizerRole Role = "Organizer"
+	PlayerRole    Role = "Player"
+)
+
+// Session is a log in of user in the core. Tokens are issued within the session until it expires
+// or is revoked. RefreshToken extends the session once and is replaced by a new one.
+type Session struct {
+	ID           string
+	UserID       string
+	Role         Role
+	RefreshToken string
+	ExpiresAt    time.Time
+}
+
+// Balance is the amount of money of user in one currency. Available is the part of Amount, which user can spend.
+type Balance struct {
+	Currency  string `json:"currency"`
+	Amount    Money  `json:"amount"`
+	Available Money  `json:"available"`
 }
 
 func (u *User) Valid() error {
-	if u.Balance < 0 {
+	if u.Balance.IsNegative() {
 		return kerror.Newf(kerror.BadRequest, "user's balance should be more than 0")
 	}
 
+	for _, balance := range u.Balances {
+		if balance.Currency == "" {
+			return kerror.Newf(kerror.BadRequest, "currency of balance should be set")
+		}
+
+		if balance.Amount.IsNegative() {
+			return kerror.Newf(kerror.BadRequest, "user's balance in %s should be more than 0", balance.Currency)
+		}
+	}
+
 	return nil
 }
+
+type UserTransaction struct {
+	ID           string    `json:"id"`
+	Type         string    `json:"type"`
+	TournamentID string    `json:"tournamentId,omitempty"`
+	Currency     string    `json:"currency"`
+	Amount       Money     `json:"amount"`
+	BalanceAfter Money     `json:"balanceAfter"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// TransactionFilter narrows user's transaction history. From is inclusive, To is exclusive.
+// Empty currency selects transactions in all currencies.
+type TransactionFilter struct {
+	Types    []string
+	Currency string
+	From     *time.Time
+	To       *time.Time
+	Limit    int
+	Offset   int
+}
+
+// TransactionsPage is a page of user's transaction history. Zero NextOffset means there are no more pages.
+type TransactionsPage struct {
+	Transactions []UserTransaction `json:"transactions"`
+	NextOffset   int               `json:"nextOffset"`
+}
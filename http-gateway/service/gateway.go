@@ -77,9 +77,12 @@ func startRouter(conn *grpc.ClientConn) *mux.Router {
 	cont := controller.NewTournamentController(conn)
 	authmid := handler.AuthenticationMiddleware{
 		TokenPassword: os.Getenv("TK_PASSWORD"),
+		Sessions:      cont,
 		NotAuthPaths: []string{
 			"/" + handler.UserPath,
 			"/" + handler.LogInPath,
+			"/" + handler.RefreshPath,
+			"/" + handler.PasswordResetPath,
 		},
 	}
 